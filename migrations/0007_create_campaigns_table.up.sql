@@ -0,0 +1,17 @@
+CREATE TABLE campaigns
+(
+    id                SERIAL PRIMARY KEY,
+    name              VARCHAR(255)     NOT NULL,
+    pools             VARCHAR(42)[]    NOT NULL,
+    start_time        TIMESTAMP        NOT NULL,
+    period_hours      INTEGER          NOT NULL,
+    periods           INTEGER          NOT NULL,
+    budget_per_period DOUBLE PRECISION NOT NULL,
+    onboarding_amount DOUBLE PRECISION NOT NULL,
+    onboarding_reward DOUBLE PRECISION NOT NULL,
+    status            VARCHAR(50)      NOT NULL,
+    created_at        TIMESTAMP        NOT NULL,
+    updated_at        TIMESTAMP        NOT NULL
+);
+
+CREATE INDEX campaigns_status ON campaigns (status);
@@ -0,0 +1,12 @@
+DROP INDEX tasks_swap_id_campaign_id_idx;
+DROP INDEX tasks_campaign_id_type_status_idx;
+DROP INDEX reward_records_campaign_id;
+
+ALTER TABLE tasks
+DROP COLUMN swap_id;
+
+ALTER TABLE tasks
+DROP COLUMN campaign_id;
+
+ALTER TABLE reward_records
+DROP COLUMN campaign_id;
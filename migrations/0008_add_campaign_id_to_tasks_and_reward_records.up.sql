@@ -0,0 +1,38 @@
+ALTER TABLE tasks
+ADD COLUMN campaign_id INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE reward_records
+ADD COLUMN campaign_id INTEGER NOT NULL DEFAULT 0;
+
+-- the tasks and reward records so far belong to the campaign that was hard coded before campaigns were stored, seed
+-- it with its settings so they keep a campaign; the config campaign is only seeded on an empty campaigns table
+INSERT INTO campaigns (name, pools, start_time, period_hours, periods, budget_per_period, onboarding_amount,
+                       onboarding_reward, status, created_at, updated_at)
+SELECT 'USDC-WETH weekly',
+       ARRAY ['0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc'],
+       '2024-09-01',
+       168,
+       4,
+       10000,
+       1000,
+       100,
+       'active',
+       NOW() AT TIME ZONE 'UTC',
+       NOW() AT TIME ZONE 'UTC'
+WHERE NOT EXISTS (SELECT 1 FROM campaigns)
+  AND (EXISTS (SELECT 1 FROM tasks) OR EXISTS (SELECT 1 FROM reward_records));
+
+UPDATE tasks
+SET campaign_id = (SELECT MIN(id) FROM campaigns);
+
+UPDATE reward_records
+SET campaign_id = (SELECT MIN(id) FROM campaigns);
+
+CREATE INDEX tasks_campaign_id_type_status_idx ON tasks (campaign_id, type, status);
+
+-- a swap creates one shared pool task per campaign, however many times its job is retried
+ALTER TABLE tasks
+ADD COLUMN swap_id VARCHAR(255);
+
+CREATE UNIQUE INDEX tasks_swap_id_campaign_id_idx ON tasks (swap_id, campaign_id) WHERE swap_id IS NOT NULL;
+CREATE INDEX reward_records_campaign_id ON reward_records (campaign_id);
@@ -0,0 +1,2 @@
+DROP TABLE settlement_payouts;
+DROP TABLE settlements;
@@ -0,0 +1,26 @@
+CREATE TABLE settlements
+(
+    id             SERIAL PRIMARY KEY,
+    campaign_id    INTEGER   NOT NULL,
+    period_index   INTEGER   NOT NULL,
+    start_time     TIMESTAMP NOT NULL,
+    end_time       TIMESTAMP NOT NULL,
+    settled_at     TIMESTAMP NOT NULL,
+    completed_at   TIMESTAMP NULL,
+    distributed_at TIMESTAMP NULL
+);
+
+CREATE UNIQUE INDEX settlements_campaign_id_period_index ON settlements (campaign_id, period_index);
+
+CREATE TABLE settlement_payouts
+(
+    settlement_id INTEGER          NOT NULL REFERENCES settlements (id),
+    task_id       INTEGER          NOT NULL,
+    user_id       VARCHAR(255)     NOT NULL,
+    swap_amount   DOUBLE PRECISION NOT NULL,
+    weight        DOUBLE PRECISION NOT NULL,
+    multipliers   JSONB            NULL,
+    share         DOUBLE PRECISION NOT NULL,
+    points        DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (settlement_id, task_id)
+);
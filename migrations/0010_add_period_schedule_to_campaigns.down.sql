@@ -0,0 +1,32 @@
+-- period_hours only holds daily and weekly periods, a monthly or cron campaign must be changed by hand first
+DO
+$$
+    BEGIN
+        IF EXISTS (SELECT 1 FROM campaigns WHERE period_type NOT IN ('daily', 'weekly')) THEN
+            RAISE EXCEPTION 'campaigns % have a period_type other than daily or weekly',
+                (SELECT string_agg(id::text, ', ') FROM campaigns WHERE period_type NOT IN ('daily', 'weekly'));
+        END IF;
+    END
+$$;
+
+ALTER TABLE campaigns
+ADD COLUMN period_hours INTEGER NOT NULL DEFAULT 168;
+
+UPDATE campaigns
+SET period_hours = 24
+WHERE period_type = 'daily';
+
+ALTER TABLE campaigns
+DROP COLUMN period_type;
+
+ALTER TABLE campaigns
+DROP COLUMN period_cron;
+
+ALTER TABLE campaigns
+DROP COLUMN timezone;
+
+ALTER TABLE campaigns
+DROP COLUMN budget_decay;
+
+ALTER TABLE campaigns
+DROP COLUMN budget_schedule;
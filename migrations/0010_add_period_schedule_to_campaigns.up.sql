@@ -0,0 +1,31 @@
+-- only daily and weekly periods could be stored, a campaign of any other period_hours must be fixed by hand first
+DO
+$$
+    BEGIN
+        IF EXISTS (SELECT 1 FROM campaigns WHERE period_hours NOT IN (24, 168)) THEN
+            RAISE EXCEPTION 'campaigns % have a period_hours other than 24 or 168',
+                (SELECT string_agg(id::text, ', ') FROM campaigns WHERE period_hours NOT IN (24, 168));
+        END IF;
+    END
+$$;
+
+ALTER TABLE campaigns
+ADD COLUMN period_type VARCHAR(50) NOT NULL DEFAULT 'weekly';
+
+ALTER TABLE campaigns
+ADD COLUMN period_cron VARCHAR(255) NOT NULL DEFAULT '';
+
+ALTER TABLE campaigns
+ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+ALTER TABLE campaigns
+ADD COLUMN budget_decay DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+ALTER TABLE campaigns
+ADD COLUMN budget_schedule DOUBLE PRECISION[] NOT NULL DEFAULT '{}';
+
+UPDATE campaigns
+SET period_type = CASE period_hours WHEN 24 THEN 'daily' ELSE 'weekly' END;
+
+ALTER TABLE campaigns
+DROP COLUMN period_hours;
@@ -0,0 +1,11 @@
+CREATE TABLE audit_logs
+(
+    id         SERIAL PRIMARY KEY,
+    operator   VARCHAR(255) NOT NULL,
+    action     VARCHAR(100) NOT NULL,
+    target     VARCHAR(255) NOT NULL,
+    detail     JSONB        NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX audit_logs_action_created_at ON audit_logs (action, created_at);
@@ -0,0 +1,2 @@
+DROP TABLE period_stats_swaps;
+DROP TABLE user_period_stats;
@@ -0,0 +1,23 @@
+CREATE TABLE user_period_stats
+(
+    campaign_id  INTEGER          NOT NULL,
+    period_index INTEGER          NOT NULL,
+    user_id      VARCHAR(255)     NOT NULL,
+    volume       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    swap_count   INTEGER          NOT NULL DEFAULT 0,
+    onboarded    BOOLEAN          NOT NULL DEFAULT FALSE,
+    weight       DOUBLE PRECISION NOT NULL DEFAULT 0,
+    updated_at   TIMESTAMP        NOT NULL,
+    PRIMARY KEY (campaign_id, period_index, user_id)
+);
+
+CREATE INDEX user_period_stats_user_id ON user_period_stats (user_id);
+
+-- a swap adds to the stats of a campaign once, however many times its job is retried
+CREATE TABLE period_stats_swaps
+(
+    swap_id     VARCHAR(255) NOT NULL,
+    campaign_id INTEGER      NOT NULL,
+    created_at  TIMESTAMP    NOT NULL,
+    PRIMARY KEY (swap_id, campaign_id)
+);
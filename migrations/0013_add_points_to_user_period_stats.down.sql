@@ -0,0 +1,4 @@
+DROP INDEX user_period_stats_campaign_id_user_id;
+
+ALTER TABLE user_period_stats
+DROP COLUMN points;
@@ -0,0 +1,4 @@
+ALTER TABLE user_period_stats
+ADD COLUMN points DOUBLE PRECISION NOT NULL DEFAULT 0;
+
+CREATE INDEX user_period_stats_campaign_id_user_id ON user_period_stats (campaign_id, user_id);
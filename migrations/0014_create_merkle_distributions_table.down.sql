@@ -0,0 +1,2 @@
+DROP TABLE merkle_claims;
+DROP TABLE merkle_distributions;
@@ -0,0 +1,22 @@
+CREATE TABLE merkle_distributions
+(
+    id             SERIAL PRIMARY KEY,
+    campaign_id    INTEGER        NOT NULL,
+    period_index   INTEGER        NOT NULL,
+    root           VARCHAR(66)    NOT NULL,
+    token_decimals INTEGER        NOT NULL,
+    total_amount   NUMERIC(78, 0) NOT NULL,
+    created_at     TIMESTAMP      NOT NULL,
+    UNIQUE (campaign_id, period_index)
+);
+
+CREATE TABLE merkle_claims
+(
+    distribution_id INTEGER        NOT NULL REFERENCES merkle_distributions (id) ON DELETE CASCADE,
+    address         VARCHAR(42)    NOT NULL,
+    amount          NUMERIC(78, 0) NOT NULL,
+    proof           VARCHAR(66)[]  NOT NULL,
+    PRIMARY KEY (distribution_id, address)
+);
+
+CREATE INDEX merkle_claims_address ON merkle_claims (address);
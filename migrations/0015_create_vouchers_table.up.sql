@@ -0,0 +1,17 @@
+CREATE TABLE voucher_nonces
+(
+    account    VARCHAR(42) PRIMARY KEY,
+    next_nonce BIGINT NOT NULL
+);
+
+CREATE TABLE vouchers
+(
+    id         SERIAL PRIMARY KEY,
+    account    VARCHAR(42)    NOT NULL,
+    nonce      BIGINT         NOT NULL,
+    amount     NUMERIC(78, 0) NOT NULL,
+    expiry     TIMESTAMP      NOT NULL,
+    signature  VARCHAR(132)   NOT NULL,
+    created_at TIMESTAMP      NOT NULL,
+    UNIQUE (account, nonce)
+);
@@ -0,0 +1,7 @@
+CREATE TABLE auth_nonces
+(
+    nonce      VARCHAR(64) PRIMARY KEY,
+    expires_at TIMESTAMP   NOT NULL
+);
+
+CREATE INDEX auth_nonces_expires_at ON auth_nonces (expires_at);
@@ -0,0 +1,11 @@
+CREATE TABLE api_keys
+(
+    id         SERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL,
+    prefix     VARCHAR(16)  NOT NULL,
+    key_hash   VARCHAR(64)  NOT NULL UNIQUE,
+    role       VARCHAR(20)  NOT NULL,
+    scopes     VARCHAR(50)[] NOT NULL,
+    created_at TIMESTAMP    NOT NULL,
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,15 @@
+DELETE FROM reward_records WHERE task_id IS NULL;
+
+DROP INDEX reward_records_user_id_entry_type;
+
+ALTER TABLE reward_records
+ALTER COLUMN task_id SET NOT NULL;
+
+ALTER TABLE reward_records
+DROP COLUMN operator;
+
+ALTER TABLE reward_records
+DROP COLUMN reason;
+
+ALTER TABLE reward_records
+DROP COLUMN entry_type;
@@ -0,0 +1,13 @@
+ALTER TABLE reward_records
+ADD COLUMN entry_type VARCHAR(20) NOT NULL DEFAULT 'task_reward';
+
+ALTER TABLE reward_records
+ADD COLUMN reason TEXT;
+
+ALTER TABLE reward_records
+ADD COLUMN operator VARCHAR(255);
+
+ALTER TABLE reward_records
+ALTER COLUMN task_id DROP NOT NULL;
+
+CREATE INDEX reward_records_user_id_entry_type ON reward_records (user_id, entry_type);
@@ -0,0 +1,10 @@
+DROP TABLE balance_mismatches;
+
+ALTER TABLE reward_records
+DROP COLUMN ledger_transaction_id;
+
+DROP TABLE ledger_entries;
+
+DROP TABLE ledger_transactions;
+
+DROP FUNCTION reject_ledger_change;
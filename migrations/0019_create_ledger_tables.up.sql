@@ -0,0 +1,73 @@
+CREATE TABLE ledger_transactions
+(
+    id         SERIAL PRIMARY KEY,
+    kind       VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP   NOT NULL
+);
+
+CREATE TABLE ledger_entries
+(
+    id             SERIAL PRIMARY KEY,
+    transaction_id INTEGER          NOT NULL REFERENCES ledger_transactions (id),
+    account        VARCHAR(300)     NOT NULL,
+    amount         DOUBLE PRECISION NOT NULL,
+    created_at     TIMESTAMP        NOT NULL
+);
+
+CREATE INDEX ledger_entries_account ON ledger_entries (account, id);
+CREATE INDEX ledger_entries_transaction_id ON ledger_entries (transaction_id);
+
+-- the ledger is append-only, a mistake is corrected by a new transaction
+CREATE FUNCTION reject_ledger_change() RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'the ledger is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER ledger_transactions_append_only
+    BEFORE UPDATE OR DELETE
+    ON ledger_transactions
+    FOR EACH ROW
+EXECUTE FUNCTION reject_ledger_change();
+
+CREATE TRIGGER ledger_entries_append_only
+    BEFORE UPDATE OR DELETE
+    ON ledger_entries
+    FOR EACH ROW
+EXECUTE FUNCTION reject_ledger_change();
+
+ALTER TABLE reward_records
+ADD COLUMN ledger_transaction_id INTEGER;
+
+-- every existing record becomes a transaction between the user and the
+-- account issuing its points, balances already drifting from their records
+-- are left for the reconciliation to flag
+UPDATE reward_records
+SET ledger_transaction_id = nextval('ledger_transactions_id_seq');
+
+INSERT INTO ledger_transactions (id, kind, created_at)
+SELECT ledger_transaction_id, entry_type, created_at
+FROM reward_records;
+
+INSERT INTO ledger_entries (transaction_id, account, amount, created_at)
+SELECT ledger_transaction_id, 'user:' || user_id, points, created_at
+FROM reward_records
+UNION ALL
+SELECT ledger_transaction_id,
+       CASE entry_type WHEN 'adjustment' THEN 'system:adjustments' ELSE 'system:rewards' END,
+       -points,
+       created_at
+FROM reward_records;
+
+ALTER TABLE reward_records
+ADD CONSTRAINT reward_records_ledger_transaction_id_fkey
+    FOREIGN KEY (ledger_transaction_id) REFERENCES ledger_transactions (id);
+
+CREATE TABLE balance_mismatches
+(
+    user_id       VARCHAR(255) PRIMARY KEY,
+    cached_points DOUBLE PRECISION         NOT NULL,
+    ledger_points DOUBLE PRECISION         NOT NULL,
+    detected_at   TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,17 @@
+CREATE TABLE redemptions
+(
+    id                           SERIAL PRIMARY KEY,
+    user_id                      VARCHAR(255)     NOT NULL,
+    item_id                      VARCHAR(100)     NOT NULL,
+    points                       DOUBLE PRECISION NOT NULL,
+    status                       VARCHAR(20)      NOT NULL,
+    ledger_transaction_id        INTEGER          NOT NULL REFERENCES ledger_transactions (id),
+    refund_ledger_transaction_id INTEGER REFERENCES ledger_transactions (id),
+    reason                       TEXT,
+    operator                     VARCHAR(255),
+    created_at                   TIMESTAMP        NOT NULL,
+    updated_at                   TIMESTAMP        NOT NULL
+);
+
+CREATE INDEX redemptions_user_id ON redemptions (user_id, id);
+CREATE INDEX redemptions_status ON redemptions (status, id);
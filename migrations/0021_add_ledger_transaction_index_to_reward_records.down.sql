@@ -0,0 +1 @@
+DROP INDEX reward_records_ledger_transaction_id;
@@ -0,0 +1 @@
+CREATE INDEX reward_records_ledger_transaction_id ON reward_records (ledger_transaction_id);
@@ -0,0 +1,4 @@
+ALTER TABLE reward_records
+DROP COLUMN multipliers;
+
+DROP TABLE multipliers;
@@ -0,0 +1,16 @@
+CREATE TABLE multipliers
+(
+    id          SERIAL PRIMARY KEY,
+    name        VARCHAR(255)     NOT NULL,
+    factor      DOUBLE PRECISION NOT NULL,
+    campaign_id INTEGER,
+    user_ids    VARCHAR(255)[]   NOT NULL DEFAULT '{}',
+    start_time  TIMESTAMP        NOT NULL,
+    end_time    TIMESTAMP,
+    created_at  TIMESTAMP        NOT NULL
+);
+
+CREATE INDEX multipliers_start_time ON multipliers (start_time);
+
+ALTER TABLE reward_records
+ADD COLUMN multipliers JSONB;
@@ -0,0 +1,9 @@
+DROP TABLE tier_history;
+
+DROP INDEX users_tier;
+
+ALTER TABLE users
+DROP COLUMN tier;
+
+DROP TABLE volume_swaps;
+DROP TABLE user_daily_volumes;
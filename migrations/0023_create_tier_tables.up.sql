@@ -0,0 +1,33 @@
+CREATE TABLE user_daily_volumes
+(
+    user_id    VARCHAR(255)     NOT NULL,
+    day        DATE             NOT NULL,
+    volume     DOUBLE PRECISION NOT NULL DEFAULT 0,
+    swap_count INTEGER          NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, day)
+);
+
+-- a swap adds its volume once, however many times its job is retried
+CREATE TABLE volume_swaps
+(
+    swap_id    VARCHAR(255) NOT NULL PRIMARY KEY,
+    user_id    VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP    NOT NULL
+);
+
+ALTER TABLE users
+ADD COLUMN tier VARCHAR(50) NOT NULL DEFAULT '';
+
+CREATE INDEX users_tier ON users (tier);
+
+CREATE TABLE tier_history
+(
+    id             SERIAL PRIMARY KEY,
+    user_id        VARCHAR(255)     NOT NULL,
+    from_tier      VARCHAR(50)      NOT NULL,
+    to_tier        VARCHAR(50)      NOT NULL,
+    rolling_volume DOUBLE PRECISION NOT NULL,
+    created_at     TIMESTAMP        NOT NULL
+);
+
+CREATE INDEX tier_history_user_id_created_at ON tier_history (user_id, created_at);
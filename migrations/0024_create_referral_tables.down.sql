@@ -0,0 +1,5 @@
+DROP TABLE referral_rewards;
+
+DROP TABLE referrals;
+
+DROP TABLE referral_codes;
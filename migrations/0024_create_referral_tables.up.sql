@@ -0,0 +1,25 @@
+CREATE TABLE referral_codes
+(
+    code       VARCHAR(32)  PRIMARY KEY,
+    user_id    VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP    NOT NULL
+);
+
+CREATE TABLE referrals
+(
+    referee_id   VARCHAR(255) PRIMARY KEY,
+    referrer_id  VARCHAR(255) NOT NULL,
+    code         VARCHAR(32)  NOT NULL REFERENCES referral_codes (code),
+    created_at   TIMESTAMP    NOT NULL,
+    onboarded_at TIMESTAMP
+);
+
+CREATE INDEX referrals_referrer_id ON referrals (referrer_id);
+
+-- the shared pool task of a referee pays its referrer once
+CREATE TABLE referral_rewards
+(
+    task_id     INTEGER      NOT NULL PRIMARY KEY,
+    referrer_id VARCHAR(255) NOT NULL,
+    created_at  TIMESTAMP    NOT NULL
+);
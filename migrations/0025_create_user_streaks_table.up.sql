@@ -0,0 +1,9 @@
+CREATE TABLE user_streaks
+(
+    user_id     VARCHAR(255) NOT NULL,
+    period      VARCHAR(50)  NOT NULL,
+    current     INTEGER      NOT NULL,
+    best        INTEGER      NOT NULL,
+    last_period DATE         NOT NULL,
+    PRIMARY KEY (user_id, period)
+);
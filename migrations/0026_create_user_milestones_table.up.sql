@@ -0,0 +1,14 @@
+CREATE TABLE user_milestones
+(
+    user_id    VARCHAR(255) NOT NULL,
+    milestone  VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP    NOT NULL,
+    PRIMARY KEY (user_id, milestone)
+);
+
+-- onboarding is the milestone of a campaign, users onboarded already achieved it
+INSERT INTO user_milestones (user_id, milestone, created_at)
+SELECT user_id, 'on_boarding:' || campaign_id, MIN(created_at)
+FROM tasks
+WHERE type = 'on_boarding'
+GROUP BY user_id, campaign_id;
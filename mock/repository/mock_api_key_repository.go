@@ -0,0 +1,258 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAPIKeyRepository is an autogenerated mock type for the APIKeyRepository type
+type MockAPIKeyRepository struct {
+	mock.Mock
+}
+
+type MockAPIKeyRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyRepository) EXPECT() *MockAPIKeyRepository_Expecter {
+	return &MockAPIKeyRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAPIKey provides a mock function with given fields: apiKey
+func (_m *MockAPIKeyRepository) CreateAPIKey(apiKey *model.APIKey) (*model.APIKey, error) {
+	ret := _m.Called(apiKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.APIKey) (*model.APIKey, error)); ok {
+		return rf(apiKey)
+	}
+	if rf, ok := ret.Get(0).(func(*model.APIKey) *model.APIKey); ok {
+		r0 = rf(apiKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.APIKey) error); ok {
+		r1 = rf(apiKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockAPIKeyRepository_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - apiKey *model.APIKey
+func (_e *MockAPIKeyRepository_Expecter) CreateAPIKey(apiKey interface{}) *MockAPIKeyRepository_CreateAPIKey_Call {
+	return &MockAPIKeyRepository_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", apiKey)}
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) Run(run func(apiKey *model.APIKey)) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.APIKey))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) Return(_a0 *model.APIKey, _a1 error) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_CreateAPIKey_Call) RunAndReturn(run func(*model.APIKey) (*model.APIKey, error)) *MockAPIKeyRepository_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveAPIKeyByHash provides a mock function with given fields: keyHash
+func (_m *MockAPIKeyRepository) GetActiveAPIKeyByHash(keyHash string) (*model.APIKey, error) {
+	ret := _m.Called(keyHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveAPIKeyByHash")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.APIKey, error)); ok {
+		return rf(keyHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.APIKey); ok {
+		r0 = rf(keyHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(keyHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_GetActiveAPIKeyByHash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveAPIKeyByHash'
+type MockAPIKeyRepository_GetActiveAPIKeyByHash_Call struct {
+	*mock.Call
+}
+
+// GetActiveAPIKeyByHash is a helper method to define mock.On call
+//   - keyHash string
+func (_e *MockAPIKeyRepository_Expecter) GetActiveAPIKeyByHash(keyHash interface{}) *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call {
+	return &MockAPIKeyRepository_GetActiveAPIKeyByHash_Call{Call: _e.mock.On("GetActiveAPIKeyByHash", keyHash)}
+}
+
+func (_c *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call) Run(run func(keyHash string)) *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call) Return(_a0 *model.APIKey, _a1 error) *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call) RunAndReturn(run func(string) (*model.APIKey, error)) *MockAPIKeyRepository_GetActiveAPIKeyByHash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: id, revokedAt
+func (_m *MockAPIKeyRepository) RevokeAPIKey(id int, revokedAt time.Time) error {
+	ret := _m.Called(id, revokedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, time.Time) error); ok {
+		r0 = rf(id, revokedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyRepository_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockAPIKeyRepository_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - id int
+//   - revokedAt time.Time
+func (_e *MockAPIKeyRepository_Expecter) RevokeAPIKey(id interface{}, revokedAt interface{}) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	return &MockAPIKeyRepository_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", id, revokedAt)}
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) Run(run func(id int, revokedAt time.Time)) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) Return(_a0 error) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_RevokeAPIKey_Call) RunAndReturn(run func(int, time.Time) error) *MockAPIKeyRepository_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAPIKeys provides a mock function with given fields:
+func (_m *MockAPIKeyRepository) SearchAPIKeys() ([]*model.APIKey, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAPIKeys")
+	}
+
+	var r0 []*model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.APIKey, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.APIKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyRepository_SearchAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAPIKeys'
+type MockAPIKeyRepository_SearchAPIKeys_Call struct {
+	*mock.Call
+}
+
+// SearchAPIKeys is a helper method to define mock.On call
+func (_e *MockAPIKeyRepository_Expecter) SearchAPIKeys() *MockAPIKeyRepository_SearchAPIKeys_Call {
+	return &MockAPIKeyRepository_SearchAPIKeys_Call{Call: _e.mock.On("SearchAPIKeys")}
+}
+
+func (_c *MockAPIKeyRepository_SearchAPIKeys_Call) Run(run func()) *MockAPIKeyRepository_SearchAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_SearchAPIKeys_Call) Return(_a0 []*model.APIKey, _a1 error) *MockAPIKeyRepository_SearchAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyRepository_SearchAPIKeys_Call) RunAndReturn(run func() ([]*model.APIKey, error)) *MockAPIKeyRepository_SearchAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyRepository creates a new instance of MockAPIKeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyRepository {
+	mock := &MockAPIKeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
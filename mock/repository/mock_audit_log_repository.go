@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuditLogRepository is an autogenerated mock type for the AuditLogRepository type
+type MockAuditLogRepository struct {
+	mock.Mock
+}
+
+type MockAuditLogRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditLogRepository) EXPECT() *MockAuditLogRepository_Expecter {
+	return &MockAuditLogRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateAuditLog provides a mock function with given fields: auditLog
+func (_m *MockAuditLogRepository) CreateAuditLog(auditLog *model.AuditLog) (*model.AuditLog, error) {
+	ret := _m.Called(auditLog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAuditLog")
+	}
+
+	var r0 *model.AuditLog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AuditLog) (*model.AuditLog, error)); ok {
+		return rf(auditLog)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AuditLog) *model.AuditLog); ok {
+		r0 = rf(auditLog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuditLog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AuditLog) error); ok {
+		r1 = rf(auditLog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuditLogRepository_CreateAuditLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAuditLog'
+type MockAuditLogRepository_CreateAuditLog_Call struct {
+	*mock.Call
+}
+
+// CreateAuditLog is a helper method to define mock.On call
+//   - auditLog *model.AuditLog
+func (_e *MockAuditLogRepository_Expecter) CreateAuditLog(auditLog interface{}) *MockAuditLogRepository_CreateAuditLog_Call {
+	return &MockAuditLogRepository_CreateAuditLog_Call{Call: _e.mock.On("CreateAuditLog", auditLog)}
+}
+
+func (_c *MockAuditLogRepository_CreateAuditLog_Call) Run(run func(auditLog *model.AuditLog)) *MockAuditLogRepository_CreateAuditLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockAuditLogRepository_CreateAuditLog_Call) Return(_a0 *model.AuditLog, _a1 error) *MockAuditLogRepository_CreateAuditLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuditLogRepository_CreateAuditLog_Call) RunAndReturn(run func(*model.AuditLog) (*model.AuditLog, error)) *MockAuditLogRepository_CreateAuditLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditLogRepository creates a new instance of MockAuditLogRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditLogRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditLogRepository {
+	mock := &MockAuditLogRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
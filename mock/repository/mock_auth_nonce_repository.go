@@ -0,0 +1,130 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAuthNonceRepository is an autogenerated mock type for the AuthNonceRepository type
+type MockAuthNonceRepository struct {
+	mock.Mock
+}
+
+type MockAuthNonceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthNonceRepository) EXPECT() *MockAuthNonceRepository_Expecter {
+	return &MockAuthNonceRepository_Expecter{mock: &_m.Mock}
+}
+
+// ConsumeNonce provides a mock function with given fields: nonce, now
+func (_m *MockAuthNonceRepository) ConsumeNonce(nonce string, now time.Time) error {
+	ret := _m.Called(nonce, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeNonce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(nonce, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthNonceRepository_ConsumeNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeNonce'
+type MockAuthNonceRepository_ConsumeNonce_Call struct {
+	*mock.Call
+}
+
+// ConsumeNonce is a helper method to define mock.On call
+//   - nonce string
+//   - now time.Time
+func (_e *MockAuthNonceRepository_Expecter) ConsumeNonce(nonce interface{}, now interface{}) *MockAuthNonceRepository_ConsumeNonce_Call {
+	return &MockAuthNonceRepository_ConsumeNonce_Call{Call: _e.mock.On("ConsumeNonce", nonce, now)}
+}
+
+func (_c *MockAuthNonceRepository_ConsumeNonce_Call) Run(run func(nonce string, now time.Time)) *MockAuthNonceRepository_ConsumeNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthNonceRepository_ConsumeNonce_Call) Return(_a0 error) *MockAuthNonceRepository_ConsumeNonce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthNonceRepository_ConsumeNonce_Call) RunAndReturn(run func(string, time.Time) error) *MockAuthNonceRepository_ConsumeNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateNonce provides a mock function with given fields: nonce, expiresAt
+func (_m *MockAuthNonceRepository) CreateNonce(nonce string, expiresAt time.Time) error {
+	ret := _m.Called(nonce, expiresAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNonce")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) error); ok {
+		r0 = rf(nonce, expiresAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuthNonceRepository_CreateNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNonce'
+type MockAuthNonceRepository_CreateNonce_Call struct {
+	*mock.Call
+}
+
+// CreateNonce is a helper method to define mock.On call
+//   - nonce string
+//   - expiresAt time.Time
+func (_e *MockAuthNonceRepository_Expecter) CreateNonce(nonce interface{}, expiresAt interface{}) *MockAuthNonceRepository_CreateNonce_Call {
+	return &MockAuthNonceRepository_CreateNonce_Call{Call: _e.mock.On("CreateNonce", nonce, expiresAt)}
+}
+
+func (_c *MockAuthNonceRepository_CreateNonce_Call) Run(run func(nonce string, expiresAt time.Time)) *MockAuthNonceRepository_CreateNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthNonceRepository_CreateNonce_Call) Return(_a0 error) *MockAuthNonceRepository_CreateNonce_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuthNonceRepository_CreateNonce_Call) RunAndReturn(run func(string, time.Time) error) *MockAuthNonceRepository_CreateNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthNonceRepository creates a new instance of MockAuthNonceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthNonceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthNonceRepository {
+	mock := &MockAuthNonceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
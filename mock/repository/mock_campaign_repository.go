@@ -0,0 +1,270 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "trading-ace/src/repository"
+)
+
+// MockCampaignRepository is an autogenerated mock type for the CampaignRepository type
+type MockCampaignRepository struct {
+	mock.Mock
+}
+
+type MockCampaignRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignRepository) EXPECT() *MockCampaignRepository_Expecter {
+	return &MockCampaignRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCampaign provides a mock function with given fields: campaign
+func (_m *MockCampaignRepository) CreateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign) (*model.Campaign, error)); ok {
+		return rf(campaign)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign) *model.Campaign); ok {
+		r0 = rf(campaign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign) error); ok {
+		r1 = rf(campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_CreateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampaign'
+type MockCampaignRepository_CreateCampaign_Call struct {
+	*mock.Call
+}
+
+// CreateCampaign is a helper method to define mock.On call
+//   - campaign *model.Campaign
+func (_e *MockCampaignRepository_Expecter) CreateCampaign(campaign interface{}) *MockCampaignRepository_CreateCampaign_Call {
+	return &MockCampaignRepository_CreateCampaign_Call{Call: _e.mock.On("CreateCampaign", campaign)}
+}
+
+func (_c *MockCampaignRepository_CreateCampaign_Call) Run(run func(campaign *model.Campaign)) *MockCampaignRepository_CreateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_CreateCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignRepository_CreateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_CreateCampaign_Call) RunAndReturn(run func(*model.Campaign) (*model.Campaign, error)) *MockCampaignRepository_CreateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaignByID provides a mock function with given fields: campaignID
+func (_m *MockCampaignRepository) GetCampaignByID(campaignID int) (*model.Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaignByID")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Campaign, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_GetCampaignByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaignByID'
+type MockCampaignRepository_GetCampaignByID_Call struct {
+	*mock.Call
+}
+
+// GetCampaignByID is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockCampaignRepository_Expecter) GetCampaignByID(campaignID interface{}) *MockCampaignRepository_GetCampaignByID_Call {
+	return &MockCampaignRepository_GetCampaignByID_Call{Call: _e.mock.On("GetCampaignByID", campaignID)}
+}
+
+func (_c *MockCampaignRepository_GetCampaignByID_Call) Run(run func(campaignID int)) *MockCampaignRepository_GetCampaignByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_GetCampaignByID_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignRepository_GetCampaignByID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_GetCampaignByID_Call) RunAndReturn(run func(int) (*model.Campaign, error)) *MockCampaignRepository_GetCampaignByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchCampaigns provides a mock function with given fields: condition
+func (_m *MockCampaignRepository) SearchCampaigns(condition *repository.SearchCampaignsCondition) ([]*model.Campaign, error) {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCampaigns")
+	}
+
+	var r0 []*model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*repository.SearchCampaignsCondition) ([]*model.Campaign, error)); ok {
+		return rf(condition)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.SearchCampaignsCondition) []*model.Campaign); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.SearchCampaignsCondition) error); ok {
+		r1 = rf(condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_SearchCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCampaigns'
+type MockCampaignRepository_SearchCampaigns_Call struct {
+	*mock.Call
+}
+
+// SearchCampaigns is a helper method to define mock.On call
+//   - condition *repository.SearchCampaignsCondition
+func (_e *MockCampaignRepository_Expecter) SearchCampaigns(condition interface{}) *MockCampaignRepository_SearchCampaigns_Call {
+	return &MockCampaignRepository_SearchCampaigns_Call{Call: _e.mock.On("SearchCampaigns", condition)}
+}
+
+func (_c *MockCampaignRepository_SearchCampaigns_Call) Run(run func(condition *repository.SearchCampaignsCondition)) *MockCampaignRepository_SearchCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.SearchCampaignsCondition))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_SearchCampaigns_Call) Return(_a0 []*model.Campaign, _a1 error) *MockCampaignRepository_SearchCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_SearchCampaigns_Call) RunAndReturn(run func(*repository.SearchCampaignsCondition) ([]*model.Campaign, error)) *MockCampaignRepository_SearchCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCampaign provides a mock function with given fields: campaign
+func (_m *MockCampaignRepository) UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign) (*model.Campaign, error)); ok {
+		return rf(campaign)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign) *model.Campaign); ok {
+		r0 = rf(campaign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign) error); ok {
+		r1 = rf(campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignRepository_UpdateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCampaign'
+type MockCampaignRepository_UpdateCampaign_Call struct {
+	*mock.Call
+}
+
+// UpdateCampaign is a helper method to define mock.On call
+//   - campaign *model.Campaign
+func (_e *MockCampaignRepository_Expecter) UpdateCampaign(campaign interface{}) *MockCampaignRepository_UpdateCampaign_Call {
+	return &MockCampaignRepository_UpdateCampaign_Call{Call: _e.mock.On("UpdateCampaign", campaign)}
+}
+
+func (_c *MockCampaignRepository_UpdateCampaign_Call) Run(run func(campaign *model.Campaign)) *MockCampaignRepository_UpdateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignRepository_UpdateCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignRepository_UpdateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignRepository_UpdateCampaign_Call) RunAndReturn(run func(*model.Campaign) (*model.Campaign, error)) *MockCampaignRepository_UpdateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignRepository creates a new instance of MockCampaignRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignRepository {
+	mock := &MockCampaignRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
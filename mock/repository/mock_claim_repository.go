@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockClaimRepository is an autogenerated mock type for the ClaimRepository type
+type MockClaimRepository struct {
+	mock.Mock
+}
+
+type MockClaimRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimRepository) EXPECT() *MockClaimRepository_Expecter {
+	return &MockClaimRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateDistribution provides a mock function with given fields: distribution, claims
+func (_m *MockClaimRepository) CreateDistribution(distribution *model.MerkleDistribution, claims []*model.Claim) (*model.MerkleDistribution, error) {
+	ret := _m.Called(distribution, claims)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateDistribution")
+	}
+
+	var r0 *model.MerkleDistribution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.MerkleDistribution, []*model.Claim) (*model.MerkleDistribution, error)); ok {
+		return rf(distribution, claims)
+	}
+	if rf, ok := ret.Get(0).(func(*model.MerkleDistribution, []*model.Claim) *model.MerkleDistribution); ok {
+		r0 = rf(distribution, claims)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MerkleDistribution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.MerkleDistribution, []*model.Claim) error); ok {
+		r1 = rf(distribution, claims)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimRepository_CreateDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateDistribution'
+type MockClaimRepository_CreateDistribution_Call struct {
+	*mock.Call
+}
+
+// CreateDistribution is a helper method to define mock.On call
+//   - distribution *model.MerkleDistribution
+//   - claims []*model.Claim
+func (_e *MockClaimRepository_Expecter) CreateDistribution(distribution interface{}, claims interface{}) *MockClaimRepository_CreateDistribution_Call {
+	return &MockClaimRepository_CreateDistribution_Call{Call: _e.mock.On("CreateDistribution", distribution, claims)}
+}
+
+func (_c *MockClaimRepository_CreateDistribution_Call) Run(run func(distribution *model.MerkleDistribution, claims []*model.Claim)) *MockClaimRepository_CreateDistribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.MerkleDistribution), args[1].([]*model.Claim))
+	})
+	return _c
+}
+
+func (_c *MockClaimRepository_CreateDistribution_Call) Return(_a0 *model.MerkleDistribution, _a1 error) *MockClaimRepository_CreateDistribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimRepository_CreateDistribution_Call) RunAndReturn(run func(*model.MerkleDistribution, []*model.Claim) (*model.MerkleDistribution, error)) *MockClaimRepository_CreateDistribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLatestClaims provides a mock function with given fields: address
+func (_m *MockClaimRepository) SearchLatestClaims(address string) ([]*model.ClaimOfCampaign, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLatestClaims")
+	}
+
+	var r0 []*model.ClaimOfCampaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.ClaimOfCampaign, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.ClaimOfCampaign); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ClaimOfCampaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimRepository_SearchLatestClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLatestClaims'
+type MockClaimRepository_SearchLatestClaims_Call struct {
+	*mock.Call
+}
+
+// SearchLatestClaims is a helper method to define mock.On call
+//   - address string
+func (_e *MockClaimRepository_Expecter) SearchLatestClaims(address interface{}) *MockClaimRepository_SearchLatestClaims_Call {
+	return &MockClaimRepository_SearchLatestClaims_Call{Call: _e.mock.On("SearchLatestClaims", address)}
+}
+
+func (_c *MockClaimRepository_SearchLatestClaims_Call) Run(run func(address string)) *MockClaimRepository_SearchLatestClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClaimRepository_SearchLatestClaims_Call) Return(_a0 []*model.ClaimOfCampaign, _a1 error) *MockClaimRepository_SearchLatestClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimRepository_SearchLatestClaims_Call) RunAndReturn(run func(string) ([]*model.ClaimOfCampaign, error)) *MockClaimRepository_SearchLatestClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimRepository creates a new instance of MockClaimRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimRepository {
+	mock := &MockClaimRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,447 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockLedgerRepository is an autogenerated mock type for the LedgerRepository type
+type MockLedgerRepository struct {
+	mock.Mock
+}
+
+type MockLedgerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLedgerRepository) EXPECT() *MockLedgerRepository_Expecter {
+	return &MockLedgerRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimPoints provides a mock function with given fields: userID, now
+func (_m *MockLedgerRepository) ClaimPoints(userID string, now time.Time) (float64, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimPoints")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (float64, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) float64); ok {
+		r0 = rf(userID, now)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_ClaimPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimPoints'
+type MockLedgerRepository_ClaimPoints_Call struct {
+	*mock.Call
+}
+
+// ClaimPoints is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockLedgerRepository_Expecter) ClaimPoints(userID interface{}, now interface{}) *MockLedgerRepository_ClaimPoints_Call {
+	return &MockLedgerRepository_ClaimPoints_Call{Call: _e.mock.On("ClaimPoints", userID, now)}
+}
+
+func (_c *MockLedgerRepository_ClaimPoints_Call) Run(run func(userID string, now time.Time)) *MockLedgerRepository_ClaimPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_ClaimPoints_Call) Return(_a0 float64, _a1 error) *MockLedgerRepository_ClaimPoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_ClaimPoints_Call) RunAndReturn(run func(string, time.Time) (float64, error)) *MockLedgerRepository_ClaimPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpirePoints provides a mock function with given fields: userID, now, expire
+func (_m *MockLedgerRepository) ExpirePoints(userID string, now time.Time, expire func([]*model.PointLot, float64) float64) (float64, error) {
+	ret := _m.Called(userID, now, expire)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePoints")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, func([]*model.PointLot, float64) float64) (float64, error)); ok {
+		return rf(userID, now, expire)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, func([]*model.PointLot, float64) float64) float64); ok {
+		r0 = rf(userID, now, expire)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, func([]*model.PointLot, float64) float64) error); ok {
+		r1 = rf(userID, now, expire)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_ExpirePoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePoints'
+type MockLedgerRepository_ExpirePoints_Call struct {
+	*mock.Call
+}
+
+// ExpirePoints is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+//   - expire func([]*model.PointLot , float64) float64
+func (_e *MockLedgerRepository_Expecter) ExpirePoints(userID interface{}, now interface{}, expire interface{}) *MockLedgerRepository_ExpirePoints_Call {
+	return &MockLedgerRepository_ExpirePoints_Call{Call: _e.mock.On("ExpirePoints", userID, now, expire)}
+}
+
+func (_c *MockLedgerRepository_ExpirePoints_Call) Run(run func(userID string, now time.Time, expire func([]*model.PointLot, float64) float64)) *MockLedgerRepository_ExpirePoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time), args[2].(func([]*model.PointLot, float64) float64))
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_ExpirePoints_Call) Return(_a0 float64, _a1 error) *MockLedgerRepository_ExpirePoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_ExpirePoints_Call) RunAndReturn(run func(string, time.Time, func([]*model.PointLot, float64) float64) (float64, error)) *MockLedgerRepository_ExpirePoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPointLots provides a mock function with given fields: userID
+func (_m *MockLedgerRepository) GetPointLots(userID string) ([]*model.PointLot, float64, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPointLots")
+	}
+
+	var r0 []*model.PointLot
+	var r1 float64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.PointLot, float64, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.PointLot); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PointLot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) float64); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Get(1).(float64)
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockLedgerRepository_GetPointLots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPointLots'
+type MockLedgerRepository_GetPointLots_Call struct {
+	*mock.Call
+}
+
+// GetPointLots is a helper method to define mock.On call
+//   - userID string
+func (_e *MockLedgerRepository_Expecter) GetPointLots(userID interface{}) *MockLedgerRepository_GetPointLots_Call {
+	return &MockLedgerRepository_GetPointLots_Call{Call: _e.mock.On("GetPointLots", userID)}
+}
+
+func (_c *MockLedgerRepository_GetPointLots_Call) Run(run func(userID string)) *MockLedgerRepository_GetPointLots_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetPointLots_Call) Return(_a0 []*model.PointLot, _a1 float64, _a2 error) *MockLedgerRepository_GetPointLots_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockLedgerRepository_GetPointLots_Call) RunAndReturn(run func(string) ([]*model.PointLot, float64, error)) *MockLedgerRepository_GetPointLots_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshBalanceMismatches provides a mock function with given fields: now
+func (_m *MockLedgerRepository) RefreshBalanceMismatches(now time.Time) ([]*model.BalanceMismatch, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshBalanceMismatches")
+	}
+
+	var r0 []*model.BalanceMismatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*model.BalanceMismatch, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*model.BalanceMismatch); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BalanceMismatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_RefreshBalanceMismatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshBalanceMismatches'
+type MockLedgerRepository_RefreshBalanceMismatches_Call struct {
+	*mock.Call
+}
+
+// RefreshBalanceMismatches is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockLedgerRepository_Expecter) RefreshBalanceMismatches(now interface{}) *MockLedgerRepository_RefreshBalanceMismatches_Call {
+	return &MockLedgerRepository_RefreshBalanceMismatches_Call{Call: _e.mock.On("RefreshBalanceMismatches", now)}
+}
+
+func (_c *MockLedgerRepository_RefreshBalanceMismatches_Call) Run(run func(now time.Time)) *MockLedgerRepository_RefreshBalanceMismatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_RefreshBalanceMismatches_Call) Return(_a0 []*model.BalanceMismatch, _a1 error) *MockLedgerRepository_RefreshBalanceMismatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_RefreshBalanceMismatches_Call) RunAndReturn(run func(time.Time) ([]*model.BalanceMismatch, error)) *MockLedgerRepository_RefreshBalanceMismatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchBalanceMismatches provides a mock function with given fields:
+func (_m *MockLedgerRepository) SearchBalanceMismatches() ([]*model.BalanceMismatch, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchBalanceMismatches")
+	}
+
+	var r0 []*model.BalanceMismatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.BalanceMismatch, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.BalanceMismatch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BalanceMismatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_SearchBalanceMismatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchBalanceMismatches'
+type MockLedgerRepository_SearchBalanceMismatches_Call struct {
+	*mock.Call
+}
+
+// SearchBalanceMismatches is a helper method to define mock.On call
+func (_e *MockLedgerRepository_Expecter) SearchBalanceMismatches() *MockLedgerRepository_SearchBalanceMismatches_Call {
+	return &MockLedgerRepository_SearchBalanceMismatches_Call{Call: _e.mock.On("SearchBalanceMismatches")}
+}
+
+func (_c *MockLedgerRepository_SearchBalanceMismatches_Call) Run(run func()) *MockLedgerRepository_SearchBalanceMismatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_SearchBalanceMismatches_Call) Return(_a0 []*model.BalanceMismatch, _a1 error) *MockLedgerRepository_SearchBalanceMismatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_SearchBalanceMismatches_Call) RunAndReturn(run func() ([]*model.BalanceMismatch, error)) *MockLedgerRepository_SearchBalanceMismatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUsersWithBalance provides a mock function with given fields:
+func (_m *MockLedgerRepository) SearchUsersWithBalance() ([]string, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUsersWithBalance")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]string, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_SearchUsersWithBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUsersWithBalance'
+type MockLedgerRepository_SearchUsersWithBalance_Call struct {
+	*mock.Call
+}
+
+// SearchUsersWithBalance is a helper method to define mock.On call
+func (_e *MockLedgerRepository_Expecter) SearchUsersWithBalance() *MockLedgerRepository_SearchUsersWithBalance_Call {
+	return &MockLedgerRepository_SearchUsersWithBalance_Call{Call: _e.mock.On("SearchUsersWithBalance")}
+}
+
+func (_c *MockLedgerRepository_SearchUsersWithBalance_Call) Run(run func()) *MockLedgerRepository_SearchUsersWithBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_SearchUsersWithBalance_Call) Return(_a0 []string, _a1 error) *MockLedgerRepository_SearchUsersWithBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_SearchUsersWithBalance_Call) RunAndReturn(run func() ([]string, error)) *MockLedgerRepository_SearchUsersWithBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumClaimedPoints provides a mock function with given fields:
+func (_m *MockLedgerRepository) SumClaimedPoints() (map[string]float64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumClaimedPoints")
+	}
+
+	var r0 map[string]float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string]float64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string]float64); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerRepository_SumClaimedPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumClaimedPoints'
+type MockLedgerRepository_SumClaimedPoints_Call struct {
+	*mock.Call
+}
+
+// SumClaimedPoints is a helper method to define mock.On call
+func (_e *MockLedgerRepository_Expecter) SumClaimedPoints() *MockLedgerRepository_SumClaimedPoints_Call {
+	return &MockLedgerRepository_SumClaimedPoints_Call{Call: _e.mock.On("SumClaimedPoints")}
+}
+
+func (_c *MockLedgerRepository_SumClaimedPoints_Call) Run(run func()) *MockLedgerRepository_SumClaimedPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLedgerRepository_SumClaimedPoints_Call) Return(_a0 map[string]float64, _a1 error) *MockLedgerRepository_SumClaimedPoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerRepository_SumClaimedPoints_Call) RunAndReturn(run func() (map[string]float64, error)) *MockLedgerRepository_SumClaimedPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLedgerRepository creates a new instance of MockLedgerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLedgerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLedgerRepository {
+	mock := &MockLedgerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,199 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMilestoneRepository is an autogenerated mock type for the MilestoneRepository type
+type MockMilestoneRepository struct {
+	mock.Mock
+}
+
+type MockMilestoneRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMilestoneRepository) EXPECT() *MockMilestoneRepository_Expecter {
+	return &MockMilestoneRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimMilestone provides a mock function with given fields: userID, milestone, at
+func (_m *MockMilestoneRepository) ClaimMilestone(userID string, milestone string, at time.Time) (bool, error) {
+	ret := _m.Called(userID, milestone, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimMilestone")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) (bool, error)); ok {
+		return rf(userID, milestone, at)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) bool); ok {
+		r0 = rf(userID, milestone, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(userID, milestone, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilestoneRepository_ClaimMilestone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimMilestone'
+type MockMilestoneRepository_ClaimMilestone_Call struct {
+	*mock.Call
+}
+
+// ClaimMilestone is a helper method to define mock.On call
+//   - userID string
+//   - milestone string
+//   - at time.Time
+func (_e *MockMilestoneRepository_Expecter) ClaimMilestone(userID interface{}, milestone interface{}, at interface{}) *MockMilestoneRepository_ClaimMilestone_Call {
+	return &MockMilestoneRepository_ClaimMilestone_Call{Call: _e.mock.On("ClaimMilestone", userID, milestone, at)}
+}
+
+func (_c *MockMilestoneRepository_ClaimMilestone_Call) Run(run func(userID string, milestone string, at time.Time)) *MockMilestoneRepository_ClaimMilestone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneRepository_ClaimMilestone_Call) Return(_a0 bool, _a1 error) *MockMilestoneRepository_ClaimMilestone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilestoneRepository_ClaimMilestone_Call) RunAndReturn(run func(string, string, time.Time) (bool, error)) *MockMilestoneRepository_ClaimMilestone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAchievedMilestones provides a mock function with given fields: userID
+func (_m *MockMilestoneRepository) GetAchievedMilestones(userID string) ([]string, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAchievedMilestones")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]string, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []string); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilestoneRepository_GetAchievedMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAchievedMilestones'
+type MockMilestoneRepository_GetAchievedMilestones_Call struct {
+	*mock.Call
+}
+
+// GetAchievedMilestones is a helper method to define mock.On call
+//   - userID string
+func (_e *MockMilestoneRepository_Expecter) GetAchievedMilestones(userID interface{}) *MockMilestoneRepository_GetAchievedMilestones_Call {
+	return &MockMilestoneRepository_GetAchievedMilestones_Call{Call: _e.mock.On("GetAchievedMilestones", userID)}
+}
+
+func (_c *MockMilestoneRepository_GetAchievedMilestones_Call) Run(run func(userID string)) *MockMilestoneRepository_GetAchievedMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneRepository_GetAchievedMilestones_Call) Return(_a0 []string, _a1 error) *MockMilestoneRepository_GetAchievedMilestones_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilestoneRepository_GetAchievedMilestones_Call) RunAndReturn(run func(string) ([]string, error)) *MockMilestoneRepository_GetAchievedMilestones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseMilestone provides a mock function with given fields: userID, milestone
+func (_m *MockMilestoneRepository) ReleaseMilestone(userID string, milestone string) error {
+	ret := _m.Called(userID, milestone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseMilestone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, milestone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMilestoneRepository_ReleaseMilestone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseMilestone'
+type MockMilestoneRepository_ReleaseMilestone_Call struct {
+	*mock.Call
+}
+
+// ReleaseMilestone is a helper method to define mock.On call
+//   - userID string
+//   - milestone string
+func (_e *MockMilestoneRepository_Expecter) ReleaseMilestone(userID interface{}, milestone interface{}) *MockMilestoneRepository_ReleaseMilestone_Call {
+	return &MockMilestoneRepository_ReleaseMilestone_Call{Call: _e.mock.On("ReleaseMilestone", userID, milestone)}
+}
+
+func (_c *MockMilestoneRepository_ReleaseMilestone_Call) Run(run func(userID string, milestone string)) *MockMilestoneRepository_ReleaseMilestone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneRepository_ReleaseMilestone_Call) Return(_a0 error) *MockMilestoneRepository_ReleaseMilestone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMilestoneRepository_ReleaseMilestone_Call) RunAndReturn(run func(string, string) error) *MockMilestoneRepository_ReleaseMilestone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMilestoneRepository creates a new instance of MockMilestoneRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMilestoneRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMilestoneRepository {
+	mock := &MockMilestoneRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
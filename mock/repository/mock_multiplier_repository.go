@@ -0,0 +1,200 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "trading-ace/src/repository"
+)
+
+// MockMultiplierRepository is an autogenerated mock type for the MultiplierRepository type
+type MockMultiplierRepository struct {
+	mock.Mock
+}
+
+type MockMultiplierRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMultiplierRepository) EXPECT() *MockMultiplierRepository_Expecter {
+	return &MockMultiplierRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateMultiplier provides a mock function with given fields: multiplier
+func (_m *MockMultiplierRepository) CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error) {
+	ret := _m.Called(multiplier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMultiplier")
+	}
+
+	var r0 *model.Multiplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Multiplier) (*model.Multiplier, error)); ok {
+		return rf(multiplier)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Multiplier) *model.Multiplier); ok {
+		r0 = rf(multiplier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Multiplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Multiplier) error); ok {
+		r1 = rf(multiplier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMultiplierRepository_CreateMultiplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMultiplier'
+type MockMultiplierRepository_CreateMultiplier_Call struct {
+	*mock.Call
+}
+
+// CreateMultiplier is a helper method to define mock.On call
+//   - multiplier *model.Multiplier
+func (_e *MockMultiplierRepository_Expecter) CreateMultiplier(multiplier interface{}) *MockMultiplierRepository_CreateMultiplier_Call {
+	return &MockMultiplierRepository_CreateMultiplier_Call{Call: _e.mock.On("CreateMultiplier", multiplier)}
+}
+
+func (_c *MockMultiplierRepository_CreateMultiplier_Call) Run(run func(multiplier *model.Multiplier)) *MockMultiplierRepository_CreateMultiplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Multiplier))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierRepository_CreateMultiplier_Call) Return(_a0 *model.Multiplier, _a1 error) *MockMultiplierRepository_CreateMultiplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMultiplierRepository_CreateMultiplier_Call) RunAndReturn(run func(*model.Multiplier) (*model.Multiplier, error)) *MockMultiplierRepository_CreateMultiplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMultiplier provides a mock function with given fields: id
+func (_m *MockMultiplierRepository) DeleteMultiplier(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMultiplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMultiplierRepository_DeleteMultiplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMultiplier'
+type MockMultiplierRepository_DeleteMultiplier_Call struct {
+	*mock.Call
+}
+
+// DeleteMultiplier is a helper method to define mock.On call
+//   - id int
+func (_e *MockMultiplierRepository_Expecter) DeleteMultiplier(id interface{}) *MockMultiplierRepository_DeleteMultiplier_Call {
+	return &MockMultiplierRepository_DeleteMultiplier_Call{Call: _e.mock.On("DeleteMultiplier", id)}
+}
+
+func (_c *MockMultiplierRepository_DeleteMultiplier_Call) Run(run func(id int)) *MockMultiplierRepository_DeleteMultiplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierRepository_DeleteMultiplier_Call) Return(_a0 error) *MockMultiplierRepository_DeleteMultiplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMultiplierRepository_DeleteMultiplier_Call) RunAndReturn(run func(int) error) *MockMultiplierRepository_DeleteMultiplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchMultipliers provides a mock function with given fields: condition
+func (_m *MockMultiplierRepository) SearchMultipliers(condition *repository.SearchMultipliersCondition) ([]*model.Multiplier, error) {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchMultipliers")
+	}
+
+	var r0 []*model.Multiplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*repository.SearchMultipliersCondition) ([]*model.Multiplier, error)); ok {
+		return rf(condition)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.SearchMultipliersCondition) []*model.Multiplier); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Multiplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.SearchMultipliersCondition) error); ok {
+		r1 = rf(condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMultiplierRepository_SearchMultipliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchMultipliers'
+type MockMultiplierRepository_SearchMultipliers_Call struct {
+	*mock.Call
+}
+
+// SearchMultipliers is a helper method to define mock.On call
+//   - condition *repository.SearchMultipliersCondition
+func (_e *MockMultiplierRepository_Expecter) SearchMultipliers(condition interface{}) *MockMultiplierRepository_SearchMultipliers_Call {
+	return &MockMultiplierRepository_SearchMultipliers_Call{Call: _e.mock.On("SearchMultipliers", condition)}
+}
+
+func (_c *MockMultiplierRepository_SearchMultipliers_Call) Run(run func(condition *repository.SearchMultipliersCondition)) *MockMultiplierRepository_SearchMultipliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.SearchMultipliersCondition))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierRepository_SearchMultipliers_Call) Return(_a0 []*model.Multiplier, _a1 error) *MockMultiplierRepository_SearchMultipliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMultiplierRepository_SearchMultipliers_Call) RunAndReturn(run func(*repository.SearchMultipliersCondition) ([]*model.Multiplier, error)) *MockMultiplierRepository_SearchMultipliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMultiplierRepository creates a new instance of MockMultiplierRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMultiplierRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMultiplierRepository {
+	mock := &MockMultiplierRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,379 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "trading-ace/src/repository"
+
+	time "time"
+)
+
+// MockPeriodStatsRepository is an autogenerated mock type for the PeriodStatsRepository type
+type MockPeriodStatsRepository struct {
+	mock.Mock
+}
+
+type MockPeriodStatsRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPeriodStatsRepository) EXPECT() *MockPeriodStatsRepository_Expecter {
+	return &MockPeriodStatsRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddSwap provides a mock function with given fields: swapID, stats
+func (_m *MockPeriodStatsRepository) AddSwap(swapID string, stats *model.UserPeriodStats) error {
+	ret := _m.Called(swapID, stats)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSwap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *model.UserPeriodStats) error); ok {
+		r0 = rf(swapID, stats)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPeriodStatsRepository_AddSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSwap'
+type MockPeriodStatsRepository_AddSwap_Call struct {
+	*mock.Call
+}
+
+// AddSwap is a helper method to define mock.On call
+//   - swapID string
+//   - stats *model.UserPeriodStats
+func (_e *MockPeriodStatsRepository_Expecter) AddSwap(swapID interface{}, stats interface{}) *MockPeriodStatsRepository_AddSwap_Call {
+	return &MockPeriodStatsRepository_AddSwap_Call{Call: _e.mock.On("AddSwap", swapID, stats)}
+}
+
+func (_c *MockPeriodStatsRepository_AddSwap_Call) Run(run func(swapID string, stats *model.UserPeriodStats)) *MockPeriodStatsRepository_AddSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*model.UserPeriodStats))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_AddSwap_Call) Return(_a0 error) *MockPeriodStatsRepository_AddSwap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_AddSwap_Call) RunAndReturn(run func(string, *model.UserPeriodStats) error) *MockPeriodStatsRepository_AddSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLeaderboardEntry provides a mock function with given fields: condition, userID
+func (_m *MockPeriodStatsRepository) GetLeaderboardEntry(condition *repository.LeaderboardCondition, userID string) (*model.LeaderboardEntry, error) {
+	ret := _m.Called(condition, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboardEntry")
+	}
+
+	var r0 *model.LeaderboardEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*repository.LeaderboardCondition, string) (*model.LeaderboardEntry, error)); ok {
+		return rf(condition, userID)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.LeaderboardCondition, string) *model.LeaderboardEntry); ok {
+		r0 = rf(condition, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.LeaderboardEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.LeaderboardCondition, string) error); ok {
+		r1 = rf(condition, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPeriodStatsRepository_GetLeaderboardEntry_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderboardEntry'
+type MockPeriodStatsRepository_GetLeaderboardEntry_Call struct {
+	*mock.Call
+}
+
+// GetLeaderboardEntry is a helper method to define mock.On call
+//   - condition *repository.LeaderboardCondition
+//   - userID string
+func (_e *MockPeriodStatsRepository_Expecter) GetLeaderboardEntry(condition interface{}, userID interface{}) *MockPeriodStatsRepository_GetLeaderboardEntry_Call {
+	return &MockPeriodStatsRepository_GetLeaderboardEntry_Call{Call: _e.mock.On("GetLeaderboardEntry", condition, userID)}
+}
+
+func (_c *MockPeriodStatsRepository_GetLeaderboardEntry_Call) Run(run func(condition *repository.LeaderboardCondition, userID string)) *MockPeriodStatsRepository_GetLeaderboardEntry_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.LeaderboardCondition), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetLeaderboardEntry_Call) Return(_a0 *model.LeaderboardEntry, _a1 error) *MockPeriodStatsRepository_GetLeaderboardEntry_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetLeaderboardEntry_Call) RunAndReturn(run func(*repository.LeaderboardCondition, string) (*model.LeaderboardEntry, error)) *MockPeriodStatsRepository_GetLeaderboardEntry_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPeriodTotals provides a mock function with given fields: campaignID, periodIndex
+func (_m *MockPeriodStatsRepository) GetPeriodTotals(campaignID int, periodIndex int) (*model.PeriodTotals, error) {
+	ret := _m.Called(campaignID, periodIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPeriodTotals")
+	}
+
+	var r0 *model.PeriodTotals
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*model.PeriodTotals, error)); ok {
+		return rf(campaignID, periodIndex)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *model.PeriodTotals); ok {
+		r0 = rf(campaignID, periodIndex)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PeriodTotals)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(campaignID, periodIndex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPeriodStatsRepository_GetPeriodTotals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPeriodTotals'
+type MockPeriodStatsRepository_GetPeriodTotals_Call struct {
+	*mock.Call
+}
+
+// GetPeriodTotals is a helper method to define mock.On call
+//   - campaignID int
+//   - periodIndex int
+func (_e *MockPeriodStatsRepository_Expecter) GetPeriodTotals(campaignID interface{}, periodIndex interface{}) *MockPeriodStatsRepository_GetPeriodTotals_Call {
+	return &MockPeriodStatsRepository_GetPeriodTotals_Call{Call: _e.mock.On("GetPeriodTotals", campaignID, periodIndex)}
+}
+
+func (_c *MockPeriodStatsRepository_GetPeriodTotals_Call) Run(run func(campaignID int, periodIndex int)) *MockPeriodStatsRepository_GetPeriodTotals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetPeriodTotals_Call) Return(_a0 *model.PeriodTotals, _a1 error) *MockPeriodStatsRepository_GetPeriodTotals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetPeriodTotals_Call) RunAndReturn(run func(int, int) (*model.PeriodTotals, error)) *MockPeriodStatsRepository_GetPeriodTotals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserPeriodStats provides a mock function with given fields: campaignID, periodIndex, userID
+func (_m *MockPeriodStatsRepository) GetUserPeriodStats(campaignID int, periodIndex int, userID string) (*model.UserPeriodStats, error) {
+	ret := _m.Called(campaignID, periodIndex, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserPeriodStats")
+	}
+
+	var r0 *model.UserPeriodStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string) (*model.UserPeriodStats, error)); ok {
+		return rf(campaignID, periodIndex, userID)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) *model.UserPeriodStats); ok {
+		r0 = rf(campaignID, periodIndex, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserPeriodStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = rf(campaignID, periodIndex, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPeriodStatsRepository_GetUserPeriodStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserPeriodStats'
+type MockPeriodStatsRepository_GetUserPeriodStats_Call struct {
+	*mock.Call
+}
+
+// GetUserPeriodStats is a helper method to define mock.On call
+//   - campaignID int
+//   - periodIndex int
+//   - userID string
+func (_e *MockPeriodStatsRepository_Expecter) GetUserPeriodStats(campaignID interface{}, periodIndex interface{}, userID interface{}) *MockPeriodStatsRepository_GetUserPeriodStats_Call {
+	return &MockPeriodStatsRepository_GetUserPeriodStats_Call{Call: _e.mock.On("GetUserPeriodStats", campaignID, periodIndex, userID)}
+}
+
+func (_c *MockPeriodStatsRepository_GetUserPeriodStats_Call) Run(run func(campaignID int, periodIndex int, userID string)) *MockPeriodStatsRepository_GetUserPeriodStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetUserPeriodStats_Call) Return(_a0 *model.UserPeriodStats, _a1 error) *MockPeriodStatsRepository_GetUserPeriodStats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_GetUserPeriodStats_Call) RunAndReturn(run func(int, int, string) (*model.UserPeriodStats, error)) *MockPeriodStatsRepository_GetUserPeriodStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshPeriodStats provides a mock function with given fields: campaignID, periodIndex, from, to
+func (_m *MockPeriodStatsRepository) RefreshPeriodStats(campaignID int, periodIndex int, from time.Time, to time.Time) error {
+	ret := _m.Called(campaignID, periodIndex, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPeriodStats")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, int, time.Time, time.Time) error); ok {
+		r0 = rf(campaignID, periodIndex, from, to)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPeriodStatsRepository_RefreshPeriodStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPeriodStats'
+type MockPeriodStatsRepository_RefreshPeriodStats_Call struct {
+	*mock.Call
+}
+
+// RefreshPeriodStats is a helper method to define mock.On call
+//   - campaignID int
+//   - periodIndex int
+//   - from time.Time
+//   - to time.Time
+func (_e *MockPeriodStatsRepository_Expecter) RefreshPeriodStats(campaignID interface{}, periodIndex interface{}, from interface{}, to interface{}) *MockPeriodStatsRepository_RefreshPeriodStats_Call {
+	return &MockPeriodStatsRepository_RefreshPeriodStats_Call{Call: _e.mock.On("RefreshPeriodStats", campaignID, periodIndex, from, to)}
+}
+
+func (_c *MockPeriodStatsRepository_RefreshPeriodStats_Call) Run(run func(campaignID int, periodIndex int, from time.Time, to time.Time)) *MockPeriodStatsRepository_RefreshPeriodStats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_RefreshPeriodStats_Call) Return(_a0 error) *MockPeriodStatsRepository_RefreshPeriodStats_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_RefreshPeriodStats_Call) RunAndReturn(run func(int, int, time.Time, time.Time) error) *MockPeriodStatsRepository_RefreshPeriodStats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchLeaderboard provides a mock function with given fields: condition
+func (_m *MockPeriodStatsRepository) SearchLeaderboard(condition *repository.LeaderboardCondition) ([]*model.LeaderboardEntry, int, error) {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchLeaderboard")
+	}
+
+	var r0 []*model.LeaderboardEntry
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*repository.LeaderboardCondition) ([]*model.LeaderboardEntry, int, error)); ok {
+		return rf(condition)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.LeaderboardCondition) []*model.LeaderboardEntry); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LeaderboardEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.LeaderboardCondition) int); ok {
+		r1 = rf(condition)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(*repository.LeaderboardCondition) error); ok {
+		r2 = rf(condition)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockPeriodStatsRepository_SearchLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchLeaderboard'
+type MockPeriodStatsRepository_SearchLeaderboard_Call struct {
+	*mock.Call
+}
+
+// SearchLeaderboard is a helper method to define mock.On call
+//   - condition *repository.LeaderboardCondition
+func (_e *MockPeriodStatsRepository_Expecter) SearchLeaderboard(condition interface{}) *MockPeriodStatsRepository_SearchLeaderboard_Call {
+	return &MockPeriodStatsRepository_SearchLeaderboard_Call{Call: _e.mock.On("SearchLeaderboard", condition)}
+}
+
+func (_c *MockPeriodStatsRepository_SearchLeaderboard_Call) Run(run func(condition *repository.LeaderboardCondition)) *MockPeriodStatsRepository_SearchLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.LeaderboardCondition))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_SearchLeaderboard_Call) Return(_a0 []*model.LeaderboardEntry, _a1 int, _a2 error) *MockPeriodStatsRepository_SearchLeaderboard_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockPeriodStatsRepository_SearchLeaderboard_Call) RunAndReturn(run func(*repository.LeaderboardCondition) ([]*model.LeaderboardEntry, int, error)) *MockPeriodStatsRepository_SearchLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPeriodStatsRepository creates a new instance of MockPeriodStatsRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPeriodStatsRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPeriodStatsRepository {
+	mock := &MockPeriodStatsRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,337 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "trading-ace/src/repository"
+
+	time "time"
+)
+
+// MockRedemptionRepository is an autogenerated mock type for the RedemptionRepository type
+type MockRedemptionRepository struct {
+	mock.Mock
+}
+
+type MockRedemptionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRedemptionRepository) EXPECT() *MockRedemptionRepository_Expecter {
+	return &MockRedemptionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CancelRedemption provides a mock function with given fields: id, reason, operator, now, audit
+func (_m *MockRedemptionRepository) CancelRedemption(id int, reason string, operator string, now time.Time, audit func(*model.Redemption) *model.AuditLog) (*model.Redemption, error) {
+	ret := _m.Called(id, reason, operator, now, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, string, time.Time, func(*model.Redemption) *model.AuditLog) (*model.Redemption, error)); ok {
+		return rf(id, reason, operator, now, audit)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, string, time.Time, func(*model.Redemption) *model.AuditLog) *model.Redemption); ok {
+		r0 = rf(id, reason, operator, now, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, string, time.Time, func(*model.Redemption) *model.AuditLog) error); ok {
+		r1 = rf(id, reason, operator, now, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionRepository_CancelRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRedemption'
+type MockRedemptionRepository_CancelRedemption_Call struct {
+	*mock.Call
+}
+
+// CancelRedemption is a helper method to define mock.On call
+//   - id int
+//   - reason string
+//   - operator string
+//   - now time.Time
+//   - audit func(*model.Redemption) *model.AuditLog
+func (_e *MockRedemptionRepository_Expecter) CancelRedemption(id interface{}, reason interface{}, operator interface{}, now interface{}, audit interface{}) *MockRedemptionRepository_CancelRedemption_Call {
+	return &MockRedemptionRepository_CancelRedemption_Call{Call: _e.mock.On("CancelRedemption", id, reason, operator, now, audit)}
+}
+
+func (_c *MockRedemptionRepository_CancelRedemption_Call) Run(run func(id int, reason string, operator string, now time.Time, audit func(*model.Redemption) *model.AuditLog)) *MockRedemptionRepository_CancelRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(string), args[3].(time.Time), args[4].(func(*model.Redemption) *model.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionRepository_CancelRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionRepository_CancelRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionRepository_CancelRedemption_Call) RunAndReturn(run func(int, string, string, time.Time, func(*model.Redemption) *model.AuditLog) (*model.Redemption, error)) *MockRedemptionRepository_CancelRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateRedemption provides a mock function with given fields: redemption
+func (_m *MockRedemptionRepository) CreateRedemption(redemption *model.Redemption) (*model.Redemption, error) {
+	ret := _m.Called(redemption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Redemption) (*model.Redemption, error)); ok {
+		return rf(redemption)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Redemption) *model.Redemption); ok {
+		r0 = rf(redemption)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Redemption) error); ok {
+		r1 = rf(redemption)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionRepository_CreateRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRedemption'
+type MockRedemptionRepository_CreateRedemption_Call struct {
+	*mock.Call
+}
+
+// CreateRedemption is a helper method to define mock.On call
+//   - redemption *model.Redemption
+func (_e *MockRedemptionRepository_Expecter) CreateRedemption(redemption interface{}) *MockRedemptionRepository_CreateRedemption_Call {
+	return &MockRedemptionRepository_CreateRedemption_Call{Call: _e.mock.On("CreateRedemption", redemption)}
+}
+
+func (_c *MockRedemptionRepository_CreateRedemption_Call) Run(run func(redemption *model.Redemption)) *MockRedemptionRepository_CreateRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Redemption))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionRepository_CreateRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionRepository_CreateRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionRepository_CreateRedemption_Call) RunAndReturn(run func(*model.Redemption) (*model.Redemption, error)) *MockRedemptionRepository_CreateRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FulfillRedemption provides a mock function with given fields: id, operator, now, audit
+func (_m *MockRedemptionRepository) FulfillRedemption(id int, operator string, now time.Time, audit func(*model.Redemption) *model.AuditLog) (*model.Redemption, error) {
+	ret := _m.Called(id, operator, now, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FulfillRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, time.Time, func(*model.Redemption) *model.AuditLog) (*model.Redemption, error)); ok {
+		return rf(id, operator, now, audit)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, time.Time, func(*model.Redemption) *model.AuditLog) *model.Redemption); ok {
+		r0 = rf(id, operator, now, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, time.Time, func(*model.Redemption) *model.AuditLog) error); ok {
+		r1 = rf(id, operator, now, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionRepository_FulfillRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfillRedemption'
+type MockRedemptionRepository_FulfillRedemption_Call struct {
+	*mock.Call
+}
+
+// FulfillRedemption is a helper method to define mock.On call
+//   - id int
+//   - operator string
+//   - now time.Time
+//   - audit func(*model.Redemption) *model.AuditLog
+func (_e *MockRedemptionRepository_Expecter) FulfillRedemption(id interface{}, operator interface{}, now interface{}, audit interface{}) *MockRedemptionRepository_FulfillRedemption_Call {
+	return &MockRedemptionRepository_FulfillRedemption_Call{Call: _e.mock.On("FulfillRedemption", id, operator, now, audit)}
+}
+
+func (_c *MockRedemptionRepository_FulfillRedemption_Call) Run(run func(id int, operator string, now time.Time, audit func(*model.Redemption) *model.AuditLog)) *MockRedemptionRepository_FulfillRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(time.Time), args[3].(func(*model.Redemption) *model.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionRepository_FulfillRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionRepository_FulfillRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionRepository_FulfillRedemption_Call) RunAndReturn(run func(int, string, time.Time, func(*model.Redemption) *model.AuditLog) (*model.Redemption, error)) *MockRedemptionRepository_FulfillRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRedemption provides a mock function with given fields: id
+func (_m *MockRedemptionRepository) GetRedemption(id int) (*model.Redemption, error) {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Redemption, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Redemption); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionRepository_GetRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRedemption'
+type MockRedemptionRepository_GetRedemption_Call struct {
+	*mock.Call
+}
+
+// GetRedemption is a helper method to define mock.On call
+//   - id int
+func (_e *MockRedemptionRepository_Expecter) GetRedemption(id interface{}) *MockRedemptionRepository_GetRedemption_Call {
+	return &MockRedemptionRepository_GetRedemption_Call{Call: _e.mock.On("GetRedemption", id)}
+}
+
+func (_c *MockRedemptionRepository_GetRedemption_Call) Run(run func(id int)) *MockRedemptionRepository_GetRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionRepository_GetRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionRepository_GetRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionRepository_GetRedemption_Call) RunAndReturn(run func(int) (*model.Redemption, error)) *MockRedemptionRepository_GetRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchRedemptions provides a mock function with given fields: condition
+func (_m *MockRedemptionRepository) SearchRedemptions(condition *repository.SearchRedemptionsCondition) ([]*model.Redemption, error) {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRedemptions")
+	}
+
+	var r0 []*model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*repository.SearchRedemptionsCondition) ([]*model.Redemption, error)); ok {
+		return rf(condition)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.SearchRedemptionsCondition) []*model.Redemption); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.SearchRedemptionsCondition) error); ok {
+		r1 = rf(condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionRepository_SearchRedemptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchRedemptions'
+type MockRedemptionRepository_SearchRedemptions_Call struct {
+	*mock.Call
+}
+
+// SearchRedemptions is a helper method to define mock.On call
+//   - condition *repository.SearchRedemptionsCondition
+func (_e *MockRedemptionRepository_Expecter) SearchRedemptions(condition interface{}) *MockRedemptionRepository_SearchRedemptions_Call {
+	return &MockRedemptionRepository_SearchRedemptions_Call{Call: _e.mock.On("SearchRedemptions", condition)}
+}
+
+func (_c *MockRedemptionRepository_SearchRedemptions_Call) Run(run func(condition *repository.SearchRedemptionsCondition)) *MockRedemptionRepository_SearchRedemptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.SearchRedemptionsCondition))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionRepository_SearchRedemptions_Call) Return(_a0 []*model.Redemption, _a1 error) *MockRedemptionRepository_SearchRedemptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionRepository_SearchRedemptions_Call) RunAndReturn(run func(*repository.SearchRedemptionsCondition) ([]*model.Redemption, error)) *MockRedemptionRepository_SearchRedemptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRedemptionRepository creates a new instance of MockRedemptionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRedemptionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRedemptionRepository {
+	mock := &MockRedemptionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,653 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockReferralRepository is an autogenerated mock type for the ReferralRepository type
+type MockReferralRepository struct {
+	mock.Mock
+}
+
+type MockReferralRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferralRepository) EXPECT() *MockReferralRepository_Expecter {
+	return &MockReferralRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimReward provides a mock function with given fields: taskID, referrerID, at
+func (_m *MockReferralRepository) ClaimReward(taskID int, referrerID string, at time.Time) (bool, error) {
+	ret := _m.Called(taskID, referrerID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimReward")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) (bool, error)); ok {
+		return rf(taskID, referrerID, at)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) bool); ok {
+		r0 = rf(taskID, referrerID, at)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, time.Time) error); ok {
+		r1 = rf(taskID, referrerID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_ClaimReward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimReward'
+type MockReferralRepository_ClaimReward_Call struct {
+	*mock.Call
+}
+
+// ClaimReward is a helper method to define mock.On call
+//   - taskID int
+//   - referrerID string
+//   - at time.Time
+func (_e *MockReferralRepository_Expecter) ClaimReward(taskID interface{}, referrerID interface{}, at interface{}) *MockReferralRepository_ClaimReward_Call {
+	return &MockReferralRepository_ClaimReward_Call{Call: _e.mock.On("ClaimReward", taskID, referrerID, at)}
+}
+
+func (_c *MockReferralRepository_ClaimReward_Call) Run(run func(taskID int, referrerID string, at time.Time)) *MockReferralRepository_ClaimReward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_ClaimReward_Call) Return(_a0 bool, _a1 error) *MockReferralRepository_ClaimReward_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_ClaimReward_Call) RunAndReturn(run func(int, string, time.Time) (bool, error)) *MockReferralRepository_ClaimReward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCode provides a mock function with given fields: code
+func (_m *MockReferralRepository) CreateCode(code *model.ReferralCode) (*model.ReferralCode, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCode")
+	}
+
+	var r0 *model.ReferralCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ReferralCode) (*model.ReferralCode, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ReferralCode) *model.ReferralCode); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ReferralCode) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_CreateCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCode'
+type MockReferralRepository_CreateCode_Call struct {
+	*mock.Call
+}
+
+// CreateCode is a helper method to define mock.On call
+//   - code *model.ReferralCode
+func (_e *MockReferralRepository_Expecter) CreateCode(code interface{}) *MockReferralRepository_CreateCode_Call {
+	return &MockReferralRepository_CreateCode_Call{Call: _e.mock.On("CreateCode", code)}
+}
+
+func (_c *MockReferralRepository_CreateCode_Call) Run(run func(code *model.ReferralCode)) *MockReferralRepository_CreateCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.ReferralCode))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_CreateCode_Call) Return(_a0 *model.ReferralCode, _a1 error) *MockReferralRepository_CreateCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_CreateCode_Call) RunAndReturn(run func(*model.ReferralCode) (*model.ReferralCode, error)) *MockReferralRepository_CreateCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateReferral provides a mock function with given fields: referral
+func (_m *MockReferralRepository) CreateReferral(referral *model.Referral) (*model.Referral, error) {
+	ret := _m.Called(referral)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateReferral")
+	}
+
+	var r0 *model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Referral) (*model.Referral, error)); ok {
+		return rf(referral)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Referral) *model.Referral); ok {
+		r0 = rf(referral)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Referral) error); ok {
+		r1 = rf(referral)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_CreateReferral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateReferral'
+type MockReferralRepository_CreateReferral_Call struct {
+	*mock.Call
+}
+
+// CreateReferral is a helper method to define mock.On call
+//   - referral *model.Referral
+func (_e *MockReferralRepository_Expecter) CreateReferral(referral interface{}) *MockReferralRepository_CreateReferral_Call {
+	return &MockReferralRepository_CreateReferral_Call{Call: _e.mock.On("CreateReferral", referral)}
+}
+
+func (_c *MockReferralRepository_CreateReferral_Call) Run(run func(referral *model.Referral)) *MockReferralRepository_CreateReferral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Referral))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_CreateReferral_Call) Return(_a0 *model.Referral, _a1 error) *MockReferralRepository_CreateReferral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_CreateReferral_Call) RunAndReturn(run func(*model.Referral) (*model.Referral, error)) *MockReferralRepository_CreateReferral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCode provides a mock function with given fields: code
+func (_m *MockReferralRepository) GetCode(code string) (*model.ReferralCode, error) {
+	ret := _m.Called(code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCode")
+	}
+
+	var r0 *model.ReferralCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ReferralCode, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ReferralCode); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_GetCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCode'
+type MockReferralRepository_GetCode_Call struct {
+	*mock.Call
+}
+
+// GetCode is a helper method to define mock.On call
+//   - code string
+func (_e *MockReferralRepository_Expecter) GetCode(code interface{}) *MockReferralRepository_GetCode_Call {
+	return &MockReferralRepository_GetCode_Call{Call: _e.mock.On("GetCode", code)}
+}
+
+func (_c *MockReferralRepository_GetCode_Call) Run(run func(code string)) *MockReferralRepository_GetCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_GetCode_Call) Return(_a0 *model.ReferralCode, _a1 error) *MockReferralRepository_GetCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_GetCode_Call) RunAndReturn(run func(string) (*model.ReferralCode, error)) *MockReferralRepository_GetCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCodeOfUser provides a mock function with given fields: userID
+func (_m *MockReferralRepository) GetCodeOfUser(userID string) (*model.ReferralCode, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCodeOfUser")
+	}
+
+	var r0 *model.ReferralCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ReferralCode, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ReferralCode); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_GetCodeOfUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCodeOfUser'
+type MockReferralRepository_GetCodeOfUser_Call struct {
+	*mock.Call
+}
+
+// GetCodeOfUser is a helper method to define mock.On call
+//   - userID string
+func (_e *MockReferralRepository_Expecter) GetCodeOfUser(userID interface{}) *MockReferralRepository_GetCodeOfUser_Call {
+	return &MockReferralRepository_GetCodeOfUser_Call{Call: _e.mock.On("GetCodeOfUser", userID)}
+}
+
+func (_c *MockReferralRepository_GetCodeOfUser_Call) Run(run func(userID string)) *MockReferralRepository_GetCodeOfUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_GetCodeOfUser_Call) Return(_a0 *model.ReferralCode, _a1 error) *MockReferralRepository_GetCodeOfUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_GetCodeOfUser_Call) RunAndReturn(run func(string) (*model.ReferralCode, error)) *MockReferralRepository_GetCodeOfUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferral provides a mock function with given fields: refereeID
+func (_m *MockReferralRepository) GetReferral(refereeID string) (*model.Referral, error) {
+	ret := _m.Called(refereeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferral")
+	}
+
+	var r0 *model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Referral, error)); ok {
+		return rf(refereeID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Referral); ok {
+		r0 = rf(refereeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(refereeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_GetReferral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferral'
+type MockReferralRepository_GetReferral_Call struct {
+	*mock.Call
+}
+
+// GetReferral is a helper method to define mock.On call
+//   - refereeID string
+func (_e *MockReferralRepository_Expecter) GetReferral(refereeID interface{}) *MockReferralRepository_GetReferral_Call {
+	return &MockReferralRepository_GetReferral_Call{Call: _e.mock.On("GetReferral", refereeID)}
+}
+
+func (_c *MockReferralRepository_GetReferral_Call) Run(run func(refereeID string)) *MockReferralRepository_GetReferral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_GetReferral_Call) Return(_a0 *model.Referral, _a1 error) *MockReferralRepository_GetReferral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_GetReferral_Call) RunAndReturn(run func(string) (*model.Referral, error)) *MockReferralRepository_GetReferral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferrers provides a mock function with given fields: refereeIDs
+func (_m *MockReferralRepository) GetReferrers(refereeIDs []string) (map[string]string, error) {
+	ret := _m.Called(refereeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferrers")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]string, error)); ok {
+		return rf(refereeIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]string); ok {
+		r0 = rf(refereeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(refereeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_GetReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferrers'
+type MockReferralRepository_GetReferrers_Call struct {
+	*mock.Call
+}
+
+// GetReferrers is a helper method to define mock.On call
+//   - refereeIDs []string
+func (_e *MockReferralRepository_Expecter) GetReferrers(refereeIDs interface{}) *MockReferralRepository_GetReferrers_Call {
+	return &MockReferralRepository_GetReferrers_Call{Call: _e.mock.On("GetReferrers", refereeIDs)}
+}
+
+func (_c *MockReferralRepository_GetReferrers_Call) Run(run func(refereeIDs []string)) *MockReferralRepository_GetReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_GetReferrers_Call) Return(_a0 map[string]string, _a1 error) *MockReferralRepository_GetReferrers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_GetReferrers_Call) RunAndReturn(run func([]string) (map[string]string, error)) *MockReferralRepository_GetReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkOnboarded provides a mock function with given fields: refereeID, at
+func (_m *MockReferralRepository) MarkOnboarded(refereeID string, at time.Time) (*model.Referral, error) {
+	ret := _m.Called(refereeID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkOnboarded")
+	}
+
+	var r0 *model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*model.Referral, error)); ok {
+		return rf(refereeID, at)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *model.Referral); ok {
+		r0 = rf(refereeID, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(refereeID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_MarkOnboarded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkOnboarded'
+type MockReferralRepository_MarkOnboarded_Call struct {
+	*mock.Call
+}
+
+// MarkOnboarded is a helper method to define mock.On call
+//   - refereeID string
+//   - at time.Time
+func (_e *MockReferralRepository_Expecter) MarkOnboarded(refereeID interface{}, at interface{}) *MockReferralRepository_MarkOnboarded_Call {
+	return &MockReferralRepository_MarkOnboarded_Call{Call: _e.mock.On("MarkOnboarded", refereeID, at)}
+}
+
+func (_c *MockReferralRepository_MarkOnboarded_Call) Run(run func(refereeID string, at time.Time)) *MockReferralRepository_MarkOnboarded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_MarkOnboarded_Call) Return(_a0 *model.Referral, _a1 error) *MockReferralRepository_MarkOnboarded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_MarkOnboarded_Call) RunAndReturn(run func(string, time.Time) (*model.Referral, error)) *MockReferralRepository_MarkOnboarded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReward provides a mock function with given fields: taskID
+func (_m *MockReferralRepository) ReleaseReward(taskID int) error {
+	ret := _m.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReward")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferralRepository_ReleaseReward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReward'
+type MockReferralRepository_ReleaseReward_Call struct {
+	*mock.Call
+}
+
+// ReleaseReward is a helper method to define mock.On call
+//   - taskID int
+func (_e *MockReferralRepository_Expecter) ReleaseReward(taskID interface{}) *MockReferralRepository_ReleaseReward_Call {
+	return &MockReferralRepository_ReleaseReward_Call{Call: _e.mock.On("ReleaseReward", taskID)}
+}
+
+func (_c *MockReferralRepository_ReleaseReward_Call) Run(run func(taskID int)) *MockReferralRepository_ReleaseReward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_ReleaseReward_Call) Return(_a0 error) *MockReferralRepository_ReleaseReward_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferralRepository_ReleaseReward_Call) RunAndReturn(run func(int) error) *MockReferralRepository_ReleaseReward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchReferrals provides a mock function with given fields: referrerID
+func (_m *MockReferralRepository) SearchReferrals(referrerID string) ([]*model.Referral, error) {
+	ret := _m.Called(referrerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchReferrals")
+	}
+
+	var r0 []*model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Referral, error)); ok {
+		return rf(referrerID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Referral); ok {
+		r0 = rf(referrerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(referrerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralRepository_SearchReferrals_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchReferrals'
+type MockReferralRepository_SearchReferrals_Call struct {
+	*mock.Call
+}
+
+// SearchReferrals is a helper method to define mock.On call
+//   - referrerID string
+func (_e *MockReferralRepository_Expecter) SearchReferrals(referrerID interface{}) *MockReferralRepository_SearchReferrals_Call {
+	return &MockReferralRepository_SearchReferrals_Call{Call: _e.mock.On("SearchReferrals", referrerID)}
+}
+
+func (_c *MockReferralRepository_SearchReferrals_Call) Run(run func(referrerID string)) *MockReferralRepository_SearchReferrals_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_SearchReferrals_Call) Return(_a0 []*model.Referral, _a1 error) *MockReferralRepository_SearchReferrals_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralRepository_SearchReferrals_Call) RunAndReturn(run func(string) ([]*model.Referral, error)) *MockReferralRepository_SearchReferrals_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnmarkOnboarded provides a mock function with given fields: refereeID
+func (_m *MockReferralRepository) UnmarkOnboarded(refereeID string) error {
+	ret := _m.Called(refereeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnmarkOnboarded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(refereeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferralRepository_UnmarkOnboarded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnmarkOnboarded'
+type MockReferralRepository_UnmarkOnboarded_Call struct {
+	*mock.Call
+}
+
+// UnmarkOnboarded is a helper method to define mock.On call
+//   - refereeID string
+func (_e *MockReferralRepository_Expecter) UnmarkOnboarded(refereeID interface{}) *MockReferralRepository_UnmarkOnboarded_Call {
+	return &MockReferralRepository_UnmarkOnboarded_Call{Call: _e.mock.On("UnmarkOnboarded", refereeID)}
+}
+
+func (_c *MockReferralRepository_UnmarkOnboarded_Call) Run(run func(refereeID string)) *MockReferralRepository_UnmarkOnboarded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralRepository_UnmarkOnboarded_Call) Return(_a0 error) *MockReferralRepository_UnmarkOnboarded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferralRepository_UnmarkOnboarded_Call) RunAndReturn(run func(string) error) *MockReferralRepository_UnmarkOnboarded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReferralRepository creates a new instance of MockReferralRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferralRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferralRepository {
+	mock := &MockReferralRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	model "trading-ace/src/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -23,6 +24,65 @@ func (_m *MockRewardRecordRepository) EXPECT() *MockRewardRecordRepository_Expec
 	return &MockRewardRecordRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateAdjustment provides a mock function with given fields: adjustment, audit
+func (_m *MockRewardRecordRepository) CreateAdjustment(adjustment *model.RewardRecord, audit func(*model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error) {
+	ret := _m.Called(adjustment, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAdjustment")
+	}
+
+	var r0 *model.RewardRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.RewardRecord, func(*model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error)); ok {
+		return rf(adjustment, audit)
+	}
+	if rf, ok := ret.Get(0).(func(*model.RewardRecord, func(*model.RewardRecord) *model.AuditLog) *model.RewardRecord); ok {
+		r0 = rf(adjustment, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RewardRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.RewardRecord, func(*model.RewardRecord) *model.AuditLog) error); ok {
+		r1 = rf(adjustment, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardRecordRepository_CreateAdjustment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAdjustment'
+type MockRewardRecordRepository_CreateAdjustment_Call struct {
+	*mock.Call
+}
+
+// CreateAdjustment is a helper method to define mock.On call
+//   - adjustment *model.RewardRecord
+//   - audit func(*model.RewardRecord) *model.AuditLog
+func (_e *MockRewardRecordRepository_Expecter) CreateAdjustment(adjustment interface{}, audit interface{}) *MockRewardRecordRepository_CreateAdjustment_Call {
+	return &MockRewardRecordRepository_CreateAdjustment_Call{Call: _e.mock.On("CreateAdjustment", adjustment, audit)}
+}
+
+func (_c *MockRewardRecordRepository_CreateAdjustment_Call) Run(run func(adjustment *model.RewardRecord, audit func(*model.RewardRecord) *model.AuditLog)) *MockRewardRecordRepository_CreateAdjustment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.RewardRecord), args[1].(func(*model.RewardRecord) *model.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_CreateAdjustment_Call) Return(_a0 *model.RewardRecord, _a1 error) *MockRewardRecordRepository_CreateAdjustment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_CreateAdjustment_Call) RunAndReturn(run func(*model.RewardRecord, func(*model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error)) *MockRewardRecordRepository_CreateAdjustment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateRewardRecord provides a mock function with given fields: rewardRecord
 func (_m *MockRewardRecordRepository) CreateRewardRecord(rewardRecord *model.RewardRecord) (*model.RewardRecord, error) {
 	ret := _m.Called(rewardRecord)
@@ -81,6 +141,64 @@ func (_c *MockRewardRecordRepository_CreateRewardRecord_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetRewardRecordsByTaskIDs provides a mock function with given fields: taskIDs
+func (_m *MockRewardRecordRepository) GetRewardRecordsByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error) {
+	ret := _m.Called(taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRewardRecordsByTaskIDs")
+	}
+
+	var r0 map[int]*model.RewardRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int) (map[int]*model.RewardRecord, error)); ok {
+		return rf(taskIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]int) map[int]*model.RewardRecord); ok {
+		r0 = rf(taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]*model.RewardRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = rf(taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRewardRecordsByTaskIDs'
+type MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// GetRewardRecordsByTaskIDs is a helper method to define mock.On call
+//   - taskIDs []int
+func (_e *MockRewardRecordRepository_Expecter) GetRewardRecordsByTaskIDs(taskIDs interface{}) *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call {
+	return &MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call{Call: _e.mock.On("GetRewardRecordsByTaskIDs", taskIDs)}
+}
+
+func (_c *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call) Run(run func(taskIDs []int)) *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int))
+	})
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call) Return(_a0 map[int]*model.RewardRecord, _a1 error) *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call) RunAndReturn(run func([]int) (map[int]*model.RewardRecord, error)) *MockRewardRecordRepository_GetRewardRecordsByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SearchRewardRecords provides a mock function with given fields: condition
 func (_m *MockRewardRecordRepository) SearchRewardRecords(condition *repository.RewardRecordSearchCondition) ([]*model.RewardRecord, error) {
 	ret := _m.Called(condition)
@@ -139,6 +257,112 @@ func (_c *MockRewardRecordRepository_SearchRewardRecords_Call) RunAndReturn(run
 	return _c
 }
 
+// StreamRewardRecords provides a mock function with given fields: ctx, condition, fn
+func (_m *MockRewardRecordRepository) StreamRewardRecords(ctx context.Context, condition *repository.RewardRecordSearchCondition, fn func(*model.RewardRecord) error) error {
+	ret := _m.Called(ctx, condition, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamRewardRecords")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.RewardRecordSearchCondition, func(*model.RewardRecord) error) error); ok {
+		r0 = rf(ctx, condition, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockRewardRecordRepository_StreamRewardRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamRewardRecords'
+type MockRewardRecordRepository_StreamRewardRecords_Call struct {
+	*mock.Call
+}
+
+// StreamRewardRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - condition *repository.RewardRecordSearchCondition
+//   - fn func(*model.RewardRecord) error
+func (_e *MockRewardRecordRepository_Expecter) StreamRewardRecords(ctx interface{}, condition interface{}, fn interface{}) *MockRewardRecordRepository_StreamRewardRecords_Call {
+	return &MockRewardRecordRepository_StreamRewardRecords_Call{Call: _e.mock.On("StreamRewardRecords", ctx, condition, fn)}
+}
+
+func (_c *MockRewardRecordRepository_StreamRewardRecords_Call) Run(run func(ctx context.Context, condition *repository.RewardRecordSearchCondition, fn func(*model.RewardRecord) error)) *MockRewardRecordRepository_StreamRewardRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*repository.RewardRecordSearchCondition), args[2].(func(*model.RewardRecord) error))
+	})
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_StreamRewardRecords_Call) Return(_a0 error) *MockRewardRecordRepository_StreamRewardRecords_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_StreamRewardRecords_Call) RunAndReturn(run func(context.Context, *repository.RewardRecordSearchCondition, func(*model.RewardRecord) error) error) *MockRewardRecordRepository_StreamRewardRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumPointsByCampaign provides a mock function with given fields: userID
+func (_m *MockRewardRecordRepository) SumPointsByCampaign(userID string) (map[int]float64, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumPointsByCampaign")
+	}
+
+	var r0 map[int]float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[int]float64, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[int]float64); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]float64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardRecordRepository_SumPointsByCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumPointsByCampaign'
+type MockRewardRecordRepository_SumPointsByCampaign_Call struct {
+	*mock.Call
+}
+
+// SumPointsByCampaign is a helper method to define mock.On call
+//   - userID string
+func (_e *MockRewardRecordRepository_Expecter) SumPointsByCampaign(userID interface{}) *MockRewardRecordRepository_SumPointsByCampaign_Call {
+	return &MockRewardRecordRepository_SumPointsByCampaign_Call{Call: _e.mock.On("SumPointsByCampaign", userID)}
+}
+
+func (_c *MockRewardRecordRepository_SumPointsByCampaign_Call) Run(run func(userID string)) *MockRewardRecordRepository_SumPointsByCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_SumPointsByCampaign_Call) Return(_a0 map[int]float64, _a1 error) *MockRewardRecordRepository_SumPointsByCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardRecordRepository_SumPointsByCampaign_Call) RunAndReturn(run func(string) (map[int]float64, error)) *MockRewardRecordRepository_SumPointsByCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockRewardRecordRepository creates a new instance of MockRewardRecordRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockRewardRecordRepository(t interface {
@@ -0,0 +1,310 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	repository "trading-ace/src/repository"
+
+	time "time"
+)
+
+// MockSettlementRepository is an autogenerated mock type for the SettlementRepository type
+type MockSettlementRepository struct {
+	mock.Mock
+}
+
+type MockSettlementRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSettlementRepository) EXPECT() *MockSettlementRepository_Expecter {
+	return &MockSettlementRepository_Expecter{mock: &_m.Mock}
+}
+
+// CompleteSettlement provides a mock function with given fields: settlementID, completedAt
+func (_m *MockSettlementRepository) CompleteSettlement(settlementID int, completedAt time.Time) error {
+	ret := _m.Called(settlementID, completedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteSettlement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, time.Time) error); ok {
+		r0 = rf(settlementID, completedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSettlementRepository_CompleteSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSettlement'
+type MockSettlementRepository_CompleteSettlement_Call struct {
+	*mock.Call
+}
+
+// CompleteSettlement is a helper method to define mock.On call
+//   - settlementID int
+//   - completedAt time.Time
+func (_e *MockSettlementRepository_Expecter) CompleteSettlement(settlementID interface{}, completedAt interface{}) *MockSettlementRepository_CompleteSettlement_Call {
+	return &MockSettlementRepository_CompleteSettlement_Call{Call: _e.mock.On("CompleteSettlement", settlementID, completedAt)}
+}
+
+func (_c *MockSettlementRepository_CompleteSettlement_Call) Run(run func(settlementID int, completedAt time.Time)) *MockSettlementRepository_CompleteSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSettlementRepository_CompleteSettlement_Call) Return(_a0 error) *MockSettlementRepository_CompleteSettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSettlementRepository_CompleteSettlement_Call) RunAndReturn(run func(int, time.Time) error) *MockSettlementRepository_CompleteSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateSettlement provides a mock function with given fields: settlement, payouts, audit
+func (_m *MockSettlementRepository) CreateSettlement(settlement *model.Settlement, payouts []*model.SharedPoolPayout, audit func(*model.Settlement) *model.AuditLog) (*model.Settlement, error) {
+	ret := _m.Called(settlement, payouts, audit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSettlement")
+	}
+
+	var r0 *model.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Settlement, []*model.SharedPoolPayout, func(*model.Settlement) *model.AuditLog) (*model.Settlement, error)); ok {
+		return rf(settlement, payouts, audit)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Settlement, []*model.SharedPoolPayout, func(*model.Settlement) *model.AuditLog) *model.Settlement); ok {
+		r0 = rf(settlement, payouts, audit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Settlement, []*model.SharedPoolPayout, func(*model.Settlement) *model.AuditLog) error); ok {
+		r1 = rf(settlement, payouts, audit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettlementRepository_CreateSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSettlement'
+type MockSettlementRepository_CreateSettlement_Call struct {
+	*mock.Call
+}
+
+// CreateSettlement is a helper method to define mock.On call
+//   - settlement *model.Settlement
+//   - payouts []*model.SharedPoolPayout
+//   - audit func(*model.Settlement) *model.AuditLog
+func (_e *MockSettlementRepository_Expecter) CreateSettlement(settlement interface{}, payouts interface{}, audit interface{}) *MockSettlementRepository_CreateSettlement_Call {
+	return &MockSettlementRepository_CreateSettlement_Call{Call: _e.mock.On("CreateSettlement", settlement, payouts, audit)}
+}
+
+func (_c *MockSettlementRepository_CreateSettlement_Call) Run(run func(settlement *model.Settlement, payouts []*model.SharedPoolPayout, audit func(*model.Settlement) *model.AuditLog)) *MockSettlementRepository_CreateSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Settlement), args[1].([]*model.SharedPoolPayout), args[2].(func(*model.Settlement) *model.AuditLog))
+	})
+	return _c
+}
+
+func (_c *MockSettlementRepository_CreateSettlement_Call) Return(_a0 *model.Settlement, _a1 error) *MockSettlementRepository_CreateSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettlementRepository_CreateSettlement_Call) RunAndReturn(run func(*model.Settlement, []*model.SharedPoolPayout, func(*model.Settlement) *model.AuditLog) (*model.Settlement, error)) *MockSettlementRepository_CreateSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DistributeSettlement provides a mock function with given fields: settlementID, distributedAt
+func (_m *MockSettlementRepository) DistributeSettlement(settlementID int, distributedAt time.Time) error {
+	ret := _m.Called(settlementID, distributedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DistributeSettlement")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int, time.Time) error); ok {
+		r0 = rf(settlementID, distributedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSettlementRepository_DistributeSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DistributeSettlement'
+type MockSettlementRepository_DistributeSettlement_Call struct {
+	*mock.Call
+}
+
+// DistributeSettlement is a helper method to define mock.On call
+//   - settlementID int
+//   - distributedAt time.Time
+func (_e *MockSettlementRepository_Expecter) DistributeSettlement(settlementID interface{}, distributedAt interface{}) *MockSettlementRepository_DistributeSettlement_Call {
+	return &MockSettlementRepository_DistributeSettlement_Call{Call: _e.mock.On("DistributeSettlement", settlementID, distributedAt)}
+}
+
+func (_c *MockSettlementRepository_DistributeSettlement_Call) Run(run func(settlementID int, distributedAt time.Time)) *MockSettlementRepository_DistributeSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSettlementRepository_DistributeSettlement_Call) Return(_a0 error) *MockSettlementRepository_DistributeSettlement_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSettlementRepository_DistributeSettlement_Call) RunAndReturn(run func(int, time.Time) error) *MockSettlementRepository_DistributeSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSettlementPayouts provides a mock function with given fields: settlementID
+func (_m *MockSettlementRepository) GetSettlementPayouts(settlementID int) ([]*model.SharedPoolPayout, error) {
+	ret := _m.Called(settlementID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettlementPayouts")
+	}
+
+	var r0 []*model.SharedPoolPayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*model.SharedPoolPayout, error)); ok {
+		return rf(settlementID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*model.SharedPoolPayout); ok {
+		r0 = rf(settlementID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SharedPoolPayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(settlementID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettlementRepository_GetSettlementPayouts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSettlementPayouts'
+type MockSettlementRepository_GetSettlementPayouts_Call struct {
+	*mock.Call
+}
+
+// GetSettlementPayouts is a helper method to define mock.On call
+//   - settlementID int
+func (_e *MockSettlementRepository_Expecter) GetSettlementPayouts(settlementID interface{}) *MockSettlementRepository_GetSettlementPayouts_Call {
+	return &MockSettlementRepository_GetSettlementPayouts_Call{Call: _e.mock.On("GetSettlementPayouts", settlementID)}
+}
+
+func (_c *MockSettlementRepository_GetSettlementPayouts_Call) Run(run func(settlementID int)) *MockSettlementRepository_GetSettlementPayouts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockSettlementRepository_GetSettlementPayouts_Call) Return(_a0 []*model.SharedPoolPayout, _a1 error) *MockSettlementRepository_GetSettlementPayouts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettlementRepository_GetSettlementPayouts_Call) RunAndReturn(run func(int) ([]*model.SharedPoolPayout, error)) *MockSettlementRepository_GetSettlementPayouts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchSettlements provides a mock function with given fields: condition
+func (_m *MockSettlementRepository) SearchSettlements(condition *repository.SearchSettlementsCondition) ([]*model.Settlement, error) {
+	ret := _m.Called(condition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchSettlements")
+	}
+
+	var r0 []*model.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*repository.SearchSettlementsCondition) ([]*model.Settlement, error)); ok {
+		return rf(condition)
+	}
+	if rf, ok := ret.Get(0).(func(*repository.SearchSettlementsCondition) []*model.Settlement); ok {
+		r0 = rf(condition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*repository.SearchSettlementsCondition) error); ok {
+		r1 = rf(condition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettlementRepository_SearchSettlements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchSettlements'
+type MockSettlementRepository_SearchSettlements_Call struct {
+	*mock.Call
+}
+
+// SearchSettlements is a helper method to define mock.On call
+//   - condition *repository.SearchSettlementsCondition
+func (_e *MockSettlementRepository_Expecter) SearchSettlements(condition interface{}) *MockSettlementRepository_SearchSettlements_Call {
+	return &MockSettlementRepository_SearchSettlements_Call{Call: _e.mock.On("SearchSettlements", condition)}
+}
+
+func (_c *MockSettlementRepository_SearchSettlements_Call) Run(run func(condition *repository.SearchSettlementsCondition)) *MockSettlementRepository_SearchSettlements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*repository.SearchSettlementsCondition))
+	})
+	return _c
+}
+
+func (_c *MockSettlementRepository_SearchSettlements_Call) Return(_a0 []*model.Settlement, _a1 error) *MockSettlementRepository_SearchSettlements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettlementRepository_SearchSettlements_Call) RunAndReturn(run func(*repository.SearchSettlementsCondition) ([]*model.Settlement, error)) *MockSettlementRepository_SearchSettlements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSettlementRepository creates a new instance of MockSettlementRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSettlementRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSettlementRepository {
+	mock := &MockSettlementRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
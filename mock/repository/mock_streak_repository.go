@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockStreakRepository is an autogenerated mock type for the StreakRepository type
+type MockStreakRepository struct {
+	mock.Mock
+}
+
+type MockStreakRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreakRepository) EXPECT() *MockStreakRepository_Expecter {
+	return &MockStreakRepository_Expecter{mock: &_m.Mock}
+}
+
+// AdvanceStreak provides a mock function with given fields: userID, period, start, previous
+func (_m *MockStreakRepository) AdvanceStreak(userID string, period model.PeriodType, start time.Time, previous time.Time) (*model.Streak, error) {
+	ret := _m.Called(userID, period, start, previous)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdvanceStreak")
+	}
+
+	var r0 *model.Streak
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, model.PeriodType, time.Time, time.Time) (*model.Streak, error)); ok {
+		return rf(userID, period, start, previous)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.PeriodType, time.Time, time.Time) *model.Streak); ok {
+		r0 = rf(userID, period, start, previous)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Streak)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.PeriodType, time.Time, time.Time) error); ok {
+		r1 = rf(userID, period, start, previous)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreakRepository_AdvanceStreak_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdvanceStreak'
+type MockStreakRepository_AdvanceStreak_Call struct {
+	*mock.Call
+}
+
+// AdvanceStreak is a helper method to define mock.On call
+//   - userID string
+//   - period model.PeriodType
+//   - start time.Time
+//   - previous time.Time
+func (_e *MockStreakRepository_Expecter) AdvanceStreak(userID interface{}, period interface{}, start interface{}, previous interface{}) *MockStreakRepository_AdvanceStreak_Call {
+	return &MockStreakRepository_AdvanceStreak_Call{Call: _e.mock.On("AdvanceStreak", userID, period, start, previous)}
+}
+
+func (_c *MockStreakRepository_AdvanceStreak_Call) Run(run func(userID string, period model.PeriodType, start time.Time, previous time.Time)) *MockStreakRepository_AdvanceStreak_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(model.PeriodType), args[2].(time.Time), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStreakRepository_AdvanceStreak_Call) Return(_a0 *model.Streak, _a1 error) *MockStreakRepository_AdvanceStreak_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreakRepository_AdvanceStreak_Call) RunAndReturn(run func(string, model.PeriodType, time.Time, time.Time) (*model.Streak, error)) *MockStreakRepository_AdvanceStreak_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreaks provides a mock function with given fields: userID
+func (_m *MockStreakRepository) GetStreaks(userID string) ([]*model.Streak, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreaks")
+	}
+
+	var r0 []*model.Streak
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Streak, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Streak); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Streak)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreakRepository_GetStreaks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreaks'
+type MockStreakRepository_GetStreaks_Call struct {
+	*mock.Call
+}
+
+// GetStreaks is a helper method to define mock.On call
+//   - userID string
+func (_e *MockStreakRepository_Expecter) GetStreaks(userID interface{}) *MockStreakRepository_GetStreaks_Call {
+	return &MockStreakRepository_GetStreaks_Call{Call: _e.mock.On("GetStreaks", userID)}
+}
+
+func (_c *MockStreakRepository_GetStreaks_Call) Run(run func(userID string)) *MockStreakRepository_GetStreaks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockStreakRepository_GetStreaks_Call) Return(_a0 []*model.Streak, _a1 error) *MockStreakRepository_GetStreaks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreakRepository_GetStreaks_Call) RunAndReturn(run func(string) ([]*model.Streak, error)) *MockStreakRepository_GetStreaks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreakRepository creates a new instance of MockStreakRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreakRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreakRepository {
+	mock := &MockStreakRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,6 +3,7 @@
 package repository
 
 import (
+	context "context"
 	model "trading-ace/src/model"
 
 	mock "github.com/stretchr/testify/mock"
@@ -23,6 +24,65 @@ func (_m *MockTaskRepository) EXPECT() *MockTaskRepository_Expecter {
 	return &MockTaskRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreateSwapTask provides a mock function with given fields: swapID, task
+func (_m *MockTaskRepository) CreateSwapTask(swapID string, task *model.Task) (*model.Task, error) {
+	ret := _m.Called(swapID, task)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSwapTask")
+	}
+
+	var r0 *model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Task) (*model.Task, error)); ok {
+		return rf(swapID, task)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Task) *model.Task); ok {
+		r0 = rf(swapID, task)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Task) error); ok {
+		r1 = rf(swapID, task)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_CreateSwapTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSwapTask'
+type MockTaskRepository_CreateSwapTask_Call struct {
+	*mock.Call
+}
+
+// CreateSwapTask is a helper method to define mock.On call
+//   - swapID string
+//   - task *model.Task
+func (_e *MockTaskRepository_Expecter) CreateSwapTask(swapID interface{}, task interface{}) *MockTaskRepository_CreateSwapTask_Call {
+	return &MockTaskRepository_CreateSwapTask_Call{Call: _e.mock.On("CreateSwapTask", swapID, task)}
+}
+
+func (_c *MockTaskRepository_CreateSwapTask_Call) Run(run func(swapID string, task *model.Task)) *MockTaskRepository_CreateSwapTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*model.Task))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateSwapTask_Call) Return(_a0 *model.Task, _a1 error) *MockTaskRepository_CreateSwapTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_CreateSwapTask_Call) RunAndReturn(run func(string, *model.Task) (*model.Task, error)) *MockTaskRepository_CreateSwapTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateTask provides a mock function with given fields: task
 func (_m *MockTaskRepository) CreateTask(task *model.Task) (*model.Task, error) {
 	ret := _m.Called(task)
@@ -81,6 +141,52 @@ func (_c *MockTaskRepository_CreateTask_Call) RunAndReturn(run func(*model.Task)
 	return _c
 }
 
+// DeletePendingTask provides a mock function with given fields: taskID
+func (_m *MockTaskRepository) DeletePendingTask(taskID int) error {
+	ret := _m.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePendingTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_DeletePendingTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePendingTask'
+type MockTaskRepository_DeletePendingTask_Call struct {
+	*mock.Call
+}
+
+// DeletePendingTask is a helper method to define mock.On call
+//   - taskID int
+func (_e *MockTaskRepository_Expecter) DeletePendingTask(taskID interface{}) *MockTaskRepository_DeletePendingTask_Call {
+	return &MockTaskRepository_DeletePendingTask_Call{Call: _e.mock.On("DeletePendingTask", taskID)}
+}
+
+func (_c *MockTaskRepository_DeletePendingTask_Call) Run(run func(taskID int)) *MockTaskRepository_DeletePendingTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_DeletePendingTask_Call) Return(_a0 error) *MockTaskRepository_DeletePendingTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_DeletePendingTask_Call) RunAndReturn(run func(int) error) *MockTaskRepository_DeletePendingTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTaskByID provides a mock function with given fields: taskID
 func (_m *MockTaskRepository) GetTaskByID(taskID int) (*model.Task, error) {
 	ret := _m.Called(taskID)
@@ -197,6 +303,112 @@ func (_c *MockTaskRepository_SearchTasks_Call) RunAndReturn(run func(*repository
 	return _c
 }
 
+// SearchUserCampaignActivities provides a mock function with given fields: userID
+func (_m *MockTaskRepository) SearchUserCampaignActivities(userID string) ([]*model.UserCampaignActivity, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUserCampaignActivities")
+	}
+
+	var r0 []*model.UserCampaignActivity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.UserCampaignActivity, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.UserCampaignActivity); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserCampaignActivity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskRepository_SearchUserCampaignActivities_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUserCampaignActivities'
+type MockTaskRepository_SearchUserCampaignActivities_Call struct {
+	*mock.Call
+}
+
+// SearchUserCampaignActivities is a helper method to define mock.On call
+//   - userID string
+func (_e *MockTaskRepository_Expecter) SearchUserCampaignActivities(userID interface{}) *MockTaskRepository_SearchUserCampaignActivities_Call {
+	return &MockTaskRepository_SearchUserCampaignActivities_Call{Call: _e.mock.On("SearchUserCampaignActivities", userID)}
+}
+
+func (_c *MockTaskRepository_SearchUserCampaignActivities_Call) Run(run func(userID string)) *MockTaskRepository_SearchUserCampaignActivities_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_SearchUserCampaignActivities_Call) Return(_a0 []*model.UserCampaignActivity, _a1 error) *MockTaskRepository_SearchUserCampaignActivities_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskRepository_SearchUserCampaignActivities_Call) RunAndReturn(run func(string) ([]*model.UserCampaignActivity, error)) *MockTaskRepository_SearchUserCampaignActivities_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamTasks provides a mock function with given fields: ctx, condition, fn
+func (_m *MockTaskRepository) StreamTasks(ctx context.Context, condition *repository.SearchTasksCondition, fn func(*model.Task) error) error {
+	ret := _m.Called(ctx, condition, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamTasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *repository.SearchTasksCondition, func(*model.Task) error) error); ok {
+		r0 = rf(ctx, condition, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskRepository_StreamTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamTasks'
+type MockTaskRepository_StreamTasks_Call struct {
+	*mock.Call
+}
+
+// StreamTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - condition *repository.SearchTasksCondition
+//   - fn func(*model.Task) error
+func (_e *MockTaskRepository_Expecter) StreamTasks(ctx interface{}, condition interface{}, fn interface{}) *MockTaskRepository_StreamTasks_Call {
+	return &MockTaskRepository_StreamTasks_Call{Call: _e.mock.On("StreamTasks", ctx, condition, fn)}
+}
+
+func (_c *MockTaskRepository_StreamTasks_Call) Run(run func(ctx context.Context, condition *repository.SearchTasksCondition, fn func(*model.Task) error)) *MockTaskRepository_StreamTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*repository.SearchTasksCondition), args[2].(func(*model.Task) error))
+	})
+	return _c
+}
+
+func (_c *MockTaskRepository_StreamTasks_Call) Return(_a0 error) *MockTaskRepository_StreamTasks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskRepository_StreamTasks_Call) RunAndReturn(run func(context.Context, *repository.SearchTasksCondition, func(*model.Task) error) error) *MockTaskRepository_StreamTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTask provides a mock function with given fields: task
 func (_m *MockTaskRepository) UpdateTask(task *model.Task) (*model.Task, error) {
 	ret := _m.Called(task)
@@ -0,0 +1,266 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockTierRepository is an autogenerated mock type for the TierRepository type
+type MockTierRepository struct {
+	mock.Mock
+}
+
+type MockTierRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTierRepository) EXPECT() *MockTierRepository_Expecter {
+	return &MockTierRepository_Expecter{mock: &_m.Mock}
+}
+
+// ChangeTier provides a mock function with given fields: change
+func (_m *MockTierRepository) ChangeTier(change *model.TierChange) (bool, error) {
+	ret := _m.Called(change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeTier")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.TierChange) (bool, error)); ok {
+		return rf(change)
+	}
+	if rf, ok := ret.Get(0).(func(*model.TierChange) bool); ok {
+		r0 = rf(change)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.TierChange) error); ok {
+		r1 = rf(change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierRepository_ChangeTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeTier'
+type MockTierRepository_ChangeTier_Call struct {
+	*mock.Call
+}
+
+// ChangeTier is a helper method to define mock.On call
+//   - change *model.TierChange
+func (_e *MockTierRepository_Expecter) ChangeTier(change interface{}) *MockTierRepository_ChangeTier_Call {
+	return &MockTierRepository_ChangeTier_Call{Call: _e.mock.On("ChangeTier", change)}
+}
+
+func (_c *MockTierRepository_ChangeTier_Call) Run(run func(change *model.TierChange)) *MockTierRepository_ChangeTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.TierChange))
+	})
+	return _c
+}
+
+func (_c *MockTierRepository_ChangeTier_Call) Return(_a0 bool, _a1 error) *MockTierRepository_ChangeTier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierRepository_ChangeTier_Call) RunAndReturn(run func(*model.TierChange) (bool, error)) *MockTierRepository_ChangeTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTierHistory provides a mock function with given fields: userID
+func (_m *MockTierRepository) GetTierHistory(userID string) ([]*model.TierChange, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTierHistory")
+	}
+
+	var r0 []*model.TierChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.TierChange, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.TierChange); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TierChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierRepository_GetTierHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTierHistory'
+type MockTierRepository_GetTierHistory_Call struct {
+	*mock.Call
+}
+
+// GetTierHistory is a helper method to define mock.On call
+//   - userID string
+func (_e *MockTierRepository_Expecter) GetTierHistory(userID interface{}) *MockTierRepository_GetTierHistory_Call {
+	return &MockTierRepository_GetTierHistory_Call{Call: _e.mock.On("GetTierHistory", userID)}
+}
+
+func (_c *MockTierRepository_GetTierHistory_Call) Run(run func(userID string)) *MockTierRepository_GetTierHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTierRepository_GetTierHistory_Call) Return(_a0 []*model.TierChange, _a1 error) *MockTierRepository_GetTierHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierRepository_GetTierHistory_Call) RunAndReturn(run func(string) ([]*model.TierChange, error)) *MockTierRepository_GetTierHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTiers provides a mock function with given fields: userIDs
+func (_m *MockTierRepository) GetUserTiers(userIDs []string) (map[string]string, error) {
+	ret := _m.Called(userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTiers")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]string, error)); ok {
+		return rf(userIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]string); ok {
+		r0 = rf(userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierRepository_GetUserTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTiers'
+type MockTierRepository_GetUserTiers_Call struct {
+	*mock.Call
+}
+
+// GetUserTiers is a helper method to define mock.On call
+//   - userIDs []string
+func (_e *MockTierRepository_Expecter) GetUserTiers(userIDs interface{}) *MockTierRepository_GetUserTiers_Call {
+	return &MockTierRepository_GetUserTiers_Call{Call: _e.mock.On("GetUserTiers", userIDs)}
+}
+
+func (_c *MockTierRepository_GetUserTiers_Call) Run(run func(userIDs []string)) *MockTierRepository_GetUserTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTierRepository_GetUserTiers_Call) Return(_a0 map[string]string, _a1 error) *MockTierRepository_GetUserTiers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierRepository_GetUserTiers_Call) RunAndReturn(run func([]string) (map[string]string, error)) *MockTierRepository_GetUserTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchUserIDsByTiers provides a mock function with given fields: tiers
+func (_m *MockTierRepository) SearchUserIDsByTiers(tiers []string) ([]string, error) {
+	ret := _m.Called(tiers)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchUserIDsByTiers")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]string, error)); ok {
+		return rf(tiers)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []string); ok {
+		r0 = rf(tiers)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(tiers)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierRepository_SearchUserIDsByTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchUserIDsByTiers'
+type MockTierRepository_SearchUserIDsByTiers_Call struct {
+	*mock.Call
+}
+
+// SearchUserIDsByTiers is a helper method to define mock.On call
+//   - tiers []string
+func (_e *MockTierRepository_Expecter) SearchUserIDsByTiers(tiers interface{}) *MockTierRepository_SearchUserIDsByTiers_Call {
+	return &MockTierRepository_SearchUserIDsByTiers_Call{Call: _e.mock.On("SearchUserIDsByTiers", tiers)}
+}
+
+func (_c *MockTierRepository_SearchUserIDsByTiers_Call) Run(run func(tiers []string)) *MockTierRepository_SearchUserIDsByTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTierRepository_SearchUserIDsByTiers_Call) Return(_a0 []string, _a1 error) *MockTierRepository_SearchUserIDsByTiers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierRepository_SearchUserIDsByTiers_Call) RunAndReturn(run func([]string) ([]string, error)) *MockTierRepository_SearchUserIDsByTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTierRepository creates a new instance of MockTierRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTierRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTierRepository {
+	mock := &MockTierRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -137,64 +137,6 @@ func (_c *MockUserRepository_GetUser_Call) RunAndReturn(run func(string) (*model
 	return _c
 }
 
-// UpdateUser provides a mock function with given fields: user
-func (_m *MockUserRepository) UpdateUser(user *model.User) (*model.User, error) {
-	ret := _m.Called(user)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateUser")
-	}
-
-	var r0 *model.User
-	var r1 error
-	if rf, ok := ret.Get(0).(func(*model.User) (*model.User, error)); ok {
-		return rf(user)
-	}
-	if rf, ok := ret.Get(0).(func(*model.User) *model.User); ok {
-		r0 = rf(user)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.User)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func(*model.User) error); ok {
-		r1 = rf(user)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// MockUserRepository_UpdateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUser'
-type MockUserRepository_UpdateUser_Call struct {
-	*mock.Call
-}
-
-// UpdateUser is a helper method to define mock.On call
-//   - user *model.User
-func (_e *MockUserRepository_Expecter) UpdateUser(user interface{}) *MockUserRepository_UpdateUser_Call {
-	return &MockUserRepository_UpdateUser_Call{Call: _e.mock.On("UpdateUser", user)}
-}
-
-func (_c *MockUserRepository_UpdateUser_Call) Run(run func(user *model.User)) *MockUserRepository_UpdateUser_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(*model.User))
-	})
-	return _c
-}
-
-func (_c *MockUserRepository_UpdateUser_Call) Return(_a0 *model.User, _a1 error) *MockUserRepository_UpdateUser_Call {
-	_c.Call.Return(_a0, _a1)
-	return _c
-}
-
-func (_c *MockUserRepository_UpdateUser_Call) RunAndReturn(run func(*model.User) (*model.User, error)) *MockUserRepository_UpdateUser_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // NewMockUserRepository creates a new instance of MockUserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserRepository(t interface {
@@ -0,0 +1,142 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockVolumeRepository is an autogenerated mock type for the VolumeRepository type
+type MockVolumeRepository struct {
+	mock.Mock
+}
+
+type MockVolumeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVolumeRepository) EXPECT() *MockVolumeRepository_Expecter {
+	return &MockVolumeRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddSwap provides a mock function with given fields: swapID, userID, at, volume
+func (_m *MockVolumeRepository) AddSwap(swapID string, userID string, at time.Time, volume float64) error {
+	ret := _m.Called(swapID, userID, at, volume)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSwap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, float64) error); ok {
+		r0 = rf(swapID, userID, at, volume)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockVolumeRepository_AddSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSwap'
+type MockVolumeRepository_AddSwap_Call struct {
+	*mock.Call
+}
+
+// AddSwap is a helper method to define mock.On call
+//   - swapID string
+//   - userID string
+//   - at time.Time
+//   - volume float64
+func (_e *MockVolumeRepository_Expecter) AddSwap(swapID interface{}, userID interface{}, at interface{}, volume interface{}) *MockVolumeRepository_AddSwap_Call {
+	return &MockVolumeRepository_AddSwap_Call{Call: _e.mock.On("AddSwap", swapID, userID, at, volume)}
+}
+
+func (_c *MockVolumeRepository_AddSwap_Call) Run(run func(swapID string, userID string, at time.Time, volume float64)) *MockVolumeRepository_AddSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *MockVolumeRepository_AddSwap_Call) Return(_a0 error) *MockVolumeRepository_AddSwap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockVolumeRepository_AddSwap_Call) RunAndReturn(run func(string, string, time.Time, float64) error) *MockVolumeRepository_AddSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVolume provides a mock function with given fields: userID, from
+func (_m *MockVolumeRepository) GetVolume(userID string, from time.Time) (float64, error) {
+	ret := _m.Called(userID, from)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVolume")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (float64, error)); ok {
+		return rf(userID, from)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) float64); ok {
+		r0 = rf(userID, from)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, from)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVolumeRepository_GetVolume_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVolume'
+type MockVolumeRepository_GetVolume_Call struct {
+	*mock.Call
+}
+
+// GetVolume is a helper method to define mock.On call
+//   - userID string
+//   - from time.Time
+func (_e *MockVolumeRepository_Expecter) GetVolume(userID interface{}, from interface{}) *MockVolumeRepository_GetVolume_Call {
+	return &MockVolumeRepository_GetVolume_Call{Call: _e.mock.On("GetVolume", userID, from)}
+}
+
+func (_c *MockVolumeRepository_GetVolume_Call) Run(run func(userID string, from time.Time)) *MockVolumeRepository_GetVolume_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockVolumeRepository_GetVolume_Call) Return(_a0 float64, _a1 error) *MockVolumeRepository_GetVolume_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVolumeRepository_GetVolume_Call) RunAndReturn(run func(string, time.Time) (float64, error)) *MockVolumeRepository_GetVolume_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVolumeRepository creates a new instance of MockVolumeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVolumeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVolumeRepository {
+	mock := &MockVolumeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package repository
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockVoucherRepository is an autogenerated mock type for the VoucherRepository type
+type MockVoucherRepository struct {
+	mock.Mock
+}
+
+type MockVoucherRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVoucherRepository) EXPECT() *MockVoucherRepository_Expecter {
+	return &MockVoucherRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateVoucher provides a mock function with given fields: account, sign
+func (_m *MockVoucherRepository) CreateVoucher(account string, sign func(int64) (*model.Voucher, error)) (*model.Voucher, error) {
+	ret := _m.Called(account, sign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateVoucher")
+	}
+
+	var r0 *model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, func(int64) (*model.Voucher, error)) (*model.Voucher, error)); ok {
+		return rf(account, sign)
+	}
+	if rf, ok := ret.Get(0).(func(string, func(int64) (*model.Voucher, error)) *model.Voucher); ok {
+		r0 = rf(account, sign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, func(int64) (*model.Voucher, error)) error); ok {
+		r1 = rf(account, sign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVoucherRepository_CreateVoucher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateVoucher'
+type MockVoucherRepository_CreateVoucher_Call struct {
+	*mock.Call
+}
+
+// CreateVoucher is a helper method to define mock.On call
+//   - account string
+//   - sign func(int64)(*model.Voucher , error)
+func (_e *MockVoucherRepository_Expecter) CreateVoucher(account interface{}, sign interface{}) *MockVoucherRepository_CreateVoucher_Call {
+	return &MockVoucherRepository_CreateVoucher_Call{Call: _e.mock.On("CreateVoucher", account, sign)}
+}
+
+func (_c *MockVoucherRepository_CreateVoucher_Call) Run(run func(account string, sign func(int64) (*model.Voucher, error))) *MockVoucherRepository_CreateVoucher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(func(int64) (*model.Voucher, error)))
+	})
+	return _c
+}
+
+func (_c *MockVoucherRepository_CreateVoucher_Call) Return(_a0 *model.Voucher, _a1 error) *MockVoucherRepository_CreateVoucher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVoucherRepository_CreateVoucher_Call) RunAndReturn(run func(string, func(int64) (*model.Voucher, error)) (*model.Voucher, error)) *MockVoucherRepository_CreateVoucher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchVouchers provides a mock function with given fields: account
+func (_m *MockVoucherRepository) SearchVouchers(account string) ([]*model.Voucher, error) {
+	ret := _m.Called(account)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchVouchers")
+	}
+
+	var r0 []*model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Voucher, error)); ok {
+		return rf(account)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Voucher); ok {
+		r0 = rf(account)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(account)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVoucherRepository_SearchVouchers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchVouchers'
+type MockVoucherRepository_SearchVouchers_Call struct {
+	*mock.Call
+}
+
+// SearchVouchers is a helper method to define mock.On call
+//   - account string
+func (_e *MockVoucherRepository_Expecter) SearchVouchers(account interface{}) *MockVoucherRepository_SearchVouchers_Call {
+	return &MockVoucherRepository_SearchVouchers_Call{Call: _e.mock.On("SearchVouchers", account)}
+}
+
+func (_c *MockVoucherRepository_SearchVouchers_Call) Run(run func(account string)) *MockVoucherRepository_SearchVouchers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockVoucherRepository_SearchVouchers_Call) Return(_a0 []*model.Voucher, _a1 error) *MockVoucherRepository_SearchVouchers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVoucherRepository_SearchVouchers_Call) RunAndReturn(run func(string) ([]*model.Voucher, error)) *MockVoucherRepository_SearchVouchers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVoucherRepository creates a new instance of MockVoucherRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVoucherRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVoucherRepository {
+	mock := &MockVoucherRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,152 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAdjustmentService is an autogenerated mock type for the AdjustmentService type
+type MockAdjustmentService struct {
+	mock.Mock
+}
+
+type MockAdjustmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAdjustmentService) EXPECT() *MockAdjustmentService_Expecter {
+	return &MockAdjustmentService_Expecter{mock: &_m.Mock}
+}
+
+// AdjustPoints provides a mock function with given fields: adjustment
+func (_m *MockAdjustmentService) AdjustPoints(adjustment *model.PointAdjustment) (*model.RewardRecord, error) {
+	ret := _m.Called(adjustment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdjustPoints")
+	}
+
+	var r0 *model.RewardRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PointAdjustment) (*model.RewardRecord, error)); ok {
+		return rf(adjustment)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PointAdjustment) *model.RewardRecord); ok {
+		r0 = rf(adjustment)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RewardRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PointAdjustment) error); ok {
+		r1 = rf(adjustment)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdjustmentService_AdjustPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdjustPoints'
+type MockAdjustmentService_AdjustPoints_Call struct {
+	*mock.Call
+}
+
+// AdjustPoints is a helper method to define mock.On call
+//   - adjustment *model.PointAdjustment
+func (_e *MockAdjustmentService_Expecter) AdjustPoints(adjustment interface{}) *MockAdjustmentService_AdjustPoints_Call {
+	return &MockAdjustmentService_AdjustPoints_Call{Call: _e.mock.On("AdjustPoints", adjustment)}
+}
+
+func (_c *MockAdjustmentService_AdjustPoints_Call) Run(run func(adjustment *model.PointAdjustment)) *MockAdjustmentService_AdjustPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.PointAdjustment))
+	})
+	return _c
+}
+
+func (_c *MockAdjustmentService_AdjustPoints_Call) Return(_a0 *model.RewardRecord, _a1 error) *MockAdjustmentService_AdjustPoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdjustmentService_AdjustPoints_Call) RunAndReturn(run func(*model.PointAdjustment) (*model.RewardRecord, error)) *MockAdjustmentService_AdjustPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAdjustments provides a mock function with given fields: userID
+func (_m *MockAdjustmentService) GetAdjustments(userID string) ([]*model.RewardRecord, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAdjustments")
+	}
+
+	var r0 []*model.RewardRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.RewardRecord, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.RewardRecord); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RewardRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAdjustmentService_GetAdjustments_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAdjustments'
+type MockAdjustmentService_GetAdjustments_Call struct {
+	*mock.Call
+}
+
+// GetAdjustments is a helper method to define mock.On call
+//   - userID string
+func (_e *MockAdjustmentService_Expecter) GetAdjustments(userID interface{}) *MockAdjustmentService_GetAdjustments_Call {
+	return &MockAdjustmentService_GetAdjustments_Call{Call: _e.mock.On("GetAdjustments", userID)}
+}
+
+func (_c *MockAdjustmentService_GetAdjustments_Call) Run(run func(userID string)) *MockAdjustmentService_GetAdjustments_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAdjustmentService_GetAdjustments_Call) Return(_a0 []*model.RewardRecord, _a1 error) *MockAdjustmentService_GetAdjustments_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAdjustmentService_GetAdjustments_Call) RunAndReturn(run func(string) ([]*model.RewardRecord, error)) *MockAdjustmentService_GetAdjustments_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAdjustmentService creates a new instance of MockAdjustmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAdjustmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAdjustmentService {
+	mock := &MockAdjustmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,257 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAPIKeyService is an autogenerated mock type for the APIKeyService type
+type MockAPIKeyService struct {
+	mock.Mock
+}
+
+type MockAPIKeyService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAPIKeyService) EXPECT() *MockAPIKeyService_Expecter {
+	return &MockAPIKeyService_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: key
+func (_m *MockAPIKeyService) Authenticate(key string) (*model.APIKey, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.APIKey, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.APIKey); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAPIKeyService_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - key string
+func (_e *MockAPIKeyService_Expecter) Authenticate(key interface{}) *MockAPIKeyService_Authenticate_Call {
+	return &MockAPIKeyService_Authenticate_Call{Call: _e.mock.On("Authenticate", key)}
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) Run(run func(key string)) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) Return(_a0 *model.APIKey, _a1 error) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyService_Authenticate_Call) RunAndReturn(run func(string) (*model.APIKey, error)) *MockAPIKeyService_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateAPIKey provides a mock function with given fields: name, role, scopes
+func (_m *MockAPIKeyService) CreateAPIKey(name string, role model.Role, scopes []model.APIKeyScope) (*model.APIKey, error) {
+	ret := _m.Called(name, role, scopes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAPIKey")
+	}
+
+	var r0 *model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, model.Role, []model.APIKeyScope) (*model.APIKey, error)); ok {
+		return rf(name, role, scopes)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.Role, []model.APIKeyScope) *model.APIKey); ok {
+		r0 = rf(name, role, scopes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.Role, []model.APIKeyScope) error); ok {
+		r1 = rf(name, role, scopes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyService_CreateAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateAPIKey'
+type MockAPIKeyService_CreateAPIKey_Call struct {
+	*mock.Call
+}
+
+// CreateAPIKey is a helper method to define mock.On call
+//   - name string
+//   - role model.Role
+//   - scopes []model.APIKeyScope
+func (_e *MockAPIKeyService_Expecter) CreateAPIKey(name interface{}, role interface{}, scopes interface{}) *MockAPIKeyService_CreateAPIKey_Call {
+	return &MockAPIKeyService_CreateAPIKey_Call{Call: _e.mock.On("CreateAPIKey", name, role, scopes)}
+}
+
+func (_c *MockAPIKeyService_CreateAPIKey_Call) Run(run func(name string, role model.Role, scopes []model.APIKeyScope)) *MockAPIKeyService_CreateAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(model.Role), args[2].([]model.APIKeyScope))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_CreateAPIKey_Call) Return(_a0 *model.APIKey, _a1 error) *MockAPIKeyService_CreateAPIKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyService_CreateAPIKey_Call) RunAndReturn(run func(string, model.Role, []model.APIKeyScope) (*model.APIKey, error)) *MockAPIKeyService_CreateAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeAPIKey provides a mock function with given fields: id
+func (_m *MockAPIKeyService) RevokeAPIKey(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeAPIKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAPIKeyService_RevokeAPIKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeAPIKey'
+type MockAPIKeyService_RevokeAPIKey_Call struct {
+	*mock.Call
+}
+
+// RevokeAPIKey is a helper method to define mock.On call
+//   - id int
+func (_e *MockAPIKeyService_Expecter) RevokeAPIKey(id interface{}) *MockAPIKeyService_RevokeAPIKey_Call {
+	return &MockAPIKeyService_RevokeAPIKey_Call{Call: _e.mock.On("RevokeAPIKey", id)}
+}
+
+func (_c *MockAPIKeyService_RevokeAPIKey_Call) Run(run func(id int)) *MockAPIKeyService_RevokeAPIKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_RevokeAPIKey_Call) Return(_a0 error) *MockAPIKeyService_RevokeAPIKey_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAPIKeyService_RevokeAPIKey_Call) RunAndReturn(run func(int) error) *MockAPIKeyService_RevokeAPIKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchAPIKeys provides a mock function with given fields:
+func (_m *MockAPIKeyService) SearchAPIKeys() ([]*model.APIKey, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchAPIKeys")
+	}
+
+	var r0 []*model.APIKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.APIKey, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.APIKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.APIKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAPIKeyService_SearchAPIKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchAPIKeys'
+type MockAPIKeyService_SearchAPIKeys_Call struct {
+	*mock.Call
+}
+
+// SearchAPIKeys is a helper method to define mock.On call
+func (_e *MockAPIKeyService_Expecter) SearchAPIKeys() *MockAPIKeyService_SearchAPIKeys_Call {
+	return &MockAPIKeyService_SearchAPIKeys_Call{Call: _e.mock.On("SearchAPIKeys")}
+}
+
+func (_c *MockAPIKeyService_SearchAPIKeys_Call) Run(run func()) *MockAPIKeyService_SearchAPIKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockAPIKeyService_SearchAPIKeys_Call) Return(_a0 []*model.APIKey, _a1 error) *MockAPIKeyService_SearchAPIKeys_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAPIKeyService_SearchAPIKeys_Call) RunAndReturn(run func() ([]*model.APIKey, error)) *MockAPIKeyService_SearchAPIKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAPIKeyService creates a new instance of MockAPIKeyService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAPIKeyService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAPIKeyService {
+	mock := &MockAPIKeyService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
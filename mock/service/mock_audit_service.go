@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockAuditService is an autogenerated mock type for the AuditService type
+type MockAuditService struct {
+	mock.Mock
+}
+
+type MockAuditService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuditService) EXPECT() *MockAuditService_Expecter {
+	return &MockAuditService_Expecter{mock: &_m.Mock}
+}
+
+// Record provides a mock function with given fields: operator, action, target, detail
+func (_m *MockAuditService) Record(operator string, action model.AuditAction, target string, detail map[string]interface{}) error {
+	ret := _m.Called(operator, action, target, detail)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.AuditAction, string, map[string]interface{}) error); ok {
+		r0 = rf(operator, action, target, detail)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockAuditService_Record_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Record'
+type MockAuditService_Record_Call struct {
+	*mock.Call
+}
+
+// Record is a helper method to define mock.On call
+//   - operator string
+//   - action model.AuditAction
+//   - target string
+//   - detail map[string]interface{}
+func (_e *MockAuditService_Expecter) Record(operator interface{}, action interface{}, target interface{}, detail interface{}) *MockAuditService_Record_Call {
+	return &MockAuditService_Record_Call{Call: _e.mock.On("Record", operator, action, target, detail)}
+}
+
+func (_c *MockAuditService_Record_Call) Run(run func(operator string, action model.AuditAction, target string, detail map[string]interface{})) *MockAuditService_Record_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(model.AuditAction), args[2].(string), args[3].(map[string]interface{}))
+	})
+	return _c
+}
+
+func (_c *MockAuditService_Record_Call) Return(_a0 error) *MockAuditService_Record_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockAuditService_Record_Call) RunAndReturn(run func(string, model.AuditAction, string, map[string]interface{}) error) *MockAuditService_Record_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuditService creates a new instance of MockAuditService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuditService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuditService {
+	mock := &MockAuditService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
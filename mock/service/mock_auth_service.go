@@ -0,0 +1,211 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockAuthService is an autogenerated mock type for the AuthService type
+type MockAuthService struct {
+	mock.Mock
+}
+
+type MockAuthService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockAuthService) EXPECT() *MockAuthService_Expecter {
+	return &MockAuthService_Expecter{mock: &_m.Mock}
+}
+
+// Authenticate provides a mock function with given fields: token, now
+func (_m *MockAuthService) Authenticate(token string, now time.Time) (string, error) {
+	ret := _m.Called(token, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Authenticate")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (string, error)); ok {
+		return rf(token, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) string); ok {
+		r0 = rf(token, now)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(token, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_Authenticate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authenticate'
+type MockAuthService_Authenticate_Call struct {
+	*mock.Call
+}
+
+// Authenticate is a helper method to define mock.On call
+//   - token string
+//   - now time.Time
+func (_e *MockAuthService_Expecter) Authenticate(token interface{}, now interface{}) *MockAuthService_Authenticate_Call {
+	return &MockAuthService_Authenticate_Call{Call: _e.mock.On("Authenticate", token, now)}
+}
+
+func (_c *MockAuthService_Authenticate_Call) Run(run func(token string, now time.Time)) *MockAuthService_Authenticate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Authenticate_Call) Return(_a0 string, _a1 error) *MockAuthService_Authenticate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_Authenticate_Call) RunAndReturn(run func(string, time.Time) (string, error)) *MockAuthService_Authenticate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueNonce provides a mock function with given fields: now
+func (_m *MockAuthService) IssueNonce(now time.Time) (string, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueNonce")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (string, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) string); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_IssueNonce_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueNonce'
+type MockAuthService_IssueNonce_Call struct {
+	*mock.Call
+}
+
+// IssueNonce is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockAuthService_Expecter) IssueNonce(now interface{}) *MockAuthService_IssueNonce_Call {
+	return &MockAuthService_IssueNonce_Call{Call: _e.mock.On("IssueNonce", now)}
+}
+
+func (_c *MockAuthService_IssueNonce_Call) Run(run func(now time.Time)) *MockAuthService_IssueNonce_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_IssueNonce_Call) Return(_a0 string, _a1 error) *MockAuthService_IssueNonce_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_IssueNonce_Call) RunAndReturn(run func(time.Time) (string, error)) *MockAuthService_IssueNonce_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Login provides a mock function with given fields: message, signature, now
+func (_m *MockAuthService) Login(message string, signature string, now time.Time) (*model.Session, error) {
+	ret := _m.Called(message, signature, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Login")
+	}
+
+	var r0 *model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) (*model.Session, error)); ok {
+		return rf(message, signature, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) *model.Session); ok {
+		r0 = rf(message, signature, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(message, signature, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockAuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type MockAuthService_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//   - message string
+//   - signature string
+//   - now time.Time
+func (_e *MockAuthService_Expecter) Login(message interface{}, signature interface{}, now interface{}) *MockAuthService_Login_Call {
+	return &MockAuthService_Login_Call{Call: _e.mock.On("Login", message, signature, now)}
+}
+
+func (_c *MockAuthService_Login_Call) Run(run func(message string, signature string, now time.Time)) *MockAuthService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) Return(_a0 *model.Session, _a1 error) *MockAuthService_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockAuthService_Login_Call) RunAndReturn(run func(string, string, time.Time) (*model.Session, error)) *MockAuthService_Login_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockAuthService creates a new instance of MockAuthService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockAuthService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockAuthService {
+	mock := &MockAuthService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
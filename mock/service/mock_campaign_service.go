@@ -0,0 +1,503 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockCampaignService is an autogenerated mock type for the CampaignService type
+type MockCampaignService struct {
+	mock.Mock
+}
+
+type MockCampaignService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockCampaignService) EXPECT() *MockCampaignService_Expecter {
+	return &MockCampaignService_Expecter{mock: &_m.Mock}
+}
+
+// ArchiveCampaign provides a mock function with given fields: campaignID
+func (_m *MockCampaignService) ArchiveCampaign(campaignID int) (*model.Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Campaign, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_ArchiveCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveCampaign'
+type MockCampaignService_ArchiveCampaign_Call struct {
+	*mock.Call
+}
+
+// ArchiveCampaign is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockCampaignService_Expecter) ArchiveCampaign(campaignID interface{}) *MockCampaignService_ArchiveCampaign_Call {
+	return &MockCampaignService_ArchiveCampaign_Call{Call: _e.mock.On("ArchiveCampaign", campaignID)}
+}
+
+func (_c *MockCampaignService_ArchiveCampaign_Call) Run(run func(campaignID int)) *MockCampaignService_ArchiveCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_ArchiveCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_ArchiveCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_ArchiveCampaign_Call) RunAndReturn(run func(int) (*model.Campaign, error)) *MockCampaignService_ArchiveCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateCampaign provides a mock function with given fields: campaign
+func (_m *MockCampaignService) CreateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign) (*model.Campaign, error)); ok {
+		return rf(campaign)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign) *model.Campaign); ok {
+		r0 = rf(campaign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign) error); ok {
+		r1 = rf(campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_CreateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCampaign'
+type MockCampaignService_CreateCampaign_Call struct {
+	*mock.Call
+}
+
+// CreateCampaign is a helper method to define mock.On call
+//   - campaign *model.Campaign
+func (_e *MockCampaignService_Expecter) CreateCampaign(campaign interface{}) *MockCampaignService_CreateCampaign_Call {
+	return &MockCampaignService_CreateCampaign_Call{Call: _e.mock.On("CreateCampaign", campaign)}
+}
+
+func (_c *MockCampaignService_CreateCampaign_Call) Run(run func(campaign *model.Campaign)) *MockCampaignService_CreateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_CreateCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_CreateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_CreateCampaign_Call) RunAndReturn(run func(*model.Campaign) (*model.Campaign, error)) *MockCampaignService_CreateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCampaign provides a mock function with given fields: campaignID
+func (_m *MockCampaignService) GetCampaign(campaignID int) (*model.Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Campaign, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_GetCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCampaign'
+type MockCampaignService_GetCampaign_Call struct {
+	*mock.Call
+}
+
+// GetCampaign is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockCampaignService_Expecter) GetCampaign(campaignID interface{}) *MockCampaignService_GetCampaign_Call {
+	return &MockCampaignService_GetCampaign_Call{Call: _e.mock.On("GetCampaign", campaignID)}
+}
+
+func (_c *MockCampaignService_GetCampaign_Call) Run(run func(campaignID int)) *MockCampaignService_GetCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_GetCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_GetCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_GetCampaign_Call) RunAndReturn(run func(int) (*model.Campaign, error)) *MockCampaignService_GetCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRunningCampaigns provides a mock function with given fields: poolAddress, at
+func (_m *MockCampaignService) GetRunningCampaigns(poolAddress string, at time.Time) ([]*model.Campaign, error) {
+	ret := _m.Called(poolAddress, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRunningCampaigns")
+	}
+
+	var r0 []*model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.Campaign, error)); ok {
+		return rf(poolAddress, at)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.Campaign); ok {
+		r0 = rf(poolAddress, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(poolAddress, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_GetRunningCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRunningCampaigns'
+type MockCampaignService_GetRunningCampaigns_Call struct {
+	*mock.Call
+}
+
+// GetRunningCampaigns is a helper method to define mock.On call
+//   - poolAddress string
+//   - at time.Time
+func (_e *MockCampaignService_Expecter) GetRunningCampaigns(poolAddress interface{}, at interface{}) *MockCampaignService_GetRunningCampaigns_Call {
+	return &MockCampaignService_GetRunningCampaigns_Call{Call: _e.mock.On("GetRunningCampaigns", poolAddress, at)}
+}
+
+func (_c *MockCampaignService_GetRunningCampaigns_Call) Run(run func(poolAddress string, at time.Time)) *MockCampaignService_GetRunningCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_GetRunningCampaigns_Call) Return(_a0 []*model.Campaign, _a1 error) *MockCampaignService_GetRunningCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_GetRunningCampaigns_Call) RunAndReturn(run func(string, time.Time) ([]*model.Campaign, error)) *MockCampaignService_GetRunningCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PauseCampaign provides a mock function with given fields: campaignID
+func (_m *MockCampaignService) PauseCampaign(campaignID int) (*model.Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PauseCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Campaign, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_PauseCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PauseCampaign'
+type MockCampaignService_PauseCampaign_Call struct {
+	*mock.Call
+}
+
+// PauseCampaign is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockCampaignService_Expecter) PauseCampaign(campaignID interface{}) *MockCampaignService_PauseCampaign_Call {
+	return &MockCampaignService_PauseCampaign_Call{Call: _e.mock.On("PauseCampaign", campaignID)}
+}
+
+func (_c *MockCampaignService_PauseCampaign_Call) Run(run func(campaignID int)) *MockCampaignService_PauseCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_PauseCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_PauseCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_PauseCampaign_Call) RunAndReturn(run func(int) (*model.Campaign, error)) *MockCampaignService_PauseCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeCampaign provides a mock function with given fields: campaignID
+func (_m *MockCampaignService) ResumeCampaign(campaignID int) (*model.Campaign, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) (*model.Campaign, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) *model.Campaign); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_ResumeCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeCampaign'
+type MockCampaignService_ResumeCampaign_Call struct {
+	*mock.Call
+}
+
+// ResumeCampaign is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockCampaignService_Expecter) ResumeCampaign(campaignID interface{}) *MockCampaignService_ResumeCampaign_Call {
+	return &MockCampaignService_ResumeCampaign_Call{Call: _e.mock.On("ResumeCampaign", campaignID)}
+}
+
+func (_c *MockCampaignService_ResumeCampaign_Call) Run(run func(campaignID int)) *MockCampaignService_ResumeCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_ResumeCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_ResumeCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_ResumeCampaign_Call) RunAndReturn(run func(int) (*model.Campaign, error)) *MockCampaignService_ResumeCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchCampaigns provides a mock function with given fields: statuses
+func (_m *MockCampaignService) SearchCampaigns(statuses []model.CampaignStatus) ([]*model.Campaign, error) {
+	ret := _m.Called(statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchCampaigns")
+	}
+
+	var r0 []*model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]model.CampaignStatus) ([]*model.Campaign, error)); ok {
+		return rf(statuses)
+	}
+	if rf, ok := ret.Get(0).(func([]model.CampaignStatus) []*model.Campaign); ok {
+		r0 = rf(statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]model.CampaignStatus) error); ok {
+		r1 = rf(statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_SearchCampaigns_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchCampaigns'
+type MockCampaignService_SearchCampaigns_Call struct {
+	*mock.Call
+}
+
+// SearchCampaigns is a helper method to define mock.On call
+//   - statuses []model.CampaignStatus
+func (_e *MockCampaignService_Expecter) SearchCampaigns(statuses interface{}) *MockCampaignService_SearchCampaigns_Call {
+	return &MockCampaignService_SearchCampaigns_Call{Call: _e.mock.On("SearchCampaigns", statuses)}
+}
+
+func (_c *MockCampaignService_SearchCampaigns_Call) Run(run func(statuses []model.CampaignStatus)) *MockCampaignService_SearchCampaigns_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.CampaignStatus))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_SearchCampaigns_Call) Return(_a0 []*model.Campaign, _a1 error) *MockCampaignService_SearchCampaigns_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_SearchCampaigns_Call) RunAndReturn(run func([]model.CampaignStatus) ([]*model.Campaign, error)) *MockCampaignService_SearchCampaigns_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCampaign provides a mock function with given fields: campaign
+func (_m *MockCampaignService) UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	ret := _m.Called(campaign)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCampaign")
+	}
+
+	var r0 *model.Campaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign) (*model.Campaign, error)); ok {
+		return rf(campaign)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign) *model.Campaign); ok {
+		r0 = rf(campaign)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Campaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign) error); ok {
+		r1 = rf(campaign)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockCampaignService_UpdateCampaign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCampaign'
+type MockCampaignService_UpdateCampaign_Call struct {
+	*mock.Call
+}
+
+// UpdateCampaign is a helper method to define mock.On call
+//   - campaign *model.Campaign
+func (_e *MockCampaignService_Expecter) UpdateCampaign(campaign interface{}) *MockCampaignService_UpdateCampaign_Call {
+	return &MockCampaignService_UpdateCampaign_Call{Call: _e.mock.On("UpdateCampaign", campaign)}
+}
+
+func (_c *MockCampaignService_UpdateCampaign_Call) Run(run func(campaign *model.Campaign)) *MockCampaignService_UpdateCampaign_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign))
+	})
+	return _c
+}
+
+func (_c *MockCampaignService_UpdateCampaign_Call) Return(_a0 *model.Campaign, _a1 error) *MockCampaignService_UpdateCampaign_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockCampaignService_UpdateCampaign_Call) RunAndReturn(run func(*model.Campaign) (*model.Campaign, error)) *MockCampaignService_UpdateCampaign_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockCampaignService creates a new instance of MockCampaignService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockCampaignService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockCampaignService {
+	mock := &MockCampaignService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,212 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockClaimService is an autogenerated mock type for the ClaimService type
+type MockClaimService struct {
+	mock.Mock
+}
+
+type MockClaimService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockClaimService) EXPECT() *MockClaimService_Expecter {
+	return &MockClaimService_Expecter{mock: &_m.Mock}
+}
+
+// BuildDistribution provides a mock function with given fields: campaign, periodIndex
+func (_m *MockClaimService) BuildDistribution(campaign *model.Campaign, periodIndex int) (*model.MerkleDistribution, error) {
+	ret := _m.Called(campaign, periodIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BuildDistribution")
+	}
+
+	var r0 *model.MerkleDistribution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign, int) (*model.MerkleDistribution, error)); ok {
+		return rf(campaign, periodIndex)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign, int) *model.MerkleDistribution); ok {
+		r0 = rf(campaign, periodIndex)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.MerkleDistribution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign, int) error); ok {
+		r1 = rf(campaign, periodIndex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimService_BuildDistribution_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildDistribution'
+type MockClaimService_BuildDistribution_Call struct {
+	*mock.Call
+}
+
+// BuildDistribution is a helper method to define mock.On call
+//   - campaign *model.Campaign
+//   - periodIndex int
+func (_e *MockClaimService_Expecter) BuildDistribution(campaign interface{}, periodIndex interface{}) *MockClaimService_BuildDistribution_Call {
+	return &MockClaimService_BuildDistribution_Call{Call: _e.mock.On("BuildDistribution", campaign, periodIndex)}
+}
+
+func (_c *MockClaimService_BuildDistribution_Call) Run(run func(campaign *model.Campaign, periodIndex int)) *MockClaimService_BuildDistribution_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockClaimService_BuildDistribution_Call) Return(_a0 *model.MerkleDistribution, _a1 error) *MockClaimService_BuildDistribution_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimService_BuildDistribution_Call) RunAndReturn(run func(*model.Campaign, int) (*model.MerkleDistribution, error)) *MockClaimService_BuildDistribution_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetClaims provides a mock function with given fields: address
+func (_m *MockClaimService) GetClaims(address string) ([]*model.ClaimOfCampaign, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetClaims")
+	}
+
+	var r0 []*model.ClaimOfCampaign
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.ClaimOfCampaign, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.ClaimOfCampaign); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ClaimOfCampaign)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimService_GetClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetClaims'
+type MockClaimService_GetClaims_Call struct {
+	*mock.Call
+}
+
+// GetClaims is a helper method to define mock.On call
+//   - address string
+func (_e *MockClaimService_Expecter) GetClaims(address interface{}) *MockClaimService_GetClaims_Call {
+	return &MockClaimService_GetClaims_Call{Call: _e.mock.On("GetClaims", address)}
+}
+
+func (_c *MockClaimService_GetClaims_Call) Run(run func(address string)) *MockClaimService_GetClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockClaimService_GetClaims_Call) Return(_a0 []*model.ClaimOfCampaign, _a1 error) *MockClaimService_GetClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimService_GetClaims_Call) RunAndReturn(run func(string) ([]*model.ClaimOfCampaign, error)) *MockClaimService_GetClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestClaim provides a mock function with given fields: address, now
+func (_m *MockClaimService) RequestClaim(address string, now time.Time) (float64, error) {
+	ret := _m.Called(address, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestClaim")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (float64, error)); ok {
+		return rf(address, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) float64); ok {
+		r0 = rf(address, now)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(address, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockClaimService_RequestClaim_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestClaim'
+type MockClaimService_RequestClaim_Call struct {
+	*mock.Call
+}
+
+// RequestClaim is a helper method to define mock.On call
+//   - address string
+//   - now time.Time
+func (_e *MockClaimService_Expecter) RequestClaim(address interface{}, now interface{}) *MockClaimService_RequestClaim_Call {
+	return &MockClaimService_RequestClaim_Call{Call: _e.mock.On("RequestClaim", address, now)}
+}
+
+func (_c *MockClaimService_RequestClaim_Call) Run(run func(address string, now time.Time)) *MockClaimService_RequestClaim_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockClaimService_RequestClaim_Call) Return(_a0 float64, _a1 error) *MockClaimService_RequestClaim_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockClaimService_RequestClaim_Call) RunAndReturn(run func(string, time.Time) (float64, error)) *MockClaimService_RequestClaim_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockClaimService creates a new instance of MockClaimService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockClaimService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockClaimService {
+	mock := &MockClaimService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
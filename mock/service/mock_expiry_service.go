@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockExpiryService is an autogenerated mock type for the ExpiryService type
+type MockExpiryService struct {
+	mock.Mock
+}
+
+type MockExpiryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExpiryService) EXPECT() *MockExpiryService_Expecter {
+	return &MockExpiryService_Expecter{mock: &_m.Mock}
+}
+
+// ExpirePoints provides a mock function with given fields: ctx, now
+func (_m *MockExpiryService) ExpirePoints(ctx context.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpirePoints")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExpiryService_ExpirePoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpirePoints'
+type MockExpiryService_ExpirePoints_Call struct {
+	*mock.Call
+}
+
+// ExpirePoints is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockExpiryService_Expecter) ExpirePoints(ctx interface{}, now interface{}) *MockExpiryService_ExpirePoints_Call {
+	return &MockExpiryService_ExpirePoints_Call{Call: _e.mock.On("ExpirePoints", ctx, now)}
+}
+
+func (_c *MockExpiryService_ExpirePoints_Call) Run(run func(ctx context.Context, now time.Time)) *MockExpiryService_ExpirePoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockExpiryService_ExpirePoints_Call) Return(_a0 error) *MockExpiryService_ExpirePoints_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExpiryService_ExpirePoints_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockExpiryService_ExpirePoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpiringPoints provides a mock function with given fields: address, now
+func (_m *MockExpiryService) GetExpiringPoints(address string, now time.Time) ([]*model.ExpiringPoints, error) {
+	ret := _m.Called(address, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiringPoints")
+	}
+
+	var r0 []*model.ExpiringPoints
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.ExpiringPoints, error)); ok {
+		return rf(address, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.ExpiringPoints); ok {
+		r0 = rf(address, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ExpiringPoints)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(address, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockExpiryService_GetExpiringPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiringPoints'
+type MockExpiryService_GetExpiringPoints_Call struct {
+	*mock.Call
+}
+
+// GetExpiringPoints is a helper method to define mock.On call
+//   - address string
+//   - now time.Time
+func (_e *MockExpiryService_Expecter) GetExpiringPoints(address interface{}, now interface{}) *MockExpiryService_GetExpiringPoints_Call {
+	return &MockExpiryService_GetExpiringPoints_Call{Call: _e.mock.On("GetExpiringPoints", address, now)}
+}
+
+func (_c *MockExpiryService_GetExpiringPoints_Call) Run(run func(address string, now time.Time)) *MockExpiryService_GetExpiringPoints_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockExpiryService_GetExpiringPoints_Call) Return(_a0 []*model.ExpiringPoints, _a1 error) *MockExpiryService_GetExpiringPoints_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockExpiryService_GetExpiringPoints_Call) RunAndReturn(run func(string, time.Time) ([]*model.ExpiringPoints, error)) *MockExpiryService_GetExpiringPoints_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExpiryService creates a new instance of MockExpiryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExpiryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExpiryService {
+	mock := &MockExpiryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
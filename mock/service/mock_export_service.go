@@ -0,0 +1,135 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "trading-ace/src/model"
+)
+
+// MockExportService is an autogenerated mock type for the ExportService type
+type MockExportService struct {
+	mock.Mock
+}
+
+type MockExportService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockExportService) EXPECT() *MockExportService_Expecter {
+	return &MockExportService_Expecter{mock: &_m.Mock}
+}
+
+// ExportRewardRecords provides a mock function with given fields: ctx, query, w
+func (_m *MockExportService) ExportRewardRecords(ctx context.Context, query *model.ExportQuery, w io.Writer) error {
+	ret := _m.Called(ctx, query, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportRewardRecords")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ExportQuery, io.Writer) error); ok {
+		r0 = rf(ctx, query, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExportService_ExportRewardRecords_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportRewardRecords'
+type MockExportService_ExportRewardRecords_Call struct {
+	*mock.Call
+}
+
+// ExportRewardRecords is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *model.ExportQuery
+//   - w io.Writer
+func (_e *MockExportService_Expecter) ExportRewardRecords(ctx interface{}, query interface{}, w interface{}) *MockExportService_ExportRewardRecords_Call {
+	return &MockExportService_ExportRewardRecords_Call{Call: _e.mock.On("ExportRewardRecords", ctx, query, w)}
+}
+
+func (_c *MockExportService_ExportRewardRecords_Call) Run(run func(ctx context.Context, query *model.ExportQuery, w io.Writer)) *MockExportService_ExportRewardRecords_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ExportQuery), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockExportService_ExportRewardRecords_Call) Return(_a0 error) *MockExportService_ExportRewardRecords_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExportService_ExportRewardRecords_Call) RunAndReturn(run func(context.Context, *model.ExportQuery, io.Writer) error) *MockExportService_ExportRewardRecords_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportTasks provides a mock function with given fields: ctx, query, w
+func (_m *MockExportService) ExportTasks(ctx context.Context, query *model.ExportQuery, w io.Writer) error {
+	ret := _m.Called(ctx, query, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportTasks")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ExportQuery, io.Writer) error); ok {
+		r0 = rf(ctx, query, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockExportService_ExportTasks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportTasks'
+type MockExportService_ExportTasks_Call struct {
+	*mock.Call
+}
+
+// ExportTasks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *model.ExportQuery
+//   - w io.Writer
+func (_e *MockExportService_Expecter) ExportTasks(ctx interface{}, query interface{}, w interface{}) *MockExportService_ExportTasks_Call {
+	return &MockExportService_ExportTasks_Call{Call: _e.mock.On("ExportTasks", ctx, query, w)}
+}
+
+func (_c *MockExportService_ExportTasks_Call) Run(run func(ctx context.Context, query *model.ExportQuery, w io.Writer)) *MockExportService_ExportTasks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ExportQuery), args[2].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockExportService_ExportTasks_Call) Return(_a0 error) *MockExportService_ExportTasks_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockExportService_ExportTasks_Call) RunAndReturn(run func(context.Context, *model.ExportQuery, io.Writer) error) *MockExportService_ExportTasks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockExportService creates a new instance of MockExportService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockExportService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockExportService {
+	mock := &MockExportService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MockLeaderboardService is an autogenerated mock type for the LeaderboardService type
+type MockLeaderboardService struct {
+	mock.Mock
+}
+
+type MockLeaderboardService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLeaderboardService) EXPECT() *MockLeaderboardService_Expecter {
+	return &MockLeaderboardService_Expecter{mock: &_m.Mock}
+}
+
+// GetLeaderboard provides a mock function with given fields: query
+func (_m *MockLeaderboardService) GetLeaderboard(query *model.LeaderboardQuery) (*model.Leaderboard, error) {
+	ret := _m.Called(query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLeaderboard")
+	}
+
+	var r0 *model.Leaderboard
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.LeaderboardQuery) (*model.Leaderboard, error)); ok {
+		return rf(query)
+	}
+	if rf, ok := ret.Get(0).(func(*model.LeaderboardQuery) *model.Leaderboard); ok {
+		r0 = rf(query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Leaderboard)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.LeaderboardQuery) error); ok {
+		r1 = rf(query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLeaderboardService_GetLeaderboard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLeaderboard'
+type MockLeaderboardService_GetLeaderboard_Call struct {
+	*mock.Call
+}
+
+// GetLeaderboard is a helper method to define mock.On call
+//   - query *model.LeaderboardQuery
+func (_e *MockLeaderboardService_Expecter) GetLeaderboard(query interface{}) *MockLeaderboardService_GetLeaderboard_Call {
+	return &MockLeaderboardService_GetLeaderboard_Call{Call: _e.mock.On("GetLeaderboard", query)}
+}
+
+func (_c *MockLeaderboardService_GetLeaderboard_Call) Run(run func(query *model.LeaderboardQuery)) *MockLeaderboardService_GetLeaderboard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.LeaderboardQuery))
+	})
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetLeaderboard_Call) Return(_a0 *model.Leaderboard, _a1 error) *MockLeaderboardService_GetLeaderboard_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLeaderboardService_GetLeaderboard_Call) RunAndReturn(run func(*model.LeaderboardQuery) (*model.Leaderboard, error)) *MockLeaderboardService_GetLeaderboard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLeaderboardService creates a new instance of MockLeaderboardService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLeaderboardService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLeaderboardService {
+	mock := &MockLeaderboardService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,153 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockLedgerService is an autogenerated mock type for the LedgerService type
+type MockLedgerService struct {
+	mock.Mock
+}
+
+type MockLedgerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockLedgerService) EXPECT() *MockLedgerService_Expecter {
+	return &MockLedgerService_Expecter{mock: &_m.Mock}
+}
+
+// GetBalanceMismatches provides a mock function with given fields:
+func (_m *MockLedgerService) GetBalanceMismatches() ([]*model.BalanceMismatch, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalanceMismatches")
+	}
+
+	var r0 []*model.BalanceMismatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.BalanceMismatch, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.BalanceMismatch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BalanceMismatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerService_GetBalanceMismatches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalanceMismatches'
+type MockLedgerService_GetBalanceMismatches_Call struct {
+	*mock.Call
+}
+
+// GetBalanceMismatches is a helper method to define mock.On call
+func (_e *MockLedgerService_Expecter) GetBalanceMismatches() *MockLedgerService_GetBalanceMismatches_Call {
+	return &MockLedgerService_GetBalanceMismatches_Call{Call: _e.mock.On("GetBalanceMismatches")}
+}
+
+func (_c *MockLedgerService_GetBalanceMismatches_Call) Run(run func()) *MockLedgerService_GetBalanceMismatches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockLedgerService_GetBalanceMismatches_Call) Return(_a0 []*model.BalanceMismatch, _a1 error) *MockLedgerService_GetBalanceMismatches_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerService_GetBalanceMismatches_Call) RunAndReturn(run func() ([]*model.BalanceMismatch, error)) *MockLedgerService_GetBalanceMismatches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReconcileBalances provides a mock function with given fields: now
+func (_m *MockLedgerService) ReconcileBalances(now time.Time) ([]*model.BalanceMismatch, error) {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReconcileBalances")
+	}
+
+	var r0 []*model.BalanceMismatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*model.BalanceMismatch, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*model.BalanceMismatch); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BalanceMismatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLedgerService_ReconcileBalances_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReconcileBalances'
+type MockLedgerService_ReconcileBalances_Call struct {
+	*mock.Call
+}
+
+// ReconcileBalances is a helper method to define mock.On call
+//   - now time.Time
+func (_e *MockLedgerService_Expecter) ReconcileBalances(now interface{}) *MockLedgerService_ReconcileBalances_Call {
+	return &MockLedgerService_ReconcileBalances_Call{Call: _e.mock.On("ReconcileBalances", now)}
+}
+
+func (_c *MockLedgerService_ReconcileBalances_Call) Run(run func(now time.Time)) *MockLedgerService_ReconcileBalances_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockLedgerService_ReconcileBalances_Call) Return(_a0 []*model.BalanceMismatch, _a1 error) *MockLedgerService_ReconcileBalances_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLedgerService_ReconcileBalances_Call) RunAndReturn(run func(time.Time) ([]*model.BalanceMismatch, error)) *MockLedgerService_ReconcileBalances_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockLedgerService creates a new instance of MockLedgerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockLedgerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockLedgerService {
+	mock := &MockLedgerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,248 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMilestoneService is an autogenerated mock type for the MilestoneService type
+type MockMilestoneService struct {
+	mock.Mock
+}
+
+type MockMilestoneService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMilestoneService) EXPECT() *MockMilestoneService_Expecter {
+	return &MockMilestoneService_Expecter{mock: &_m.Mock}
+}
+
+// ClaimMilestone provides a mock function with given fields: userID, milestone, now
+func (_m *MockMilestoneService) ClaimMilestone(userID string, milestone *model.MilestoneRule, now time.Time) (bool, error) {
+	ret := _m.Called(userID, milestone, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimMilestone")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.MilestoneRule, time.Time) (bool, error)); ok {
+		return rf(userID, milestone, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.MilestoneRule, time.Time) bool); ok {
+		r0 = rf(userID, milestone, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.MilestoneRule, time.Time) error); ok {
+		r1 = rf(userID, milestone, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilestoneService_ClaimMilestone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimMilestone'
+type MockMilestoneService_ClaimMilestone_Call struct {
+	*mock.Call
+}
+
+// ClaimMilestone is a helper method to define mock.On call
+//   - userID string
+//   - milestone *model.MilestoneRule
+//   - now time.Time
+func (_e *MockMilestoneService_Expecter) ClaimMilestone(userID interface{}, milestone interface{}, now interface{}) *MockMilestoneService_ClaimMilestone_Call {
+	return &MockMilestoneService_ClaimMilestone_Call{Call: _e.mock.On("ClaimMilestone", userID, milestone, now)}
+}
+
+func (_c *MockMilestoneService_ClaimMilestone_Call) Run(run func(userID string, milestone *model.MilestoneRule, now time.Time)) *MockMilestoneService_ClaimMilestone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*model.MilestoneRule), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneService_ClaimMilestone_Call) Return(_a0 bool, _a1 error) *MockMilestoneService_ClaimMilestone_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilestoneService_ClaimMilestone_Call) RunAndReturn(run func(string, *model.MilestoneRule, time.Time) (bool, error)) *MockMilestoneService_ClaimMilestone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPendingVolumeMilestones provides a mock function with given fields: userID
+func (_m *MockMilestoneService) GetPendingVolumeMilestones(userID string) ([]*model.MilestoneRule, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPendingVolumeMilestones")
+	}
+
+	var r0 []*model.MilestoneRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.MilestoneRule, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.MilestoneRule); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MilestoneRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMilestoneService_GetPendingVolumeMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPendingVolumeMilestones'
+type MockMilestoneService_GetPendingVolumeMilestones_Call struct {
+	*mock.Call
+}
+
+// GetPendingVolumeMilestones is a helper method to define mock.On call
+//   - userID string
+func (_e *MockMilestoneService_Expecter) GetPendingVolumeMilestones(userID interface{}) *MockMilestoneService_GetPendingVolumeMilestones_Call {
+	return &MockMilestoneService_GetPendingVolumeMilestones_Call{Call: _e.mock.On("GetPendingVolumeMilestones", userID)}
+}
+
+func (_c *MockMilestoneService_GetPendingVolumeMilestones_Call) Run(run func(userID string)) *MockMilestoneService_GetPendingVolumeMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneService_GetPendingVolumeMilestones_Call) Return(_a0 []*model.MilestoneRule, _a1 error) *MockMilestoneService_GetPendingVolumeMilestones_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMilestoneService_GetPendingVolumeMilestones_Call) RunAndReturn(run func(string) ([]*model.MilestoneRule, error)) *MockMilestoneService_GetPendingVolumeMilestones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVolumeMilestones provides a mock function with given fields:
+func (_m *MockMilestoneService) GetVolumeMilestones() []*model.MilestoneRule {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVolumeMilestones")
+	}
+
+	var r0 []*model.MilestoneRule
+	if rf, ok := ret.Get(0).(func() []*model.MilestoneRule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MilestoneRule)
+		}
+	}
+
+	return r0
+}
+
+// MockMilestoneService_GetVolumeMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVolumeMilestones'
+type MockMilestoneService_GetVolumeMilestones_Call struct {
+	*mock.Call
+}
+
+// GetVolumeMilestones is a helper method to define mock.On call
+func (_e *MockMilestoneService_Expecter) GetVolumeMilestones() *MockMilestoneService_GetVolumeMilestones_Call {
+	return &MockMilestoneService_GetVolumeMilestones_Call{Call: _e.mock.On("GetVolumeMilestones")}
+}
+
+func (_c *MockMilestoneService_GetVolumeMilestones_Call) Run(run func()) *MockMilestoneService_GetVolumeMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockMilestoneService_GetVolumeMilestones_Call) Return(_a0 []*model.MilestoneRule) *MockMilestoneService_GetVolumeMilestones_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMilestoneService_GetVolumeMilestones_Call) RunAndReturn(run func() []*model.MilestoneRule) *MockMilestoneService_GetVolumeMilestones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseMilestone provides a mock function with given fields: userID, milestone
+func (_m *MockMilestoneService) ReleaseMilestone(userID string, milestone *model.MilestoneRule) error {
+	ret := _m.Called(userID, milestone)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseMilestone")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *model.MilestoneRule) error); ok {
+		r0 = rf(userID, milestone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMilestoneService_ReleaseMilestone_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseMilestone'
+type MockMilestoneService_ReleaseMilestone_Call struct {
+	*mock.Call
+}
+
+// ReleaseMilestone is a helper method to define mock.On call
+//   - userID string
+//   - milestone *model.MilestoneRule
+func (_e *MockMilestoneService_Expecter) ReleaseMilestone(userID interface{}, milestone interface{}) *MockMilestoneService_ReleaseMilestone_Call {
+	return &MockMilestoneService_ReleaseMilestone_Call{Call: _e.mock.On("ReleaseMilestone", userID, milestone)}
+}
+
+func (_c *MockMilestoneService_ReleaseMilestone_Call) Run(run func(userID string, milestone *model.MilestoneRule)) *MockMilestoneService_ReleaseMilestone_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*model.MilestoneRule))
+	})
+	return _c
+}
+
+func (_c *MockMilestoneService_ReleaseMilestone_Call) Return(_a0 error) *MockMilestoneService_ReleaseMilestone_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMilestoneService_ReleaseMilestone_Call) RunAndReturn(run func(string, *model.MilestoneRule) error) *MockMilestoneService_ReleaseMilestone_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMilestoneService creates a new instance of MockMilestoneService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMilestoneService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMilestoneService {
+	mock := &MockMilestoneService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,260 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockMultiplierService is an autogenerated mock type for the MultiplierService type
+type MockMultiplierService struct {
+	mock.Mock
+}
+
+type MockMultiplierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockMultiplierService) EXPECT() *MockMultiplierService_Expecter {
+	return &MockMultiplierService_Expecter{mock: &_m.Mock}
+}
+
+// CreateMultiplier provides a mock function with given fields: multiplier
+func (_m *MockMultiplierService) CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error) {
+	ret := _m.Called(multiplier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateMultiplier")
+	}
+
+	var r0 *model.Multiplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Multiplier) (*model.Multiplier, error)); ok {
+		return rf(multiplier)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Multiplier) *model.Multiplier); ok {
+		r0 = rf(multiplier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Multiplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Multiplier) error); ok {
+		r1 = rf(multiplier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMultiplierService_CreateMultiplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateMultiplier'
+type MockMultiplierService_CreateMultiplier_Call struct {
+	*mock.Call
+}
+
+// CreateMultiplier is a helper method to define mock.On call
+//   - multiplier *model.Multiplier
+func (_e *MockMultiplierService_Expecter) CreateMultiplier(multiplier interface{}) *MockMultiplierService_CreateMultiplier_Call {
+	return &MockMultiplierService_CreateMultiplier_Call{Call: _e.mock.On("CreateMultiplier", multiplier)}
+}
+
+func (_c *MockMultiplierService_CreateMultiplier_Call) Run(run func(multiplier *model.Multiplier)) *MockMultiplierService_CreateMultiplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Multiplier))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierService_CreateMultiplier_Call) Return(_a0 *model.Multiplier, _a1 error) *MockMultiplierService_CreateMultiplier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMultiplierService_CreateMultiplier_Call) RunAndReturn(run func(*model.Multiplier) (*model.Multiplier, error)) *MockMultiplierService_CreateMultiplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteMultiplier provides a mock function with given fields: id
+func (_m *MockMultiplierService) DeleteMultiplier(id int) error {
+	ret := _m.Called(id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteMultiplier")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockMultiplierService_DeleteMultiplier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteMultiplier'
+type MockMultiplierService_DeleteMultiplier_Call struct {
+	*mock.Call
+}
+
+// DeleteMultiplier is a helper method to define mock.On call
+//   - id int
+func (_e *MockMultiplierService_Expecter) DeleteMultiplier(id interface{}) *MockMultiplierService_DeleteMultiplier_Call {
+	return &MockMultiplierService_DeleteMultiplier_Call{Call: _e.mock.On("DeleteMultiplier", id)}
+}
+
+func (_c *MockMultiplierService_DeleteMultiplier_Call) Run(run func(id int)) *MockMultiplierService_DeleteMultiplier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierService_DeleteMultiplier_Call) Return(_a0 error) *MockMultiplierService_DeleteMultiplier_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockMultiplierService_DeleteMultiplier_Call) RunAndReturn(run func(int) error) *MockMultiplierService_DeleteMultiplier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetActiveMultipliers provides a mock function with given fields: campaignID, from, to
+func (_m *MockMultiplierService) GetActiveMultipliers(campaignID int, from time.Time, to time.Time) ([]*model.Multiplier, error) {
+	ret := _m.Called(campaignID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetActiveMultipliers")
+	}
+
+	var r0 []*model.Multiplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Time) ([]*model.Multiplier, error)); ok {
+		return rf(campaignID, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(int, time.Time, time.Time) []*model.Multiplier); ok {
+		r0 = rf(campaignID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Multiplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, time.Time, time.Time) error); ok {
+		r1 = rf(campaignID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMultiplierService_GetActiveMultipliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActiveMultipliers'
+type MockMultiplierService_GetActiveMultipliers_Call struct {
+	*mock.Call
+}
+
+// GetActiveMultipliers is a helper method to define mock.On call
+//   - campaignID int
+//   - from time.Time
+//   - to time.Time
+func (_e *MockMultiplierService_Expecter) GetActiveMultipliers(campaignID interface{}, from interface{}, to interface{}) *MockMultiplierService_GetActiveMultipliers_Call {
+	return &MockMultiplierService_GetActiveMultipliers_Call{Call: _e.mock.On("GetActiveMultipliers", campaignID, from, to)}
+}
+
+func (_c *MockMultiplierService_GetActiveMultipliers_Call) Run(run func(campaignID int, from time.Time, to time.Time)) *MockMultiplierService_GetActiveMultipliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierService_GetActiveMultipliers_Call) Return(_a0 []*model.Multiplier, _a1 error) *MockMultiplierService_GetActiveMultipliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMultiplierService_GetActiveMultipliers_Call) RunAndReturn(run func(int, time.Time, time.Time) ([]*model.Multiplier, error)) *MockMultiplierService_GetActiveMultipliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMultipliers provides a mock function with given fields: campaignID
+func (_m *MockMultiplierService) GetMultipliers(campaignID int) ([]*model.Multiplier, error) {
+	ret := _m.Called(campaignID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMultipliers")
+	}
+
+	var r0 []*model.Multiplier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*model.Multiplier, error)); ok {
+		return rf(campaignID)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*model.Multiplier); ok {
+		r0 = rf(campaignID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Multiplier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(campaignID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockMultiplierService_GetMultipliers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMultipliers'
+type MockMultiplierService_GetMultipliers_Call struct {
+	*mock.Call
+}
+
+// GetMultipliers is a helper method to define mock.On call
+//   - campaignID int
+func (_e *MockMultiplierService_Expecter) GetMultipliers(campaignID interface{}) *MockMultiplierService_GetMultipliers_Call {
+	return &MockMultiplierService_GetMultipliers_Call{Call: _e.mock.On("GetMultipliers", campaignID)}
+}
+
+func (_c *MockMultiplierService_GetMultipliers_Call) Run(run func(campaignID int)) *MockMultiplierService_GetMultipliers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockMultiplierService_GetMultipliers_Call) Return(_a0 []*model.Multiplier, _a1 error) *MockMultiplierService_GetMultipliers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockMultiplierService_GetMultipliers_Call) RunAndReturn(run func(int) ([]*model.Multiplier, error)) *MockMultiplierService_GetMultipliers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockMultiplierService creates a new instance of MockMultiplierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockMultiplierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockMultiplierService {
+	mock := &MockMultiplierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,196 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockPeriodStatsService is an autogenerated mock type for the PeriodStatsService type
+type MockPeriodStatsService struct {
+	mock.Mock
+}
+
+type MockPeriodStatsService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockPeriodStatsService) EXPECT() *MockPeriodStatsService_Expecter {
+	return &MockPeriodStatsService_Expecter{mock: &_m.Mock}
+}
+
+// GetCurrentProjections provides a mock function with given fields: userID, now
+func (_m *MockPeriodStatsService) GetCurrentProjections(userID string, now time.Time) ([]*model.RewardProjection, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCurrentProjections")
+	}
+
+	var r0 []*model.RewardProjection
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.RewardProjection, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.RewardProjection); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RewardProjection)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockPeriodStatsService_GetCurrentProjections_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCurrentProjections'
+type MockPeriodStatsService_GetCurrentProjections_Call struct {
+	*mock.Call
+}
+
+// GetCurrentProjections is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockPeriodStatsService_Expecter) GetCurrentProjections(userID interface{}, now interface{}) *MockPeriodStatsService_GetCurrentProjections_Call {
+	return &MockPeriodStatsService_GetCurrentProjections_Call{Call: _e.mock.On("GetCurrentProjections", userID, now)}
+}
+
+func (_c *MockPeriodStatsService_GetCurrentProjections_Call) Run(run func(userID string, now time.Time)) *MockPeriodStatsService_GetCurrentProjections_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsService_GetCurrentProjections_Call) Return(_a0 []*model.RewardProjection, _a1 error) *MockPeriodStatsService_GetCurrentProjections_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockPeriodStatsService_GetCurrentProjections_Call) RunAndReturn(run func(string, time.Time) ([]*model.RewardProjection, error)) *MockPeriodStatsService_GetCurrentProjections_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSwap provides a mock function with given fields: swapID, campaign, userID, at, swapAmount, onboarded, tier
+func (_m *MockPeriodStatsService) RecordSwap(swapID string, campaign *model.Campaign, userID string, at time.Time, swapAmount float64, onboarded bool, tier *model.Tier) error {
+	ret := _m.Called(swapID, campaign, userID, at, swapAmount, onboarded, tier)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSwap")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *model.Campaign, string, time.Time, float64, bool, *model.Tier) error); ok {
+		r0 = rf(swapID, campaign, userID, at, swapAmount, onboarded, tier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPeriodStatsService_RecordSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSwap'
+type MockPeriodStatsService_RecordSwap_Call struct {
+	*mock.Call
+}
+
+// RecordSwap is a helper method to define mock.On call
+//   - swapID string
+//   - campaign *model.Campaign
+//   - userID string
+//   - at time.Time
+//   - swapAmount float64
+//   - onboarded bool
+//   - tier *model.Tier
+func (_e *MockPeriodStatsService_Expecter) RecordSwap(swapID interface{}, campaign interface{}, userID interface{}, at interface{}, swapAmount interface{}, onboarded interface{}, tier interface{}) *MockPeriodStatsService_RecordSwap_Call {
+	return &MockPeriodStatsService_RecordSwap_Call{Call: _e.mock.On("RecordSwap", swapID, campaign, userID, at, swapAmount, onboarded, tier)}
+}
+
+func (_c *MockPeriodStatsService_RecordSwap_Call) Run(run func(swapID string, campaign *model.Campaign, userID string, at time.Time, swapAmount float64, onboarded bool, tier *model.Tier)) *MockPeriodStatsService_RecordSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(*model.Campaign), args[2].(string), args[3].(time.Time), args[4].(float64), args[5].(bool), args[6].(*model.Tier))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsService_RecordSwap_Call) Return(_a0 error) *MockPeriodStatsService_RecordSwap_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPeriodStatsService_RecordSwap_Call) RunAndReturn(run func(string, *model.Campaign, string, time.Time, float64, bool, *model.Tier) error) *MockPeriodStatsService_RecordSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshPeriod provides a mock function with given fields: campaign, periodIndex
+func (_m *MockPeriodStatsService) RefreshPeriod(campaign *model.Campaign, periodIndex int) error {
+	ret := _m.Called(campaign, periodIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshPeriod")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign, int) error); ok {
+		r0 = rf(campaign, periodIndex)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPeriodStatsService_RefreshPeriod_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshPeriod'
+type MockPeriodStatsService_RefreshPeriod_Call struct {
+	*mock.Call
+}
+
+// RefreshPeriod is a helper method to define mock.On call
+//   - campaign *model.Campaign
+//   - periodIndex int
+func (_e *MockPeriodStatsService_Expecter) RefreshPeriod(campaign interface{}, periodIndex interface{}) *MockPeriodStatsService_RefreshPeriod_Call {
+	return &MockPeriodStatsService_RefreshPeriod_Call{Call: _e.mock.On("RefreshPeriod", campaign, periodIndex)}
+}
+
+func (_c *MockPeriodStatsService_RefreshPeriod_Call) Run(run func(campaign *model.Campaign, periodIndex int)) *MockPeriodStatsService_RefreshPeriod_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockPeriodStatsService_RefreshPeriod_Call) Return(_a0 error) *MockPeriodStatsService_RefreshPeriod_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPeriodStatsService_RefreshPeriod_Call) RunAndReturn(run func(*model.Campaign, int) error) *MockPeriodStatsService_RefreshPeriod_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockPeriodStatsService creates a new instance of MockPeriodStatsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockPeriodStatsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockPeriodStatsService {
+	mock := &MockPeriodStatsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,382 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockRedemptionService is an autogenerated mock type for the RedemptionService type
+type MockRedemptionService struct {
+	mock.Mock
+}
+
+type MockRedemptionService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRedemptionService) EXPECT() *MockRedemptionService_Expecter {
+	return &MockRedemptionService_Expecter{mock: &_m.Mock}
+}
+
+// CancelRedemption provides a mock function with given fields: id, reason, operator, now
+func (_m *MockRedemptionService) CancelRedemption(id int, reason string, operator string, now time.Time) (*model.Redemption, error) {
+	ret := _m.Called(id, reason, operator, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, string, time.Time) (*model.Redemption, error)); ok {
+		return rf(id, reason, operator, now)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, string, time.Time) *model.Redemption); ok {
+		r0 = rf(id, reason, operator, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, string, time.Time) error); ok {
+		r1 = rf(id, reason, operator, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionService_CancelRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelRedemption'
+type MockRedemptionService_CancelRedemption_Call struct {
+	*mock.Call
+}
+
+// CancelRedemption is a helper method to define mock.On call
+//   - id int
+//   - reason string
+//   - operator string
+//   - now time.Time
+func (_e *MockRedemptionService_Expecter) CancelRedemption(id interface{}, reason interface{}, operator interface{}, now interface{}) *MockRedemptionService_CancelRedemption_Call {
+	return &MockRedemptionService_CancelRedemption_Call{Call: _e.mock.On("CancelRedemption", id, reason, operator, now)}
+}
+
+func (_c *MockRedemptionService_CancelRedemption_Call) Run(run func(id int, reason string, operator string, now time.Time)) *MockRedemptionService_CancelRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_CancelRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionService_CancelRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionService_CancelRedemption_Call) RunAndReturn(run func(int, string, string, time.Time) (*model.Redemption, error)) *MockRedemptionService_CancelRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FulfillRedemption provides a mock function with given fields: id, operator, now
+func (_m *MockRedemptionService) FulfillRedemption(id int, operator string, now time.Time) (*model.Redemption, error) {
+	ret := _m.Called(id, operator, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FulfillRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) (*model.Redemption, error)); ok {
+		return rf(id, operator, now)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) *model.Redemption); ok {
+		r0 = rf(id, operator, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, time.Time) error); ok {
+		r1 = rf(id, operator, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionService_FulfillRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FulfillRedemption'
+type MockRedemptionService_FulfillRedemption_Call struct {
+	*mock.Call
+}
+
+// FulfillRedemption is a helper method to define mock.On call
+//   - id int
+//   - operator string
+//   - now time.Time
+func (_e *MockRedemptionService_Expecter) FulfillRedemption(id interface{}, operator interface{}, now interface{}) *MockRedemptionService_FulfillRedemption_Call {
+	return &MockRedemptionService_FulfillRedemption_Call{Call: _e.mock.On("FulfillRedemption", id, operator, now)}
+}
+
+func (_c *MockRedemptionService_FulfillRedemption_Call) Run(run func(id int, operator string, now time.Time)) *MockRedemptionService_FulfillRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_FulfillRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionService_FulfillRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionService_FulfillRedemption_Call) RunAndReturn(run func(int, string, time.Time) (*model.Redemption, error)) *MockRedemptionService_FulfillRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCatalogue provides a mock function with given fields:
+func (_m *MockRedemptionService) GetCatalogue() []*model.CatalogueItem {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCatalogue")
+	}
+
+	var r0 []*model.CatalogueItem
+	if rf, ok := ret.Get(0).(func() []*model.CatalogueItem); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CatalogueItem)
+		}
+	}
+
+	return r0
+}
+
+// MockRedemptionService_GetCatalogue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCatalogue'
+type MockRedemptionService_GetCatalogue_Call struct {
+	*mock.Call
+}
+
+// GetCatalogue is a helper method to define mock.On call
+func (_e *MockRedemptionService_Expecter) GetCatalogue() *MockRedemptionService_GetCatalogue_Call {
+	return &MockRedemptionService_GetCatalogue_Call{Call: _e.mock.On("GetCatalogue")}
+}
+
+func (_c *MockRedemptionService_GetCatalogue_Call) Run(run func()) *MockRedemptionService_GetCatalogue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_GetCatalogue_Call) Return(_a0 []*model.CatalogueItem) *MockRedemptionService_GetCatalogue_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockRedemptionService_GetCatalogue_Call) RunAndReturn(run func() []*model.CatalogueItem) *MockRedemptionService_GetCatalogue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRedemptions provides a mock function with given fields: address
+func (_m *MockRedemptionService) GetRedemptions(address string) ([]*model.Redemption, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRedemptions")
+	}
+
+	var r0 []*model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Redemption, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Redemption); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionService_GetRedemptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRedemptions'
+type MockRedemptionService_GetRedemptions_Call struct {
+	*mock.Call
+}
+
+// GetRedemptions is a helper method to define mock.On call
+//   - address string
+func (_e *MockRedemptionService_Expecter) GetRedemptions(address interface{}) *MockRedemptionService_GetRedemptions_Call {
+	return &MockRedemptionService_GetRedemptions_Call{Call: _e.mock.On("GetRedemptions", address)}
+}
+
+func (_c *MockRedemptionService_GetRedemptions_Call) Run(run func(address string)) *MockRedemptionService_GetRedemptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_GetRedemptions_Call) Return(_a0 []*model.Redemption, _a1 error) *MockRedemptionService_GetRedemptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionService_GetRedemptions_Call) RunAndReturn(run func(string) ([]*model.Redemption, error)) *MockRedemptionService_GetRedemptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestRedemption provides a mock function with given fields: address, itemID, now
+func (_m *MockRedemptionService) RequestRedemption(address string, itemID string, now time.Time) (*model.Redemption, error) {
+	ret := _m.Called(address, itemID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestRedemption")
+	}
+
+	var r0 *model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) (*model.Redemption, error)); ok {
+		return rf(address, itemID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) *model.Redemption); ok {
+		r0 = rf(address, itemID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(address, itemID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionService_RequestRedemption_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestRedemption'
+type MockRedemptionService_RequestRedemption_Call struct {
+	*mock.Call
+}
+
+// RequestRedemption is a helper method to define mock.On call
+//   - address string
+//   - itemID string
+//   - now time.Time
+func (_e *MockRedemptionService_Expecter) RequestRedemption(address interface{}, itemID interface{}, now interface{}) *MockRedemptionService_RequestRedemption_Call {
+	return &MockRedemptionService_RequestRedemption_Call{Call: _e.mock.On("RequestRedemption", address, itemID, now)}
+}
+
+func (_c *MockRedemptionService_RequestRedemption_Call) Run(run func(address string, itemID string, now time.Time)) *MockRedemptionService_RequestRedemption_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_RequestRedemption_Call) Return(_a0 *model.Redemption, _a1 error) *MockRedemptionService_RequestRedemption_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionService_RequestRedemption_Call) RunAndReturn(run func(string, string, time.Time) (*model.Redemption, error)) *MockRedemptionService_RequestRedemption_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchRedemptions provides a mock function with given fields: statuses
+func (_m *MockRedemptionService) SearchRedemptions(statuses []model.RedemptionStatus) ([]*model.Redemption, error) {
+	ret := _m.Called(statuses)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchRedemptions")
+	}
+
+	var r0 []*model.Redemption
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]model.RedemptionStatus) ([]*model.Redemption, error)); ok {
+		return rf(statuses)
+	}
+	if rf, ok := ret.Get(0).(func([]model.RedemptionStatus) []*model.Redemption); ok {
+		r0 = rf(statuses)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Redemption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]model.RedemptionStatus) error); ok {
+		r1 = rf(statuses)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRedemptionService_SearchRedemptions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchRedemptions'
+type MockRedemptionService_SearchRedemptions_Call struct {
+	*mock.Call
+}
+
+// SearchRedemptions is a helper method to define mock.On call
+//   - statuses []model.RedemptionStatus
+func (_e *MockRedemptionService_Expecter) SearchRedemptions(statuses interface{}) *MockRedemptionService_SearchRedemptions_Call {
+	return &MockRedemptionService_SearchRedemptions_Call{Call: _e.mock.On("SearchRedemptions", statuses)}
+}
+
+func (_c *MockRedemptionService_SearchRedemptions_Call) Run(run func(statuses []model.RedemptionStatus)) *MockRedemptionService_SearchRedemptions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]model.RedemptionStatus))
+	})
+	return _c
+}
+
+func (_c *MockRedemptionService_SearchRedemptions_Call) Return(_a0 []*model.Redemption, _a1 error) *MockRedemptionService_SearchRedemptions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRedemptionService_SearchRedemptions_Call) RunAndReturn(run func([]model.RedemptionStatus) ([]*model.Redemption, error)) *MockRedemptionService_SearchRedemptions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockRedemptionService creates a new instance of MockRedemptionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRedemptionService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRedemptionService {
+	mock := &MockRedemptionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,529 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockReferralService is an autogenerated mock type for the ReferralService type
+type MockReferralService struct {
+	mock.Mock
+}
+
+type MockReferralService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockReferralService) EXPECT() *MockReferralService_Expecter {
+	return &MockReferralService_Expecter{mock: &_m.Mock}
+}
+
+// ClaimSharedPoolReward provides a mock function with given fields: taskID, referrerID, now
+func (_m *MockReferralService) ClaimSharedPoolReward(taskID int, referrerID string, now time.Time) (bool, error) {
+	ret := _m.Called(taskID, referrerID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimSharedPoolReward")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) (bool, error)); ok {
+		return rf(taskID, referrerID, now)
+	}
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) bool); ok {
+		r0 = rf(taskID, referrerID, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(int, string, time.Time) error); ok {
+		r1 = rf(taskID, referrerID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_ClaimSharedPoolReward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimSharedPoolReward'
+type MockReferralService_ClaimSharedPoolReward_Call struct {
+	*mock.Call
+}
+
+// ClaimSharedPoolReward is a helper method to define mock.On call
+//   - taskID int
+//   - referrerID string
+//   - now time.Time
+func (_e *MockReferralService_Expecter) ClaimSharedPoolReward(taskID interface{}, referrerID interface{}, now interface{}) *MockReferralService_ClaimSharedPoolReward_Call {
+	return &MockReferralService_ClaimSharedPoolReward_Call{Call: _e.mock.On("ClaimSharedPoolReward", taskID, referrerID, now)}
+}
+
+func (_c *MockReferralService_ClaimSharedPoolReward_Call) Run(run func(taskID int, referrerID string, now time.Time)) *MockReferralService_ClaimSharedPoolReward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_ClaimSharedPoolReward_Call) Return(_a0 bool, _a1 error) *MockReferralService_ClaimSharedPoolReward_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_ClaimSharedPoolReward_Call) RunAndReturn(run func(int, string, time.Time) (bool, error)) *MockReferralService_ClaimSharedPoolReward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferralInfo provides a mock function with given fields: userID
+func (_m *MockReferralService) GetReferralInfo(userID string) (*model.ReferralInfo, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferralInfo")
+	}
+
+	var r0 *model.ReferralInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ReferralInfo, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ReferralInfo); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_GetReferralInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferralInfo'
+type MockReferralService_GetReferralInfo_Call struct {
+	*mock.Call
+}
+
+// GetReferralInfo is a helper method to define mock.On call
+//   - userID string
+func (_e *MockReferralService_Expecter) GetReferralInfo(userID interface{}) *MockReferralService_GetReferralInfo_Call {
+	return &MockReferralService_GetReferralInfo_Call{Call: _e.mock.On("GetReferralInfo", userID)}
+}
+
+func (_c *MockReferralService_GetReferralInfo_Call) Run(run func(userID string)) *MockReferralService_GetReferralInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_GetReferralInfo_Call) Return(_a0 *model.ReferralInfo, _a1 error) *MockReferralService_GetReferralInfo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_GetReferralInfo_Call) RunAndReturn(run func(string) (*model.ReferralInfo, error)) *MockReferralService_GetReferralInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetReferrers provides a mock function with given fields: refereeIDs
+func (_m *MockReferralService) GetReferrers(refereeIDs []string) (map[string]string, error) {
+	ret := _m.Called(refereeIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetReferrers")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]string, error)); ok {
+		return rf(refereeIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]string); ok {
+		r0 = rf(refereeIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(refereeIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_GetReferrers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetReferrers'
+type MockReferralService_GetReferrers_Call struct {
+	*mock.Call
+}
+
+// GetReferrers is a helper method to define mock.On call
+//   - refereeIDs []string
+func (_e *MockReferralService_Expecter) GetReferrers(refereeIDs interface{}) *MockReferralService_GetReferrers_Call {
+	return &MockReferralService_GetReferrers_Call{Call: _e.mock.On("GetReferrers", refereeIDs)}
+}
+
+func (_c *MockReferralService_GetReferrers_Call) Run(run func(refereeIDs []string)) *MockReferralService_GetReferrers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_GetReferrers_Call) Return(_a0 map[string]string, _a1 error) *MockReferralService_GetReferrers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_GetReferrers_Call) RunAndReturn(run func([]string) (map[string]string, error)) *MockReferralService_GetReferrers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRewards provides a mock function with given fields:
+func (_m *MockReferralService) GetRewards() *model.ReferralRewards {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRewards")
+	}
+
+	var r0 *model.ReferralRewards
+	if rf, ok := ret.Get(0).(func() *model.ReferralRewards); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralRewards)
+		}
+	}
+
+	return r0
+}
+
+// MockReferralService_GetRewards_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRewards'
+type MockReferralService_GetRewards_Call struct {
+	*mock.Call
+}
+
+// GetRewards is a helper method to define mock.On call
+func (_e *MockReferralService_Expecter) GetRewards() *MockReferralService_GetRewards_Call {
+	return &MockReferralService_GetRewards_Call{Call: _e.mock.On("GetRewards")}
+}
+
+func (_c *MockReferralService_GetRewards_Call) Run(run func()) *MockReferralService_GetRewards_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockReferralService_GetRewards_Call) Return(_a0 *model.ReferralRewards) *MockReferralService_GetRewards_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferralService_GetRewards_Call) RunAndReturn(run func() *model.ReferralRewards) *MockReferralService_GetRewards_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LinkReferral provides a mock function with given fields: refereeID, code, signature
+func (_m *MockReferralService) LinkReferral(refereeID string, code string, signature string) (*model.Referral, error) {
+	ret := _m.Called(refereeID, code, signature)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkReferral")
+	}
+
+	var r0 *model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.Referral, error)); ok {
+		return rf(refereeID, code, signature)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.Referral); ok {
+		r0 = rf(refereeID, code, signature)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(refereeID, code, signature)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_LinkReferral_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LinkReferral'
+type MockReferralService_LinkReferral_Call struct {
+	*mock.Call
+}
+
+// LinkReferral is a helper method to define mock.On call
+//   - refereeID string
+//   - code string
+//   - signature string
+func (_e *MockReferralService_Expecter) LinkReferral(refereeID interface{}, code interface{}, signature interface{}) *MockReferralService_LinkReferral_Call {
+	return &MockReferralService_LinkReferral_Call{Call: _e.mock.On("LinkReferral", refereeID, code, signature)}
+}
+
+func (_c *MockReferralService_LinkReferral_Call) Run(run func(refereeID string, code string, signature string)) *MockReferralService_LinkReferral_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_LinkReferral_Call) Return(_a0 *model.Referral, _a1 error) *MockReferralService_LinkReferral_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_LinkReferral_Call) RunAndReturn(run func(string, string, string) (*model.Referral, error)) *MockReferralService_LinkReferral_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OnboardReferee provides a mock function with given fields: refereeID, now
+func (_m *MockReferralService) OnboardReferee(refereeID string, now time.Time) (*model.Referral, error) {
+	ret := _m.Called(refereeID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OnboardReferee")
+	}
+
+	var r0 *model.Referral
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*model.Referral, error)); ok {
+		return rf(refereeID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *model.Referral); ok {
+		r0 = rf(refereeID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Referral)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(refereeID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_OnboardReferee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OnboardReferee'
+type MockReferralService_OnboardReferee_Call struct {
+	*mock.Call
+}
+
+// OnboardReferee is a helper method to define mock.On call
+//   - refereeID string
+//   - now time.Time
+func (_e *MockReferralService_Expecter) OnboardReferee(refereeID interface{}, now interface{}) *MockReferralService_OnboardReferee_Call {
+	return &MockReferralService_OnboardReferee_Call{Call: _e.mock.On("OnboardReferee", refereeID, now)}
+}
+
+func (_c *MockReferralService_OnboardReferee_Call) Run(run func(refereeID string, now time.Time)) *MockReferralService_OnboardReferee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_OnboardReferee_Call) Return(_a0 *model.Referral, _a1 error) *MockReferralService_OnboardReferee_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_OnboardReferee_Call) RunAndReturn(run func(string, time.Time) (*model.Referral, error)) *MockReferralService_OnboardReferee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RegisterCode provides a mock function with given fields: userID, code
+func (_m *MockReferralService) RegisterCode(userID string, code string) (*model.ReferralCode, error) {
+	ret := _m.Called(userID, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RegisterCode")
+	}
+
+	var r0 *model.ReferralCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.ReferralCode, error)); ok {
+		return rf(userID, code)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.ReferralCode); ok {
+		r0 = rf(userID, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReferralCode)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userID, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockReferralService_RegisterCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegisterCode'
+type MockReferralService_RegisterCode_Call struct {
+	*mock.Call
+}
+
+// RegisterCode is a helper method to define mock.On call
+//   - userID string
+//   - code string
+func (_e *MockReferralService_Expecter) RegisterCode(userID interface{}, code interface{}) *MockReferralService_RegisterCode_Call {
+	return &MockReferralService_RegisterCode_Call{Call: _e.mock.On("RegisterCode", userID, code)}
+}
+
+func (_c *MockReferralService_RegisterCode_Call) Run(run func(userID string, code string)) *MockReferralService_RegisterCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_RegisterCode_Call) Return(_a0 *model.ReferralCode, _a1 error) *MockReferralService_RegisterCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockReferralService_RegisterCode_Call) RunAndReturn(run func(string, string) (*model.ReferralCode, error)) *MockReferralService_RegisterCode_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseReferee provides a mock function with given fields: refereeID
+func (_m *MockReferralService) ReleaseReferee(refereeID string) error {
+	ret := _m.Called(refereeID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseReferee")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(refereeID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferralService_ReleaseReferee_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseReferee'
+type MockReferralService_ReleaseReferee_Call struct {
+	*mock.Call
+}
+
+// ReleaseReferee is a helper method to define mock.On call
+//   - refereeID string
+func (_e *MockReferralService_Expecter) ReleaseReferee(refereeID interface{}) *MockReferralService_ReleaseReferee_Call {
+	return &MockReferralService_ReleaseReferee_Call{Call: _e.mock.On("ReleaseReferee", refereeID)}
+}
+
+func (_c *MockReferralService_ReleaseReferee_Call) Run(run func(refereeID string)) *MockReferralService_ReleaseReferee_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_ReleaseReferee_Call) Return(_a0 error) *MockReferralService_ReleaseReferee_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferralService_ReleaseReferee_Call) RunAndReturn(run func(string) error) *MockReferralService_ReleaseReferee_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseSharedPoolReward provides a mock function with given fields: taskID
+func (_m *MockReferralService) ReleaseSharedPoolReward(taskID int) error {
+	ret := _m.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseSharedPoolReward")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockReferralService_ReleaseSharedPoolReward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseSharedPoolReward'
+type MockReferralService_ReleaseSharedPoolReward_Call struct {
+	*mock.Call
+}
+
+// ReleaseSharedPoolReward is a helper method to define mock.On call
+//   - taskID int
+func (_e *MockReferralService_Expecter) ReleaseSharedPoolReward(taskID interface{}) *MockReferralService_ReleaseSharedPoolReward_Call {
+	return &MockReferralService_ReleaseSharedPoolReward_Call{Call: _e.mock.On("ReleaseSharedPoolReward", taskID)}
+}
+
+func (_c *MockReferralService_ReleaseSharedPoolReward_Call) Run(run func(taskID int)) *MockReferralService_ReleaseSharedPoolReward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockReferralService_ReleaseSharedPoolReward_Call) Return(_a0 error) *MockReferralService_ReleaseSharedPoolReward_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockReferralService_ReleaseSharedPoolReward_Call) RunAndReturn(run func(int) error) *MockReferralService_ReleaseSharedPoolReward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockReferralService creates a new instance of MockReferralService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockReferralService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockReferralService {
+	mock := &MockReferralService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
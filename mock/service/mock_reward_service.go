@@ -7,6 +7,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	repository "trading-ace/src/repository"
+
 	time "time"
 )
 
@@ -23,9 +25,9 @@ func (_m *MockRewardService) EXPECT() *MockRewardService_Expecter {
 	return &MockRewardService_Expecter{mock: &_m.Mock}
 }
 
-// GetRewardHistory provides a mock function with given fields: userID, startTime, duration
-func (_m *MockRewardService) GetRewardHistory(userID string, startTime time.Time, duration time.Duration) ([]*model.RewardRecord, error) {
-	ret := _m.Called(userID, startTime, duration)
+// GetRewardHistory provides a mock function with given fields: userID, startTime, duration, page
+func (_m *MockRewardService) GetRewardHistory(userID string, startTime time.Time, duration time.Duration, page *repository.Page) ([]*model.RewardRecord, error) {
+	ret := _m.Called(userID, startTime, duration, page)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetRewardHistory")
@@ -33,19 +35,19 @@ func (_m *MockRewardService) GetRewardHistory(userID string, startTime time.Time
 
 	var r0 []*model.RewardRecord
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration) ([]*model.RewardRecord, error)); ok {
-		return rf(userID, startTime, duration)
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration, *repository.Page) ([]*model.RewardRecord, error)); ok {
+		return rf(userID, startTime, duration, page)
 	}
-	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration) []*model.RewardRecord); ok {
-		r0 = rf(userID, startTime, duration)
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration, *repository.Page) []*model.RewardRecord); ok {
+		r0 = rf(userID, startTime, duration, page)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*model.RewardRecord)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, time.Time, time.Duration) error); ok {
-		r1 = rf(userID, startTime, duration)
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Duration, *repository.Page) error); ok {
+		r1 = rf(userID, startTime, duration, page)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +64,14 @@ type MockRewardService_GetRewardHistory_Call struct {
 //   - userID string
 //   - startTime time.Time
 //   - duration time.Duration
-func (_e *MockRewardService_Expecter) GetRewardHistory(userID interface{}, startTime interface{}, duration interface{}) *MockRewardService_GetRewardHistory_Call {
-	return &MockRewardService_GetRewardHistory_Call{Call: _e.mock.On("GetRewardHistory", userID, startTime, duration)}
+//   - page *repository.Page
+func (_e *MockRewardService_Expecter) GetRewardHistory(userID interface{}, startTime interface{}, duration interface{}, page interface{}) *MockRewardService_GetRewardHistory_Call {
+	return &MockRewardService_GetRewardHistory_Call{Call: _e.mock.On("GetRewardHistory", userID, startTime, duration, page)}
 }
 
-func (_c *MockRewardService_GetRewardHistory_Call) Run(run func(userID string, startTime time.Time, duration time.Duration)) *MockRewardService_GetRewardHistory_Call {
+func (_c *MockRewardService_GetRewardHistory_Call) Run(run func(userID string, startTime time.Time, duration time.Duration, page *repository.Page)) *MockRewardService_GetRewardHistory_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(time.Time), args[2].(time.Duration))
+		run(args[0].(string), args[1].(time.Time), args[2].(time.Duration), args[3].(*repository.Page))
 	})
 	return _c
 }
@@ -78,7 +81,7 @@ func (_c *MockRewardService_GetRewardHistory_Call) Return(_a0 []*model.RewardRec
 	return _c
 }
 
-func (_c *MockRewardService_GetRewardHistory_Call) RunAndReturn(run func(string, time.Time, time.Duration) ([]*model.RewardRecord, error)) *MockRewardService_GetRewardHistory_Call {
+func (_c *MockRewardService_GetRewardHistory_Call) RunAndReturn(run func(string, time.Time, time.Duration, *repository.Page) ([]*model.RewardRecord, error)) *MockRewardService_GetRewardHistory_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -141,17 +144,75 @@ func (_c *MockRewardService_GetRewardHistoryByTaskID_Call) RunAndReturn(run func
 	return _c
 }
 
-// RewardUser provides a mock function with given fields: userID, TaskID, points
-func (_m *MockRewardService) RewardUser(userID string, TaskID int, points float64) error {
-	ret := _m.Called(userID, TaskID, points)
+// GetRewardHistoryByTaskIDs provides a mock function with given fields: taskIDs
+func (_m *MockRewardService) GetRewardHistoryByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error) {
+	ret := _m.Called(taskIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRewardHistoryByTaskIDs")
+	}
+
+	var r0 map[int]*model.RewardRecord
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]int) (map[int]*model.RewardRecord, error)); ok {
+		return rf(taskIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]int) map[int]*model.RewardRecord); ok {
+		r0 = rf(taskIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]*model.RewardRecord)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]int) error); ok {
+		r1 = rf(taskIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockRewardService_GetRewardHistoryByTaskIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRewardHistoryByTaskIDs'
+type MockRewardService_GetRewardHistoryByTaskIDs_Call struct {
+	*mock.Call
+}
+
+// GetRewardHistoryByTaskIDs is a helper method to define mock.On call
+//   - taskIDs []int
+func (_e *MockRewardService_Expecter) GetRewardHistoryByTaskIDs(taskIDs interface{}) *MockRewardService_GetRewardHistoryByTaskIDs_Call {
+	return &MockRewardService_GetRewardHistoryByTaskIDs_Call{Call: _e.mock.On("GetRewardHistoryByTaskIDs", taskIDs)}
+}
+
+func (_c *MockRewardService_GetRewardHistoryByTaskIDs_Call) Run(run func(taskIDs []int)) *MockRewardService_GetRewardHistoryByTaskIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]int))
+	})
+	return _c
+}
+
+func (_c *MockRewardService_GetRewardHistoryByTaskIDs_Call) Return(_a0 map[int]*model.RewardRecord, _a1 error) *MockRewardService_GetRewardHistoryByTaskIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockRewardService_GetRewardHistoryByTaskIDs_Call) RunAndReturn(run func([]int) (map[int]*model.RewardRecord, error)) *MockRewardService_GetRewardHistoryByTaskIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RewardUser provides a mock function with given fields: userID, campaignID, TaskID, points, multipliers
+func (_m *MockRewardService) RewardUser(userID string, campaignID int, TaskID int, points float64, multipliers []*model.AppliedMultiplier) error {
+	ret := _m.Called(userID, campaignID, TaskID, points, multipliers)
 
 	if len(ret) == 0 {
 		panic("no return value specified for RewardUser")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, int, float64) error); ok {
-		r0 = rf(userID, TaskID, points)
+	if rf, ok := ret.Get(0).(func(string, int, int, float64, []*model.AppliedMultiplier) error); ok {
+		r0 = rf(userID, campaignID, TaskID, points, multipliers)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -166,15 +227,17 @@ type MockRewardService_RewardUser_Call struct {
 
 // RewardUser is a helper method to define mock.On call
 //   - userID string
+//   - campaignID int
 //   - TaskID int
 //   - points float64
-func (_e *MockRewardService_Expecter) RewardUser(userID interface{}, TaskID interface{}, points interface{}) *MockRewardService_RewardUser_Call {
-	return &MockRewardService_RewardUser_Call{Call: _e.mock.On("RewardUser", userID, TaskID, points)}
+//   - multipliers []*model.AppliedMultiplier
+func (_e *MockRewardService_Expecter) RewardUser(userID interface{}, campaignID interface{}, TaskID interface{}, points interface{}, multipliers interface{}) *MockRewardService_RewardUser_Call {
+	return &MockRewardService_RewardUser_Call{Call: _e.mock.On("RewardUser", userID, campaignID, TaskID, points, multipliers)}
 }
 
-func (_c *MockRewardService_RewardUser_Call) Run(run func(userID string, TaskID int, points float64)) *MockRewardService_RewardUser_Call {
+func (_c *MockRewardService_RewardUser_Call) Run(run func(userID string, campaignID int, TaskID int, points float64, multipliers []*model.AppliedMultiplier)) *MockRewardService_RewardUser_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(int), args[2].(float64))
+		run(args[0].(string), args[1].(int), args[2].(int), args[3].(float64), args[4].([]*model.AppliedMultiplier))
 	})
 	return _c
 }
@@ -184,7 +247,7 @@ func (_c *MockRewardService_RewardUser_Call) Return(_a0 error) *MockRewardServic
 	return _c
 }
 
-func (_c *MockRewardService_RewardUser_Call) RunAndReturn(run func(string, int, float64) error) *MockRewardService_RewardUser_Call {
+func (_c *MockRewardService_RewardUser_Call) RunAndReturn(run func(string, int, int, float64, []*model.AppliedMultiplier) error) *MockRewardService_RewardUser_Call {
 	_c.Call.Return(run)
 	return _c
 }
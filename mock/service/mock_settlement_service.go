@@ -0,0 +1,206 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockSettlementService is an autogenerated mock type for the SettlementService type
+type MockSettlementService struct {
+	mock.Mock
+}
+
+type MockSettlementService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSettlementService) EXPECT() *MockSettlementService_Expecter {
+	return &MockSettlementService_Expecter{mock: &_m.Mock}
+}
+
+// ExecuteSettlement provides a mock function with given fields: ctx, campaignID, periodIndex, operator
+func (_m *MockSettlementService) ExecuteSettlement(ctx context.Context, campaignID int, periodIndex int, operator string) (*model.Settlement, error) {
+	ret := _m.Called(ctx, campaignID, periodIndex, operator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteSettlement")
+	}
+
+	var r0 *model.Settlement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) (*model.Settlement, error)); ok {
+		return rf(ctx, campaignID, periodIndex, operator)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int, string) *model.Settlement); ok {
+		r0 = rf(ctx, campaignID, periodIndex, operator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Settlement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int, string) error); ok {
+		r1 = rf(ctx, campaignID, periodIndex, operator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettlementService_ExecuteSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExecuteSettlement'
+type MockSettlementService_ExecuteSettlement_Call struct {
+	*mock.Call
+}
+
+// ExecuteSettlement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - campaignID int
+//   - periodIndex int
+//   - operator string
+func (_e *MockSettlementService_Expecter) ExecuteSettlement(ctx interface{}, campaignID interface{}, periodIndex interface{}, operator interface{}) *MockSettlementService_ExecuteSettlement_Call {
+	return &MockSettlementService_ExecuteSettlement_Call{Call: _e.mock.On("ExecuteSettlement", ctx, campaignID, periodIndex, operator)}
+}
+
+func (_c *MockSettlementService_ExecuteSettlement_Call) Run(run func(ctx context.Context, campaignID int, periodIndex int, operator string)) *MockSettlementService_ExecuteSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *MockSettlementService_ExecuteSettlement_Call) Return(_a0 *model.Settlement, _a1 error) *MockSettlementService_ExecuteSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettlementService_ExecuteSettlement_Call) RunAndReturn(run func(context.Context, int, int, string) (*model.Settlement, error)) *MockSettlementService_ExecuteSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PreviewSettlement provides a mock function with given fields: campaignID, periodIndex
+func (_m *MockSettlementService) PreviewSettlement(campaignID int, periodIndex int) (*model.SettlementPreview, error) {
+	ret := _m.Called(campaignID, periodIndex)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewSettlement")
+	}
+
+	var r0 *model.SettlementPreview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) (*model.SettlementPreview, error)); ok {
+		return rf(campaignID, periodIndex)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) *model.SettlementPreview); ok {
+		r0 = rf(campaignID, periodIndex)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SettlementPreview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(campaignID, periodIndex)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSettlementService_PreviewSettlement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewSettlement'
+type MockSettlementService_PreviewSettlement_Call struct {
+	*mock.Call
+}
+
+// PreviewSettlement is a helper method to define mock.On call
+//   - campaignID int
+//   - periodIndex int
+func (_e *MockSettlementService_Expecter) PreviewSettlement(campaignID interface{}, periodIndex interface{}) *MockSettlementService_PreviewSettlement_Call {
+	return &MockSettlementService_PreviewSettlement_Call{Call: _e.mock.On("PreviewSettlement", campaignID, periodIndex)}
+}
+
+func (_c *MockSettlementService_PreviewSettlement_Call) Run(run func(campaignID int, periodIndex int)) *MockSettlementService_PreviewSettlement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *MockSettlementService_PreviewSettlement_Call) Return(_a0 *model.SettlementPreview, _a1 error) *MockSettlementService_PreviewSettlement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSettlementService_PreviewSettlement_Call) RunAndReturn(run func(int, int) (*model.SettlementPreview, error)) *MockSettlementService_PreviewSettlement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SettleDuePeriods provides a mock function with given fields: ctx, now
+func (_m *MockSettlementService) SettleDuePeriods(ctx context.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SettleDuePeriods")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockSettlementService_SettleDuePeriods_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SettleDuePeriods'
+type MockSettlementService_SettleDuePeriods_Call struct {
+	*mock.Call
+}
+
+// SettleDuePeriods is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockSettlementService_Expecter) SettleDuePeriods(ctx interface{}, now interface{}) *MockSettlementService_SettleDuePeriods_Call {
+	return &MockSettlementService_SettleDuePeriods_Call{Call: _e.mock.On("SettleDuePeriods", ctx, now)}
+}
+
+func (_c *MockSettlementService_SettleDuePeriods_Call) Run(run func(ctx context.Context, now time.Time)) *MockSettlementService_SettleDuePeriods_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSettlementService_SettleDuePeriods_Call) Return(_a0 error) *MockSettlementService_SettleDuePeriods_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSettlementService_SettleDuePeriods_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockSettlementService_SettleDuePeriods_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSettlementService creates a new instance of MockSettlementService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSettlementService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSettlementService {
+	mock := &MockSettlementService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
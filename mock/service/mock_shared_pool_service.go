@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockSharedPoolService is an autogenerated mock type for the SharedPoolService type
+type MockSharedPoolService struct {
+	mock.Mock
+}
+
+type MockSharedPoolService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockSharedPoolService) EXPECT() *MockSharedPoolService_Expecter {
+	return &MockSharedPoolService_Expecter{mock: &_m.Mock}
+}
+
+// PreviewSharedPool provides a mock function with given fields: campaign, from, to
+func (_m *MockSharedPoolService) PreviewSharedPool(campaign *model.Campaign, from time.Time, to time.Time) ([]*model.SharedPoolPayout, error) {
+	ret := _m.Called(campaign, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PreviewSharedPool")
+	}
+
+	var r0 []*model.SharedPoolPayout
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Campaign, time.Time, time.Time) ([]*model.SharedPoolPayout, error)); ok {
+		return rf(campaign, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Campaign, time.Time, time.Time) []*model.SharedPoolPayout); ok {
+		r0 = rf(campaign, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SharedPoolPayout)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Campaign, time.Time, time.Time) error); ok {
+		r1 = rf(campaign, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockSharedPoolService_PreviewSharedPool_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewSharedPool'
+type MockSharedPoolService_PreviewSharedPool_Call struct {
+	*mock.Call
+}
+
+// PreviewSharedPool is a helper method to define mock.On call
+//   - campaign *model.Campaign
+//   - from time.Time
+//   - to time.Time
+func (_e *MockSharedPoolService_Expecter) PreviewSharedPool(campaign interface{}, from interface{}, to interface{}) *MockSharedPoolService_PreviewSharedPool_Call {
+	return &MockSharedPoolService_PreviewSharedPool_Call{Call: _e.mock.On("PreviewSharedPool", campaign, from, to)}
+}
+
+func (_c *MockSharedPoolService_PreviewSharedPool_Call) Run(run func(campaign *model.Campaign, from time.Time, to time.Time)) *MockSharedPoolService_PreviewSharedPool_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*model.Campaign), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockSharedPoolService_PreviewSharedPool_Call) Return(_a0 []*model.SharedPoolPayout, _a1 error) *MockSharedPoolService_PreviewSharedPool_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockSharedPoolService_PreviewSharedPool_Call) RunAndReturn(run func(*model.Campaign, time.Time, time.Time) ([]*model.SharedPoolPayout, error)) *MockSharedPoolService_PreviewSharedPool_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockSharedPoolService creates a new instance of MockSharedPoolService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockSharedPoolService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockSharedPoolService {
+	mock := &MockSharedPoolService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,203 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockStreakService is an autogenerated mock type for the StreakService type
+type MockStreakService struct {
+	mock.Mock
+}
+
+type MockStreakService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockStreakService) EXPECT() *MockStreakService_Expecter {
+	return &MockStreakService_Expecter{mock: &_m.Mock}
+}
+
+// GetMilestones provides a mock function with given fields:
+func (_m *MockStreakService) GetMilestones() []*model.StreakMilestone {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMilestones")
+	}
+
+	var r0 []*model.StreakMilestone
+	if rf, ok := ret.Get(0).(func() []*model.StreakMilestone); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StreakMilestone)
+		}
+	}
+
+	return r0
+}
+
+// MockStreakService_GetMilestones_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMilestones'
+type MockStreakService_GetMilestones_Call struct {
+	*mock.Call
+}
+
+// GetMilestones is a helper method to define mock.On call
+func (_e *MockStreakService_Expecter) GetMilestones() *MockStreakService_GetMilestones_Call {
+	return &MockStreakService_GetMilestones_Call{Call: _e.mock.On("GetMilestones")}
+}
+
+func (_c *MockStreakService_GetMilestones_Call) Run(run func()) *MockStreakService_GetMilestones_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockStreakService_GetMilestones_Call) Return(_a0 []*model.StreakMilestone) *MockStreakService_GetMilestones_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockStreakService_GetMilestones_Call) RunAndReturn(run func() []*model.StreakMilestone) *MockStreakService_GetMilestones_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStreaks provides a mock function with given fields: userID, now
+func (_m *MockStreakService) GetStreaks(userID string, now time.Time) ([]*model.Streak, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStreaks")
+	}
+
+	var r0 []*model.Streak
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.Streak, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.Streak); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Streak)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreakService_GetStreaks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStreaks'
+type MockStreakService_GetStreaks_Call struct {
+	*mock.Call
+}
+
+// GetStreaks is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockStreakService_Expecter) GetStreaks(userID interface{}, now interface{}) *MockStreakService_GetStreaks_Call {
+	return &MockStreakService_GetStreaks_Call{Call: _e.mock.On("GetStreaks", userID, now)}
+}
+
+func (_c *MockStreakService_GetStreaks_Call) Run(run func(userID string, now time.Time)) *MockStreakService_GetStreaks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStreakService_GetStreaks_Call) Return(_a0 []*model.Streak, _a1 error) *MockStreakService_GetStreaks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreakService_GetStreaks_Call) RunAndReturn(run func(string, time.Time) ([]*model.Streak, error)) *MockStreakService_GetStreaks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSwap provides a mock function with given fields: userID, now
+func (_m *MockStreakService) RecordSwap(userID string, now time.Time) ([]*model.MilestoneRule, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSwap")
+	}
+
+	var r0 []*model.MilestoneRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.MilestoneRule, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.MilestoneRule); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.MilestoneRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockStreakService_RecordSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSwap'
+type MockStreakService_RecordSwap_Call struct {
+	*mock.Call
+}
+
+// RecordSwap is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockStreakService_Expecter) RecordSwap(userID interface{}, now interface{}) *MockStreakService_RecordSwap_Call {
+	return &MockStreakService_RecordSwap_Call{Call: _e.mock.On("RecordSwap", userID, now)}
+}
+
+func (_c *MockStreakService_RecordSwap_Call) Run(run func(userID string, now time.Time)) *MockStreakService_RecordSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockStreakService_RecordSwap_Call) Return(_a0 []*model.MilestoneRule, _a1 error) *MockStreakService_RecordSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockStreakService_RecordSwap_Call) RunAndReturn(run func(string, time.Time) ([]*model.MilestoneRule, error)) *MockStreakService_RecordSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockStreakService creates a new instance of MockStreakService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockStreakService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockStreakService {
+	mock := &MockStreakService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
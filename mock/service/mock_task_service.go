@@ -69,9 +69,70 @@ func (_c *MockTaskService_CompleteTask_Call) RunAndReturn(run func(int) error) *
 	return _c
 }
 
-// CreateTask provides a mock function with given fields: userId, taskType, swapAmount
-func (_m *MockTaskService) CreateTask(userId string, taskType model.TaskType, swapAmount float64) (*model.Task, error) {
-	ret := _m.Called(userId, taskType, swapAmount)
+// CreateSharedPoolTask provides a mock function with given fields: swapID, userId, campaignID, swapAmount
+func (_m *MockTaskService) CreateSharedPoolTask(swapID string, userId string, campaignID int, swapAmount float64) (*model.Task, error) {
+	ret := _m.Called(swapID, userId, campaignID, swapAmount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSharedPoolTask")
+	}
+
+	var r0 *model.Task
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, float64) (*model.Task, error)); ok {
+		return rf(swapID, userId, campaignID, swapAmount)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, float64) *model.Task); ok {
+		r0 = rf(swapID, userId, campaignID, swapAmount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Task)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, float64) error); ok {
+		r1 = rf(swapID, userId, campaignID, swapAmount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTaskService_CreateSharedPoolTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateSharedPoolTask'
+type MockTaskService_CreateSharedPoolTask_Call struct {
+	*mock.Call
+}
+
+// CreateSharedPoolTask is a helper method to define mock.On call
+//   - swapID string
+//   - userId string
+//   - campaignID int
+//   - swapAmount float64
+func (_e *MockTaskService_Expecter) CreateSharedPoolTask(swapID interface{}, userId interface{}, campaignID interface{}, swapAmount interface{}) *MockTaskService_CreateSharedPoolTask_Call {
+	return &MockTaskService_CreateSharedPoolTask_Call{Call: _e.mock.On("CreateSharedPoolTask", swapID, userId, campaignID, swapAmount)}
+}
+
+func (_c *MockTaskService_CreateSharedPoolTask_Call) Run(run func(swapID string, userId string, campaignID int, swapAmount float64)) *MockTaskService_CreateSharedPoolTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(int), args[3].(float64))
+	})
+	return _c
+}
+
+func (_c *MockTaskService_CreateSharedPoolTask_Call) Return(_a0 *model.Task, _a1 error) *MockTaskService_CreateSharedPoolTask_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTaskService_CreateSharedPoolTask_Call) RunAndReturn(run func(string, string, int, float64) (*model.Task, error)) *MockTaskService_CreateSharedPoolTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTask provides a mock function with given fields: userId, campaignID, taskType, swapAmount
+func (_m *MockTaskService) CreateTask(userId string, campaignID int, taskType model.TaskType, swapAmount float64) (*model.Task, error) {
+	ret := _m.Called(userId, campaignID, taskType, swapAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateTask")
@@ -79,19 +140,19 @@ func (_m *MockTaskService) CreateTask(userId string, taskType model.TaskType, sw
 
 	var r0 *model.Task
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string, model.TaskType, float64) (*model.Task, error)); ok {
-		return rf(userId, taskType, swapAmount)
+	if rf, ok := ret.Get(0).(func(string, int, model.TaskType, float64) (*model.Task, error)); ok {
+		return rf(userId, campaignID, taskType, swapAmount)
 	}
-	if rf, ok := ret.Get(0).(func(string, model.TaskType, float64) *model.Task); ok {
-		r0 = rf(userId, taskType, swapAmount)
+	if rf, ok := ret.Get(0).(func(string, int, model.TaskType, float64) *model.Task); ok {
+		r0 = rf(userId, campaignID, taskType, swapAmount)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Task)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, model.TaskType, float64) error); ok {
-		r1 = rf(userId, taskType, swapAmount)
+	if rf, ok := ret.Get(1).(func(string, int, model.TaskType, float64) error); ok {
+		r1 = rf(userId, campaignID, taskType, swapAmount)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -106,15 +167,16 @@ type MockTaskService_CreateTask_Call struct {
 
 // CreateTask is a helper method to define mock.On call
 //   - userId string
+//   - campaignID int
 //   - taskType model.TaskType
 //   - swapAmount float64
-func (_e *MockTaskService_Expecter) CreateTask(userId interface{}, taskType interface{}, swapAmount interface{}) *MockTaskService_CreateTask_Call {
-	return &MockTaskService_CreateTask_Call{Call: _e.mock.On("CreateTask", userId, taskType, swapAmount)}
+func (_e *MockTaskService_Expecter) CreateTask(userId interface{}, campaignID interface{}, taskType interface{}, swapAmount interface{}) *MockTaskService_CreateTask_Call {
+	return &MockTaskService_CreateTask_Call{Call: _e.mock.On("CreateTask", userId, campaignID, taskType, swapAmount)}
 }
 
-func (_c *MockTaskService_CreateTask_Call) Run(run func(userId string, taskType model.TaskType, swapAmount float64)) *MockTaskService_CreateTask_Call {
+func (_c *MockTaskService_CreateTask_Call) Run(run func(userId string, campaignID int, taskType model.TaskType, swapAmount float64)) *MockTaskService_CreateTask_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(model.TaskType), args[2].(float64))
+		run(args[0].(string), args[1].(int), args[2].(model.TaskType), args[3].(float64))
 	})
 	return _c
 }
@@ -124,7 +186,53 @@ func (_c *MockTaskService_CreateTask_Call) Return(_a0 *model.Task, _a1 error) *M
 	return _c
 }
 
-func (_c *MockTaskService_CreateTask_Call) RunAndReturn(run func(string, model.TaskType, float64) (*model.Task, error)) *MockTaskService_CreateTask_Call {
+func (_c *MockTaskService_CreateTask_Call) RunAndReturn(run func(string, int, model.TaskType, float64) (*model.Task, error)) *MockTaskService_CreateTask_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiscardTask provides a mock function with given fields: taskID
+func (_m *MockTaskService) DiscardTask(taskID int) error {
+	ret := _m.Called(taskID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiscardTask")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(taskID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTaskService_DiscardTask_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiscardTask'
+type MockTaskService_DiscardTask_Call struct {
+	*mock.Call
+}
+
+// DiscardTask is a helper method to define mock.On call
+//   - taskID int
+func (_e *MockTaskService_Expecter) DiscardTask(taskID interface{}) *MockTaskService_DiscardTask_Call {
+	return &MockTaskService_DiscardTask_Call{Call: _e.mock.On("DiscardTask", taskID)}
+}
+
+func (_c *MockTaskService_DiscardTask_Call) Run(run func(taskID int)) *MockTaskService_DiscardTask_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *MockTaskService_DiscardTask_Call) Return(_a0 error) *MockTaskService_DiscardTask_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTaskService_DiscardTask_Call) RunAndReturn(run func(int) error) *MockTaskService_DiscardTask_Call {
 	_c.Call.Return(run)
 	return _c
 }
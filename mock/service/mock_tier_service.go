@@ -0,0 +1,369 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	context "context"
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockTierService is an autogenerated mock type for the TierService type
+type MockTierService struct {
+	mock.Mock
+}
+
+type MockTierService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockTierService) EXPECT() *MockTierService_Expecter {
+	return &MockTierService_Expecter{mock: &_m.Mock}
+}
+
+// GetTierHistory provides a mock function with given fields: userID
+func (_m *MockTierService) GetTierHistory(userID string) ([]*model.TierChange, error) {
+	ret := _m.Called(userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTierHistory")
+	}
+
+	var r0 []*model.TierChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.TierChange, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.TierChange); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TierChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierService_GetTierHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTierHistory'
+type MockTierService_GetTierHistory_Call struct {
+	*mock.Call
+}
+
+// GetTierHistory is a helper method to define mock.On call
+//   - userID string
+func (_e *MockTierService_Expecter) GetTierHistory(userID interface{}) *MockTierService_GetTierHistory_Call {
+	return &MockTierService_GetTierHistory_Call{Call: _e.mock.On("GetTierHistory", userID)}
+}
+
+func (_c *MockTierService_GetTierHistory_Call) Run(run func(userID string)) *MockTierService_GetTierHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockTierService_GetTierHistory_Call) Return(_a0 []*model.TierChange, _a1 error) *MockTierService_GetTierHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierService_GetTierHistory_Call) RunAndReturn(run func(string) ([]*model.TierChange, error)) *MockTierService_GetTierHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTiers provides a mock function with given fields:
+func (_m *MockTierService) GetTiers() model.TierSchedule {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTiers")
+	}
+
+	var r0 model.TierSchedule
+	if rf, ok := ret.Get(0).(func() model.TierSchedule); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(model.TierSchedule)
+		}
+	}
+
+	return r0
+}
+
+// MockTierService_GetTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTiers'
+type MockTierService_GetTiers_Call struct {
+	*mock.Call
+}
+
+// GetTiers is a helper method to define mock.On call
+func (_e *MockTierService_Expecter) GetTiers() *MockTierService_GetTiers_Call {
+	return &MockTierService_GetTiers_Call{Call: _e.mock.On("GetTiers")}
+}
+
+func (_c *MockTierService_GetTiers_Call) Run(run func()) *MockTierService_GetTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockTierService_GetTiers_Call) Return(_a0 model.TierSchedule) *MockTierService_GetTiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTierService_GetTiers_Call) RunAndReturn(run func() model.TierSchedule) *MockTierService_GetTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUserTiers provides a mock function with given fields: userIDs
+func (_m *MockTierService) GetUserTiers(userIDs []string) (map[string]*model.Tier, error) {
+	ret := _m.Called(userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserTiers")
+	}
+
+	var r0 map[string]*model.Tier
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (map[string]*model.Tier, error)); ok {
+		return rf(userIDs)
+	}
+	if rf, ok := ret.Get(0).(func([]string) map[string]*model.Tier); ok {
+		r0 = rf(userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.Tier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierService_GetUserTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserTiers'
+type MockTierService_GetUserTiers_Call struct {
+	*mock.Call
+}
+
+// GetUserTiers is a helper method to define mock.On call
+//   - userIDs []string
+func (_e *MockTierService_Expecter) GetUserTiers(userIDs interface{}) *MockTierService_GetUserTiers_Call {
+	return &MockTierService_GetUserTiers_Call{Call: _e.mock.On("GetUserTiers", userIDs)}
+}
+
+func (_c *MockTierService_GetUserTiers_Call) Run(run func(userIDs []string)) *MockTierService_GetUserTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]string))
+	})
+	return _c
+}
+
+func (_c *MockTierService_GetUserTiers_Call) Return(_a0 map[string]*model.Tier, _a1 error) *MockTierService_GetUserTiers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierService_GetUserTiers_Call) RunAndReturn(run func([]string) (map[string]*model.Tier, error)) *MockTierService_GetUserTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordSwap provides a mock function with given fields: swapID, userID, swapAmount, now
+func (_m *MockTierService) RecordSwap(swapID string, userID string, swapAmount float64, now time.Time) (*model.Tier, error) {
+	ret := _m.Called(swapID, userID, swapAmount, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordSwap")
+	}
+
+	var r0 *model.Tier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, float64, time.Time) (*model.Tier, error)); ok {
+		return rf(swapID, userID, swapAmount, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, float64, time.Time) *model.Tier); ok {
+		r0 = rf(swapID, userID, swapAmount, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, float64, time.Time) error); ok {
+		r1 = rf(swapID, userID, swapAmount, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierService_RecordSwap_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordSwap'
+type MockTierService_RecordSwap_Call struct {
+	*mock.Call
+}
+
+// RecordSwap is a helper method to define mock.On call
+//   - swapID string
+//   - userID string
+//   - swapAmount float64
+//   - now time.Time
+func (_e *MockTierService_Expecter) RecordSwap(swapID interface{}, userID interface{}, swapAmount interface{}, now interface{}) *MockTierService_RecordSwap_Call {
+	return &MockTierService_RecordSwap_Call{Call: _e.mock.On("RecordSwap", swapID, userID, swapAmount, now)}
+}
+
+func (_c *MockTierService_RecordSwap_Call) Run(run func(swapID string, userID string, swapAmount float64, now time.Time)) *MockTierService_RecordSwap_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(float64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTierService_RecordSwap_Call) Return(_a0 *model.Tier, _a1 error) *MockTierService_RecordSwap_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierService_RecordSwap_Call) RunAndReturn(run func(string, string, float64, time.Time) (*model.Tier, error)) *MockTierService_RecordSwap_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshTier provides a mock function with given fields: userID, now
+func (_m *MockTierService) RefreshTier(userID string, now time.Time) (*model.Tier, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTier")
+	}
+
+	var r0 *model.Tier
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*model.Tier, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *model.Tier); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Tier)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockTierService_RefreshTier_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTier'
+type MockTierService_RefreshTier_Call struct {
+	*mock.Call
+}
+
+// RefreshTier is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockTierService_Expecter) RefreshTier(userID interface{}, now interface{}) *MockTierService_RefreshTier_Call {
+	return &MockTierService_RefreshTier_Call{Call: _e.mock.On("RefreshTier", userID, now)}
+}
+
+func (_c *MockTierService_RefreshTier_Call) Run(run func(userID string, now time.Time)) *MockTierService_RefreshTier_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTierService_RefreshTier_Call) Return(_a0 *model.Tier, _a1 error) *MockTierService_RefreshTier_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockTierService_RefreshTier_Call) RunAndReturn(run func(string, time.Time) (*model.Tier, error)) *MockTierService_RefreshTier_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RefreshTiers provides a mock function with given fields: ctx, now
+func (_m *MockTierService) RefreshTiers(ctx context.Context, now time.Time) error {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTiers")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) error); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockTierService_RefreshTiers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTiers'
+type MockTierService_RefreshTiers_Call struct {
+	*mock.Call
+}
+
+// RefreshTiers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *MockTierService_Expecter) RefreshTiers(ctx interface{}, now interface{}) *MockTierService_RefreshTiers_Call {
+	return &MockTierService_RefreshTiers_Call{Call: _e.mock.On("RefreshTiers", ctx, now)}
+}
+
+func (_c *MockTierService_RefreshTiers_Call) Run(run func(ctx context.Context, now time.Time)) *MockTierService_RefreshTiers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockTierService_RefreshTiers_Call) Return(_a0 error) *MockTierService_RefreshTiers_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockTierService_RefreshTiers_Call) RunAndReturn(run func(context.Context, time.Time) error) *MockTierService_RefreshTiers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockTierService creates a new instance of MockTierService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockTierService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockTierService {
+	mock := &MockTierService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,9 +3,10 @@
 package service
 
 import (
-	mock "github.com/stretchr/testify/mock"
+	context "context"
+	model "trading-ace/src/model"
 
-	time "time"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // MockUniSwapService is an autogenerated mock type for the UniSwapService type
@@ -21,17 +22,17 @@ func (_m *MockUniSwapService) EXPECT() *MockUniSwapService_Expecter {
 	return &MockUniSwapService_Expecter{mock: &_m.Mock}
 }
 
-// ProcessSharedPool provides a mock function with given fields: from, to
-func (_m *MockUniSwapService) ProcessSharedPool(from time.Time, to time.Time) error {
-	ret := _m.Called(from, to)
+// ProcessSharedPool provides a mock function with given fields: ctx, campaign, payouts
+func (_m *MockUniSwapService) ProcessSharedPool(ctx context.Context, campaign *model.Campaign, payouts []*model.SharedPoolPayout) error {
+	ret := _m.Called(ctx, campaign, payouts)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessSharedPool")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(time.Time, time.Time) error); ok {
-		r0 = rf(from, to)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Campaign, []*model.SharedPoolPayout) error); ok {
+		r0 = rf(ctx, campaign, payouts)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -45,15 +46,16 @@ type MockUniSwapService_ProcessSharedPool_Call struct {
 }
 
 // ProcessSharedPool is a helper method to define mock.On call
-//   - from time.Time
-//   - to time.Time
-func (_e *MockUniSwapService_Expecter) ProcessSharedPool(from interface{}, to interface{}) *MockUniSwapService_ProcessSharedPool_Call {
-	return &MockUniSwapService_ProcessSharedPool_Call{Call: _e.mock.On("ProcessSharedPool", from, to)}
+//   - ctx context.Context
+//   - campaign *model.Campaign
+//   - payouts []*model.SharedPoolPayout
+func (_e *MockUniSwapService_Expecter) ProcessSharedPool(ctx interface{}, campaign interface{}, payouts interface{}) *MockUniSwapService_ProcessSharedPool_Call {
+	return &MockUniSwapService_ProcessSharedPool_Call{Call: _e.mock.On("ProcessSharedPool", ctx, campaign, payouts)}
 }
 
-func (_c *MockUniSwapService_ProcessSharedPool_Call) Run(run func(from time.Time, to time.Time)) *MockUniSwapService_ProcessSharedPool_Call {
+func (_c *MockUniSwapService_ProcessSharedPool_Call) Run(run func(ctx context.Context, campaign *model.Campaign, payouts []*model.SharedPoolPayout)) *MockUniSwapService_ProcessSharedPool_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(time.Time), args[1].(time.Time))
+		run(args[0].(context.Context), args[1].(*model.Campaign), args[2].([]*model.SharedPoolPayout))
 	})
 	return _c
 }
@@ -63,22 +65,22 @@ func (_c *MockUniSwapService_ProcessSharedPool_Call) Return(_a0 error) *MockUniS
 	return _c
 }
 
-func (_c *MockUniSwapService_ProcessSharedPool_Call) RunAndReturn(run func(time.Time, time.Time) error) *MockUniSwapService_ProcessSharedPool_Call {
+func (_c *MockUniSwapService_ProcessSharedPool_Call) RunAndReturn(run func(context.Context, *model.Campaign, []*model.SharedPoolPayout) error) *MockUniSwapService_ProcessSharedPool_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ProcessUniSwapTransaction provides a mock function with given fields: senderID, swapAmount
-func (_m *MockUniSwapService) ProcessUniSwapTransaction(senderID string, swapAmount float64) error {
-	ret := _m.Called(senderID, swapAmount)
+// ProcessUniSwapTransaction provides a mock function with given fields: swapID, senderID, poolAddress, swapAmount
+func (_m *MockUniSwapService) ProcessUniSwapTransaction(swapID string, senderID string, poolAddress string, swapAmount float64) error {
+	ret := _m.Called(swapID, senderID, poolAddress, swapAmount)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ProcessUniSwapTransaction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, float64) error); ok {
-		r0 = rf(senderID, swapAmount)
+	if rf, ok := ret.Get(0).(func(string, string, string, float64) error); ok {
+		r0 = rf(swapID, senderID, poolAddress, swapAmount)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -92,15 +94,17 @@ type MockUniSwapService_ProcessUniSwapTransaction_Call struct {
 }
 
 // ProcessUniSwapTransaction is a helper method to define mock.On call
+//   - swapID string
 //   - senderID string
+//   - poolAddress string
 //   - swapAmount float64
-func (_e *MockUniSwapService_Expecter) ProcessUniSwapTransaction(senderID interface{}, swapAmount interface{}) *MockUniSwapService_ProcessUniSwapTransaction_Call {
-	return &MockUniSwapService_ProcessUniSwapTransaction_Call{Call: _e.mock.On("ProcessUniSwapTransaction", senderID, swapAmount)}
+func (_e *MockUniSwapService_Expecter) ProcessUniSwapTransaction(swapID interface{}, senderID interface{}, poolAddress interface{}, swapAmount interface{}) *MockUniSwapService_ProcessUniSwapTransaction_Call {
+	return &MockUniSwapService_ProcessUniSwapTransaction_Call{Call: _e.mock.On("ProcessUniSwapTransaction", swapID, senderID, poolAddress, swapAmount)}
 }
 
-func (_c *MockUniSwapService_ProcessUniSwapTransaction_Call) Run(run func(senderID string, swapAmount float64)) *MockUniSwapService_ProcessUniSwapTransaction_Call {
+func (_c *MockUniSwapService_ProcessUniSwapTransaction_Call) Run(run func(swapID string, senderID string, poolAddress string, swapAmount float64)) *MockUniSwapService_ProcessUniSwapTransaction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(float64))
+		run(args[0].(string), args[1].(string), args[2].(string), args[3].(float64))
 	})
 	return _c
 }
@@ -110,7 +114,7 @@ func (_c *MockUniSwapService_ProcessUniSwapTransaction_Call) Return(_a0 error) *
 	return _c
 }
 
-func (_c *MockUniSwapService_ProcessUniSwapTransaction_Call) RunAndReturn(run func(string, float64) error) *MockUniSwapService_ProcessUniSwapTransaction_Call {
+func (_c *MockUniSwapService_ProcessUniSwapTransaction_Call) RunAndReturn(run func(string, string, string, float64) error) *MockUniSwapService_ProcessUniSwapTransaction_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,97 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockUserProfileService is an autogenerated mock type for the UserProfileService type
+type MockUserProfileService struct {
+	mock.Mock
+}
+
+type MockUserProfileService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUserProfileService) EXPECT() *MockUserProfileService_Expecter {
+	return &MockUserProfileService_Expecter{mock: &_m.Mock}
+}
+
+// GetUserProfile provides a mock function with given fields: userID, now
+func (_m *MockUserProfileService) GetUserProfile(userID string, now time.Time) (*model.UserProfile, error) {
+	ret := _m.Called(userID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserProfile")
+	}
+
+	var r0 *model.UserProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*model.UserProfile, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *model.UserProfile); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockUserProfileService_GetUserProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUserProfile'
+type MockUserProfileService_GetUserProfile_Call struct {
+	*mock.Call
+}
+
+// GetUserProfile is a helper method to define mock.On call
+//   - userID string
+//   - now time.Time
+func (_e *MockUserProfileService_Expecter) GetUserProfile(userID interface{}, now interface{}) *MockUserProfileService_GetUserProfile_Call {
+	return &MockUserProfileService_GetUserProfile_Call{Call: _e.mock.On("GetUserProfile", userID, now)}
+}
+
+func (_c *MockUserProfileService_GetUserProfile_Call) Run(run func(userID string, now time.Time)) *MockUserProfileService_GetUserProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockUserProfileService_GetUserProfile_Call) Return(_a0 *model.UserProfile, _a1 error) *MockUserProfileService_GetUserProfile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockUserProfileService_GetUserProfile_Call) RunAndReturn(run func(string, time.Time) (*model.UserProfile, error)) *MockUserProfileService_GetUserProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockUserProfileService creates a new instance of MockUserProfileService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUserProfileService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUserProfileService {
+	mock := &MockUserProfileService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -137,53 +137,6 @@ func (_c *MockUserService_GetUserByID_Call) RunAndReturn(run func(string) (*mode
 	return _c
 }
 
-// UpdateUserPoints provides a mock function with given fields: userID, points
-func (_m *MockUserService) UpdateUserPoints(userID string, points float64) error {
-	ret := _m.Called(userID, points)
-
-	if len(ret) == 0 {
-		panic("no return value specified for UpdateUserPoints")
-	}
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(string, float64) error); ok {
-		r0 = rf(userID, points)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// MockUserService_UpdateUserPoints_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateUserPoints'
-type MockUserService_UpdateUserPoints_Call struct {
-	*mock.Call
-}
-
-// UpdateUserPoints is a helper method to define mock.On call
-//   - userID string
-//   - points float64
-func (_e *MockUserService_Expecter) UpdateUserPoints(userID interface{}, points interface{}) *MockUserService_UpdateUserPoints_Call {
-	return &MockUserService_UpdateUserPoints_Call{Call: _e.mock.On("UpdateUserPoints", userID, points)}
-}
-
-func (_c *MockUserService_UpdateUserPoints_Call) Run(run func(userID string, points float64)) *MockUserService_UpdateUserPoints_Call {
-	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(float64))
-	})
-	return _c
-}
-
-func (_c *MockUserService_UpdateUserPoints_Call) Return(_a0 error) *MockUserService_UpdateUserPoints_Call {
-	_c.Call.Return(_a0)
-	return _c
-}
-
-func (_c *MockUserService_UpdateUserPoints_Call) RunAndReturn(run func(string, float64) error) *MockUserService_UpdateUserPoints_Call {
-	_c.Call.Return(run)
-	return _c
-}
-
 // NewMockUserService creates a new instance of MockUserService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUserService(t interface {
@@ -0,0 +1,155 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package service
+
+import (
+	model "trading-ace/src/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MockVoucherService is an autogenerated mock type for the VoucherService type
+type MockVoucherService struct {
+	mock.Mock
+}
+
+type MockVoucherService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockVoucherService) EXPECT() *MockVoucherService_Expecter {
+	return &MockVoucherService_Expecter{mock: &_m.Mock}
+}
+
+// GetVouchers provides a mock function with given fields: address
+func (_m *MockVoucherService) GetVouchers(address string) ([]*model.Voucher, error) {
+	ret := _m.Called(address)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVouchers")
+	}
+
+	var r0 []*model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.Voucher, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.Voucher); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVoucherService_GetVouchers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVouchers'
+type MockVoucherService_GetVouchers_Call struct {
+	*mock.Call
+}
+
+// GetVouchers is a helper method to define mock.On call
+//   - address string
+func (_e *MockVoucherService_Expecter) GetVouchers(address interface{}) *MockVoucherService_GetVouchers_Call {
+	return &MockVoucherService_GetVouchers_Call{Call: _e.mock.On("GetVouchers", address)}
+}
+
+func (_c *MockVoucherService_GetVouchers_Call) Run(run func(address string)) *MockVoucherService_GetVouchers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *MockVoucherService_GetVouchers_Call) Return(_a0 []*model.Voucher, _a1 error) *MockVoucherService_GetVouchers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVoucherService_GetVouchers_Call) RunAndReturn(run func(string) ([]*model.Voucher, error)) *MockVoucherService_GetVouchers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IssueVoucher provides a mock function with given fields: address, now
+func (_m *MockVoucherService) IssueVoucher(address string, now time.Time) (*model.Voucher, error) {
+	ret := _m.Called(address, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IssueVoucher")
+	}
+
+	var r0 *model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (*model.Voucher, error)); ok {
+		return rf(address, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) *model.Voucher); ok {
+		r0 = rf(address, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Voucher)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(address, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockVoucherService_IssueVoucher_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IssueVoucher'
+type MockVoucherService_IssueVoucher_Call struct {
+	*mock.Call
+}
+
+// IssueVoucher is a helper method to define mock.On call
+//   - address string
+//   - now time.Time
+func (_e *MockVoucherService_Expecter) IssueVoucher(address interface{}, now interface{}) *MockVoucherService_IssueVoucher_Call {
+	return &MockVoucherService_IssueVoucher_Call{Call: _e.mock.On("IssueVoucher", address, now)}
+}
+
+func (_c *MockVoucherService_IssueVoucher_Call) Run(run func(address string, now time.Time)) *MockVoucherService_IssueVoucher_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *MockVoucherService_IssueVoucher_Call) Return(_a0 *model.Voucher, _a1 error) *MockVoucherService_IssueVoucher_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockVoucherService_IssueVoucher_Call) RunAndReturn(run func(string, time.Time) (*model.Voucher, error)) *MockVoucherService_IssueVoucher_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockVoucherService creates a new instance of MockVoucherService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockVoucherService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockVoucherService {
+	mock := &MockVoucherService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"trading-ace/src/model"
+	"trading-ace/src/service"
+)
+
+// runAPIKey implements the `api-key` command, which creates the first admin
+// API keys, the others can then be managed through the admin API:
+//
+//	main api-key -name ops -role admin -scopes '*'
+func runAPIKey(args []string) error {
+	flags := flag.NewFlagSet("api-key", flag.ContinueOnError)
+	name := flags.String("name", "", "name of the key, recorded as the operator of its calls")
+	role := flags.String("role", string(model.RoleViewer), "viewer, operator or admin")
+	scopes := flags.String("scopes", "", "comma separated scopes: campaigns, settlements, adjustments, redemptions, api_keys or *")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var keyScopes []model.APIKeyScope
+	for _, scope := range splitFlagValues(*scopes) {
+		keyScopes = append(keyScopes, model.APIKeyScope(scope))
+	}
+
+	apiKey, err := service.NewAPIKeyService().CreateAPIKey(*name, model.Role(*role), keyScopes)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created API key %d (%s), it won't be shown again:\n%s\n", apiKey.ID, apiKey.Name, apiKey.Key)
+	return nil
+}
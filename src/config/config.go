@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/viper"
 	"log"
 	"os"
+	"strings"
 	"sync"
 )
 
@@ -39,6 +40,11 @@ func LoadConfig() (*AppConfig, error) {
 
 	viper.AddConfigPath(configPath)
 
+	// Environment variables override the keys of the config file, e.g.
+	// AUTH_JWT_SECRET for auth.jwt_secret, to keep secrets out of the repository.
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	viper.AutomaticEnv()
+
 	if err := viper.ReadInConfig(); err != nil {
 		log.Fatalf("Error reading config file: %v", err)
 		return nil, err
@@ -29,27 +29,278 @@ type RedisConnectionConfig struct {
 }
 type RedisConfig struct {
 	Job *RedisConnectionConfig `mapstructure:"job"`
+	// RateLimit is the Redis of the rate limiter, the job one when omitted.
+	RateLimit *RedisConnectionConfig `mapstructure:"rate_limit"`
+}
+
+func (r *RedisConfig) GetRateLimit() *RedisConnectionConfig {
+	if r.RateLimit != nil {
+		return r.RateLimit
+	}
+	return r.Job
+}
+
+// RateLimitConfig throttles each client of the API with a token bucket of
+// Limit requests refilled every Period.
+type RateLimitConfig struct {
+	// Backend is "memory", per replica, or "redis", shared by the replicas.
+	Backend string `mapstructure:"backend"`
+	// Limit is 0 to disable rate limiting.
+	Limit  int    `mapstructure:"limit"`
+	Period string `mapstructure:"period"`
+	// TrustedProxies are the IPs or CIDRs of the proxies in front of the API.
+	// X-Forwarded-For only names the client of a request coming from one of
+	// them, none is trusted when empty.
+	TrustedProxies []string `mapstructure:"trusted_proxies"`
+}
+
+func (c *RateLimitConfig) GetPeriod() time.Duration {
+	return parseDurationOr(c.Period, time.Minute)
+}
+
+func (c *RateLimitConfig) GetTrustedProxies() []string {
+	if c == nil {
+		return nil
+	}
+	return c.TrustedProxies
 }
 
 type EthereumNodeConfig struct {
 	SocketUrl string `mapstructure:"socket"`
 }
 
+// CampaignConfig seeds the first campaign on an empty database, campaigns are
+// managed through the admin API afterwards.
 type CampaignConfig struct {
-	CampaignStartTime string `mapstructure:"start_time"`
-	Weeks             int    `mapstructure:"weeks"`
+	Name              string   `mapstructure:"name"`
+	Pools             []string `mapstructure:"pools"`
+	CampaignStartTime string   `mapstructure:"start_time"`
+	Period            string   `mapstructure:"period"`
+	Timezone          string   `mapstructure:"timezone"`
+	Periods           int      `mapstructure:"periods"`
+	// Weeks is the legacy name of Periods for weekly campaigns.
+	Weeks int `mapstructure:"weeks"`
 }
 
+// GetCampaignStartTime returns midnight of the configured date in the campaign
+// timezone.
 func (c *CampaignConfig) GetCampaignStartTime() time.Time {
 	layout := "2006-01-02"
-	t, _ := time.Parse(layout, c.CampaignStartTime)
+	t, _ := time.ParseInLocation(layout, c.CampaignStartTime, c.GetLocation())
 	return t
 }
 
+func (c *CampaignConfig) GetLocation() *time.Location {
+	if c.Timezone == "" {
+		return time.UTC
+	}
+
+	location, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+func (c *CampaignConfig) GetPeriods() int {
+	if c.Periods > 0 {
+		return c.Periods
+	}
+	return c.Weeks
+}
+
+func (c *CampaignConfig) GetPeriod() string {
+	if c.Period == "" {
+		return "weekly"
+	}
+	return c.Period
+}
+
+const (
+	// ClaimModeMerkle builds a Merkle distribution after every settlement.
+	ClaimModeMerkle = "merkle"
+	// ClaimModeVoucher signs a voucher when an address asks for one.
+	ClaimModeVoucher = "voucher"
+)
+
+// ClaimConfig configures the conversion of points to claimable tokens.
+type ClaimConfig struct {
+	// Mode is how points are claimed on chain, ClaimModeMerkle or
+	// ClaimModeVoucher. Both pay the same cumulative points, so enabling both
+	// for the same token would pay them twice. Any other value disables claims.
+	Mode string `mapstructure:"mode"`
+	// TokenDecimals is the number of decimals of the token, one point is one token.
+	TokenDecimals int `mapstructure:"token_decimals"`
+	// SignerKey is the hex private key signing the vouchers. When empty, the key
+	// is decrypted from KeystoreFile with KeystorePassword.
+	SignerKey         string `mapstructure:"signer_key"`
+	KeystoreFile      string `mapstructure:"keystore_file"`
+	KeystorePassword  string `mapstructure:"keystore_password"`
+	ChainID           int64  `mapstructure:"chain_id"`
+	VerifyingContract string `mapstructure:"verifying_contract"`
+	// VoucherTTL is how long a voucher can be redeemed, e.g. "24h".
+	VoucherTTL string `mapstructure:"voucher_ttl"`
+}
+
+func (c *ClaimConfig) GetMode() string {
+	if c == nil || c.Mode == "" {
+		return ClaimModeMerkle
+	}
+	return c.Mode
+}
+
+func (c *ClaimConfig) GetTokenDecimals() int {
+	if c == nil || c.TokenDecimals <= 0 {
+		return 18
+	}
+	return c.TokenDecimals
+}
+
+func (c *ClaimConfig) GetChainID() int64 {
+	if c == nil || c.ChainID <= 0 {
+		return 1
+	}
+	return c.ChainID
+}
+
+func (c *ClaimConfig) GetVoucherTTL() time.Duration {
+	if c == nil {
+		return 24 * time.Hour
+	}
+
+	return parseDurationOr(c.VoucherTTL, 24*time.Hour)
+}
+
+// AuthConfig configures Sign-In with Ethereum and the sessions it opens.
+type AuthConfig struct {
+	// Domain is the domain SIWE messages must be issued for, e.g. "app.example.com".
+	Domain string `mapstructure:"domain"`
+	// ChainID restricts the chain of the SIWE messages, any chain when 0.
+	ChainID int64 `mapstructure:"chain_id"`
+	// JWTSecret signs the session tokens. It is required outside development,
+	// where a random secret is used when empty so sessions don't survive a
+	// restart.
+	JWTSecret  string `mapstructure:"jwt_secret"`
+	SessionTTL string `mapstructure:"session_ttl"`
+	NonceTTL   string `mapstructure:"nonce_ttl"`
+}
+
+func (c *AuthConfig) GetSessionTTL() time.Duration {
+	if c == nil {
+		return 24 * time.Hour
+	}
+	return parseDurationOr(c.SessionTTL, 24*time.Hour)
+}
+
+func (c *AuthConfig) GetNonceTTL() time.Duration {
+	if c == nil {
+		return 10 * time.Minute
+	}
+	return parseDurationOr(c.NonceTTL, 10*time.Minute)
+}
+
+// RedemptionConfig lists the catalogue points can be redeemed against.
+type RedemptionConfig struct {
+	Catalogue []*CatalogueItemConfig `mapstructure:"catalogue"`
+}
+
+type CatalogueItemConfig struct {
+	ID     string  `mapstructure:"id"`
+	Name   string  `mapstructure:"name"`
+	Points float64 `mapstructure:"points"`
+}
+
+// ExpiryConfig expires points Days after they are earned, at the end of their
+// campaign with AtCampaignEnd, or whichever comes first, see model.ExpiryRule.
+// Points never expire when neither is set.
+type ExpiryConfig struct {
+	Days          int  `mapstructure:"days"`
+	AtCampaignEnd bool `mapstructure:"at_campaign_end"`
+	// WarningWindow is how far ahead points are reported as expiring soon.
+	WarningWindow string `mapstructure:"warning_window"`
+}
+
+func (c *ExpiryConfig) GetWarningWindow() time.Duration {
+	if c == nil {
+		return 7 * 24 * time.Hour
+	}
+	return parseDurationOr(c.WarningWindow, 7*24*time.Hour)
+}
+
+// TierConfig ranks users in tiers by their volume across campaigns over the
+// last WindowDays days.
+type TierConfig struct {
+	WindowDays int                `mapstructure:"window_days"`
+	Levels     []*TierLevelConfig `mapstructure:"levels"`
+}
+
+type TierLevelConfig struct {
+	Name       string  `mapstructure:"name"`
+	MinVolume  float64 `mapstructure:"min_volume"`
+	Multiplier float64 `mapstructure:"multiplier"`
+	// BonusCap caps the extra points of a reward, 0 for no cap.
+	BonusCap float64 `mapstructure:"bonus_cap"`
+}
+
+func (c *TierConfig) GetWindow() time.Duration {
+	if c == nil || c.WindowDays <= 0 {
+		return 30 * 24 * time.Hour
+	}
+	return time.Duration(c.WindowDays) * 24 * time.Hour
+}
+
+// ReferralConfig rewards referrers with ReferrerReward points when a referee
+// onboards, then with SharedPoolShare of the shared pool points of the referee,
+// e.g. 0.1 for 10%.
+type ReferralConfig struct {
+	ReferrerReward  float64 `mapstructure:"referrer_reward"`
+	SharedPoolShare float64 `mapstructure:"shared_pool_share"`
+}
+
+// StreakConfig rewards the users who swap on consecutive UTC days or weeks.
+type StreakConfig struct {
+	Milestones []*StreakMilestoneConfig `mapstructure:"milestones"`
+}
+
+type StreakMilestoneConfig struct {
+	// Period is "daily" or "weekly".
+	Period string  `mapstructure:"period"`
+	Length int     `mapstructure:"length"`
+	Reward float64 `mapstructure:"reward"`
+}
+
+// MilestoneConfig rewards users once when their lifetime volume across
+// campaigns reaches each of the volume milestones.
+type MilestoneConfig struct {
+	Volume []*VolumeMilestoneConfig `mapstructure:"volume"`
+}
+
+type VolumeMilestoneConfig struct {
+	Volume float64 `mapstructure:"volume"`
+	Reward float64 `mapstructure:"reward"`
+}
+
+func parseDurationOr(value string, defaultDuration time.Duration) time.Duration {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return defaultDuration
+	}
+	return duration
+}
+
 type AppConfig struct {
 	AppEnv       string
 	Database     *DatabaseConfig     `mapstructure:"database"`
 	EthereumNode *EthereumNodeConfig `mapstructure:"ethereum_node"`
 	Campaign     *CampaignConfig     `mapstructure:"campaign"`
 	Redis        *RedisConfig        `mapstructure:"redis"`
+	Claim        *ClaimConfig        `mapstructure:"claim"`
+	Auth         *AuthConfig         `mapstructure:"auth"`
+	RateLimit    *RateLimitConfig    `mapstructure:"rate_limit"`
+	Redemption   *RedemptionConfig   `mapstructure:"redemption"`
+	Expiry       *ExpiryConfig       `mapstructure:"expiry"`
+	Tier         *TierConfig         `mapstructure:"tier"`
+	Referral     *ReferralConfig     `mapstructure:"referral"`
+	Streak       *StreakConfig       `mapstructure:"streak"`
+	Milestone    *MilestoneConfig    `mapstructure:"milestone"`
 }
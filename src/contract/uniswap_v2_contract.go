@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"fmt"
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -14,12 +15,20 @@ import (
 )
 
 type UniSwapV2SwapEvent struct {
+	Pool       common.Address
 	Sender     common.Address
 	Amount0In  *big.Int
 	Amount1In  *big.Int
 	Amount0Out *big.Int
 	Amount1Out *big.Int
 	To         common.Address
+	TxHash     common.Hash
+	LogIndex   uint
+}
+
+// ID identifies the swap on chain, by its transaction and log index.
+func (e *UniSwapV2SwapEvent) ID() string {
+	return fmt.Sprintf("%s:%d", e.TxHash.Hex(), e.LogIndex)
 }
 
 type UniSwapV2Contract struct {
@@ -81,8 +90,11 @@ func (c *UniSwapV2Contract) ListenSwapEvents(callback func(event *UniSwapV2SwapE
 					continue
 				}
 
+				event.Pool = vLog.Address
 				event.Sender = common.HexToAddress(vLog.Topics[1].Hex())
 				event.To = common.HexToAddress(vLog.Topics[2].Hex())
+				event.TxHash = vLog.TxHash
+				event.LogIndex = vLog.Index
 
 				eventChan <- &event
 			}
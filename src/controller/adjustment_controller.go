@@ -0,0 +1,87 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type AdjustmentController interface {
+	AdjustPoints(c *gin.Context)
+	GetAdjustments(c *gin.Context)
+}
+
+type adjustmentController struct {
+	adjustmentService service.AdjustmentService
+}
+
+var (
+	adjustmentControllerInstance *adjustmentController
+	adjustmentControllerOnce     sync.Once
+)
+
+func GetAdjustmentControllerInstance() AdjustmentController {
+	adjustmentControllerOnce.Do(func() {
+		adjustmentControllerInstance = &adjustmentController{
+			adjustmentService: service.NewAdjustmentService(),
+		}
+	})
+	return adjustmentControllerInstance
+}
+
+// AdjustPoints credits or debits the points of the user of the path.
+func (ac *adjustmentController) AdjustPoints(c *gin.Context) {
+	var body request.AdjustPointsRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	operator := c.GetString(AdminOperatorKey)
+	if operator == "" {
+		operator = body.Operator
+	}
+
+	record, err := ac.adjustmentService.AdjustPoints(&model.PointAdjustment{
+		UserID:     c.Param("address"),
+		CampaignID: body.CampaignID,
+		Direction:  model.AdjustmentDirection(body.Direction),
+		Points:     body.Points,
+		Reason:     body.Reason,
+		Operator:   operator,
+	})
+
+	switch {
+	case errors.Is(err, exception.InvalidAdjustmentError), errors.Is(err, exception.InvalidAddressError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.UserNotFoundError), errors.Is(err, exception.CampaignNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.InsufficientPointsError):
+		c.JSON(http.StatusConflict, gin.H{"exception": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusCreated, response.NewAdjustment(record))
+	}
+}
+
+func (ac *adjustmentController) GetAdjustments(c *gin.Context) {
+	records, err := ac.adjustmentService.GetAdjustments(c.Param("address"))
+	if errors.Is(err, exception.InvalidAddressError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewAdjustmentCollection(records))
+}
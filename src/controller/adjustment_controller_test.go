@@ -0,0 +1,120 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type adjustmentControllerTestSuite struct {
+	adjustmentController    AdjustmentController
+	mockedAdjustmentService *service.MockAdjustmentService
+}
+
+func (s *adjustmentControllerTestSuite) setUp(t *testing.T) {
+	s.mockedAdjustmentService = service.NewMockAdjustmentService(t)
+	s.adjustmentController = &adjustmentController{
+		adjustmentService: s.mockedAdjustmentService,
+	}
+}
+
+func TestAdjustmentController(t *testing.T) {
+	testSuite := &adjustmentControllerTestSuite{}
+
+	newContext := func(body string) (*gin.Context, *httptest.ResponseRecorder) {
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Set(AdminOperatorKey, "ops")
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/users/test_user_id/adjustments", strings.NewReader(body))
+		return testContext, testResponseWriter
+	}
+
+	t.Run("AdjustPoints", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, testResponseWriter := newContext(`{"campaign_id": 1, "direction": "debit", "points": 40, "reason": "double credit"}`)
+
+		testSuite.mockedAdjustmentService.EXPECT().AdjustPoints(&model.PointAdjustment{
+			UserID:     "test_user_id",
+			CampaignID: 1,
+			Direction:  model.AdjustmentDirectionDebit,
+			Points:     40,
+			Reason:     "double credit",
+			Operator:   "ops",
+		}).Return(&model.RewardRecord{ID: 9, UserID: "test_user_id", Points: -40, OriginPoints: 100, UpdatedPoints: 60}, nil).Times(1)
+
+		testSuite.adjustmentController.AdjustPoints(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var adjustmentFromRes response.Adjustment
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &adjustmentFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, -40.0, adjustmentFromRes.Points)
+		assert.Equal(t, 60.0, adjustmentFromRes.BalanceAfter)
+	})
+
+	t.Run("AdjustPoints without reason", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(`{"direction": "credit", "points": 40}`)
+
+		testSuite.adjustmentController.AdjustPoints(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("AdjustPoints with insufficient points", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(`{"direction": "debit", "points": 1000, "reason": "wash trading"}`)
+
+		testSuite.mockedAdjustmentService.EXPECT().AdjustPoints(&model.PointAdjustment{
+			UserID:    "test_user_id",
+			Direction: model.AdjustmentDirectionDebit,
+			Points:    1000,
+			Reason:    "wash trading",
+			Operator:  "ops",
+		}).Return(nil, exception.InsufficientPointsError).Times(1)
+
+		testSuite.adjustmentController.AdjustPoints(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+	})
+
+	t.Run("GetAdjustments", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/users/test_user_id/adjustments", nil)
+
+		testSuite.mockedAdjustmentService.EXPECT().GetAdjustments("test_user_id").Return(nil, nil).Times(1)
+
+		testSuite.adjustmentController.GetAdjustments(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.Equal(t, "[]", testResponseWriter.Body.String())
+	})
+	t.Run("GetAdjustments of Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/users/test_user_id/adjustments", nil)
+
+		testSuite.mockedAdjustmentService.EXPECT().GetAdjustments("test_user_id").Return(nil, exception.InvalidAddressError).Times(1)
+
+		testSuite.adjustmentController.GetAdjustments(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
@@ -0,0 +1,101 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"sync"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/service"
+)
+
+type APIKeyController interface {
+	SearchAPIKeys(c *gin.Context)
+	CreateAPIKey(c *gin.Context)
+	RevokeAPIKey(c *gin.Context)
+}
+
+type apiKeyController struct {
+	apiKeyService service.APIKeyService
+}
+
+var (
+	apiKeyControllerInstance *apiKeyController
+	apiKeyControllerOnce     sync.Once
+)
+
+func GetAPIKeyControllerInstance() APIKeyController {
+	apiKeyControllerOnce.Do(func() {
+		apiKeyControllerInstance = &apiKeyController{
+			apiKeyService: service.NewAPIKeyService(),
+		}
+	})
+	return apiKeyControllerInstance
+}
+
+func (ac *apiKeyController) SearchAPIKeys(c *gin.Context) {
+	apiKeys, err := ac.apiKeyService.SearchAPIKeys()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if apiKeys == nil {
+		apiKeys = []*model.APIKey{}
+	}
+
+	c.JSON(http.StatusOK, apiKeys)
+}
+
+// CreateAPIKey returns the new key in plain text, it can't be read again.
+func (ac *apiKeyController) CreateAPIKey(c *gin.Context) {
+	var body request.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	scopes := make([]model.APIKeyScope, 0, len(body.Scopes))
+	for _, scope := range body.Scopes {
+		scopes = append(scopes, model.APIKeyScope(scope))
+	}
+
+	apiKey, err := ac.apiKeyService.CreateAPIKey(body.Name, model.Role(body.Role), scopes)
+
+	if errors.Is(err, exception.InvalidAPIKeyError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, apiKey)
+}
+
+func (ac *apiKeyController) RevokeAPIKey(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "invalid api key id"})
+		return
+	}
+
+	err = ac.apiKeyService.RevokeAPIKey(id)
+
+	if errors.Is(err, exception.APIKeyNotFoundError) {
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type apiKeyControllerTestSuite struct {
+	apiKeyController    APIKeyController
+	mockedAPIKeyService *service.MockAPIKeyService
+}
+
+func (s *apiKeyControllerTestSuite) setUp(t *testing.T) {
+	s.mockedAPIKeyService = service.NewMockAPIKeyService(t)
+	s.apiKeyController = &apiKeyController{
+		apiKeyService: s.mockedAPIKeyService,
+	}
+}
+
+func TestAPIKeyController(t *testing.T) {
+	testSuite := &apiKeyControllerTestSuite{}
+
+	t.Run("CreateAPIKey", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/api-keys",
+			strings.NewReader(`{"name": "ops", "role": "operator", "scopes": ["campaigns", "settlements"]}`))
+
+		testSuite.mockedAPIKeyService.EXPECT().
+			CreateAPIKey("ops", model.RoleOperator, []model.APIKeyScope{model.APIKeyScopeCampaigns, model.APIKeyScopeSettlements}).
+			Return(&model.APIKey{ID: 1, Name: "ops", Key: "ta_secret", KeyHash: "hash"}, nil).Times(1)
+
+		testSuite.apiKeyController.CreateAPIKey(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var apiKeyFromRes map[string]any
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &apiKeyFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, "ta_secret", apiKeyFromRes["key"])
+		assert.NotContains(t, apiKeyFromRes, "key_hash")
+	})
+
+	t.Run("CreateAPIKey with unknown role", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/api-keys",
+			strings.NewReader(`{"name": "ops", "role": "root", "scopes": ["*"]}`))
+
+		testSuite.apiKeyController.CreateAPIKey(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("RevokeAPIKey", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "3"}}
+		testContext.Request = httptest.NewRequest(http.MethodDelete, "/api/admin/api-keys/3", nil)
+
+		testSuite.mockedAPIKeyService.EXPECT().RevokeAPIKey(3).Return(exception.APIKeyNotFoundError).Times(1)
+
+		testSuite.apiKeyController.RevokeAPIKey(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testContext.Writer.Status())
+	})
+}
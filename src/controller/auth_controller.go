@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/request"
+	"trading-ace/src/service"
+)
+
+const (
+	// AuthenticatedAddressKey is the context key of the address of the session.
+	AuthenticatedAddressKey = "authenticated_address"
+	// AdminOperatorKey is the context key of the name of the API key calling the admin API.
+	AdminOperatorKey = "admin_operator"
+)
+
+type AuthController interface {
+	GetNonce(c *gin.Context)
+	Login(c *gin.Context)
+	RequireAddressOwner(c *gin.Context)
+	RequireQueriedAddressOwner(c *gin.Context)
+}
+
+type authController struct {
+	authService service.AuthService
+}
+
+var (
+	authControllerInstance *authController
+	authControllerOnce     sync.Once
+)
+
+func GetAuthControllerInstance() AuthController {
+	authControllerOnce.Do(func() {
+		authControllerInstance = &authController{
+			authService: service.NewAuthService(),
+		}
+	})
+	return authControllerInstance
+}
+
+// GetNonce returns a one-time nonce to sign in with.
+func (ac *authController) GetNonce(c *gin.Context) {
+	nonce, err := ac.authService.IssueNonce(time.Now().UTC())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"nonce": nonce})
+}
+
+// Login exchanges a signed SIWE message for a session token.
+func (ac *authController) Login(c *gin.Context) {
+	var body request.LoginRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	session, err := ac.authService.Login(body.Message, body.Signature, time.Now().UTC())
+
+	if errors.Is(err, exception.AuthenticationError) || errors.Is(err, exception.InvalidNonceError) {
+		c.JSON(http.StatusUnauthorized, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, session)
+}
+
+// RequireAddressOwner is a middleware letting a request through only when it
+// carries a valid "Authorization: Bearer" session token of the address it is
+// about, read from the :address path parameter or the user_address query.
+func (ac *authController) RequireAddressOwner(c *gin.Context) {
+	token, found := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !found || token == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"exception": exception.UnauthenticatedError.Error()})
+		return
+	}
+
+	sessionAddress, err := ac.authService.Authenticate(token, time.Now().UTC())
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"exception": err.Error()})
+		return
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		address = c.Query("user_address")
+	}
+
+	if !strings.EqualFold(address, sessionAddress) {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"exception": "only the signed in address can be queried"})
+		return
+	}
+
+	c.Set(AuthenticatedAddressKey, sessionAddress)
+	c.Next()
+}
+
+// RequireQueriedAddressOwner is RequireAddressOwner for routes listing the
+// data of every address: a request filtering on a user_address needs a session
+// of that address, one without the filter goes through unauthenticated.
+func (ac *authController) RequireQueriedAddressOwner(c *gin.Context) {
+	if c.Query("user_address") == "" {
+		c.Next()
+		return
+	}
+
+	ac.RequireAddressOwner(c)
+}
@@ -0,0 +1,202 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type authControllerTestSuite struct {
+	authController    AuthController
+	mockedAuthService *service.MockAuthService
+}
+
+func (s *authControllerTestSuite) setUp(t *testing.T) {
+	s.mockedAuthService = service.NewMockAuthService(t)
+	s.authController = &authController{
+		authService: s.mockedAuthService,
+	}
+}
+
+func TestAuthController(t *testing.T) {
+	testSuite := &authControllerTestSuite{}
+	address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	t.Run("GetNonce", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/auth/nonce", nil)
+
+		testSuite.mockedAuthService.EXPECT().IssueNonce(mock.Anything).Return("32891756abcdef01", nil).Times(1)
+
+		testSuite.authController.GetNonce(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.JSONEq(t, `{"nonce": "32891756abcdef01"}`, testResponseWriter.Body.String())
+	})
+
+	t.Run("Login", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/auth/login",
+			strings.NewReader(`{"message": "siwe message", "signature": "0xsignature"}`))
+
+		testSuite.mockedAuthService.EXPECT().Login("siwe message", "0xsignature", mock.Anything).Return(&model.Session{
+			Address:   address,
+			Token:     "token",
+			ExpiresAt: time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC),
+		}, nil).Times(1)
+
+		testSuite.authController.Login(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var sessionFromRes model.Session
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &sessionFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, "token", sessionFromRes.Token)
+	})
+
+	t.Run("Login with invalid signature", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/auth/login",
+			strings.NewReader(`{"message": "siwe message", "signature": "0xsignature"}`))
+
+		testSuite.mockedAuthService.EXPECT().Login("siwe message", "0xsignature", mock.Anything).
+			Return(nil, exception.AuthenticationError).Times(1)
+
+		testSuite.authController.Login(testContext)
+
+		assert.Equal(t, http.StatusUnauthorized, testContext.Writer.Status())
+	})
+
+	t.Run("Login without signature", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/auth/login", strings.NewReader(`{"message": "siwe message"}`))
+
+		testSuite.authController.Login(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
+
+func TestRequireAddressOwner(t *testing.T) {
+	testSuite := &authControllerTestSuite{}
+	address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	serve := func(url string, token string) *httptest.ResponseRecorder {
+		engine := gin.New()
+		engine.GET("/api/users/:address", testSuite.authController.RequireAddressOwner, func(c *gin.Context) {
+			c.String(http.StatusOK, c.GetString(AuthenticatedAddressKey))
+		})
+		engine.GET("/api/reward-history", testSuite.authController.RequireAddressOwner, func(c *gin.Context) {
+			c.String(http.StatusOK, c.GetString(AuthenticatedAddressKey))
+		})
+
+		testResponseWriter := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		engine.ServeHTTP(testResponseWriter, request)
+		return testResponseWriter
+	}
+
+	t.Run("Own Address", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAuthService.EXPECT().Authenticate("token", mock.Anything).Return(address, nil).Times(2)
+
+		testResponseWriter := serve("/api/users/"+strings.ToLower(address), "token")
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, address, testResponseWriter.Body.String())
+
+		testResponseWriter = serve("/api/reward-history?user_address="+address, "token")
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+	})
+
+	t.Run("Another Address", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAuthService.EXPECT().Authenticate("token", mock.Anything).Return(address, nil).Times(2)
+
+		assert.Equal(t, http.StatusForbidden, serve("/api/users/0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "token").Code)
+		assert.Equal(t, http.StatusForbidden, serve("/api/reward-history?campaign_id=1", "token").Code)
+	})
+
+	t.Run("Without Token", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		assert.Equal(t, http.StatusUnauthorized, serve("/api/users/"+address, "").Code)
+	})
+
+	t.Run("Invalid Token", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAuthService.EXPECT().Authenticate("expired", mock.Anything).Return("", exception.UnauthenticatedError).Times(1)
+
+		assert.Equal(t, http.StatusUnauthorized, serve("/api/users/"+address, "expired").Code)
+	})
+}
+
+func TestRequireQueriedAddressOwner(t *testing.T) {
+	testSuite := &authControllerTestSuite{}
+	address := "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	serve := func(url string, token string) *httptest.ResponseRecorder {
+		engine := gin.New()
+		engine.GET("/api/tasks", testSuite.authController.RequireQueriedAddressOwner, func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		testResponseWriter := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, url, nil)
+		if token != "" {
+			request.Header.Set("Authorization", "Bearer "+token)
+		}
+		engine.ServeHTTP(testResponseWriter, request)
+		return testResponseWriter
+	}
+
+	t.Run("Without User Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		assert.Equal(t, http.StatusOK, serve("/api/tasks?campaign_id=1", "").Code)
+	})
+
+	t.Run("Own Address", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAuthService.EXPECT().Authenticate("token", mock.Anything).Return(address, nil).Times(1)
+
+		assert.Equal(t, http.StatusOK, serve("/api/tasks?user_address="+address, "token").Code)
+	})
+
+	t.Run("Another Address", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAuthService.EXPECT().Authenticate("token", mock.Anything).Return(address, nil).Times(1)
+
+		assert.Equal(t, http.StatusForbidden, serve("/api/tasks?user_address=0x70997970C51812dc3A010C7d01b50e0d17dc79C8", "token").Code)
+	})
+
+	t.Run("Without Token", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		assert.Equal(t, http.StatusUnauthorized, serve("/api/tasks?user_address="+address, "").Code)
+	})
+}
@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type CampaignController interface {
+	CreateCampaign(c *gin.Context)
+	UpdateCampaign(c *gin.Context)
+	PauseCampaign(c *gin.Context)
+	ResumeCampaign(c *gin.Context)
+	ArchiveCampaign(c *gin.Context)
+	GetCampaign(c *gin.Context)
+	SearchCampaigns(c *gin.Context)
+}
+
+type campaignController struct {
+	campaignService service.CampaignService
+}
+
+var (
+	campaignControllerInstance *campaignController
+	campaignControllerOnce     sync.Once
+)
+
+func GetCampaignControllerInstance() CampaignController {
+	campaignControllerOnce.Do(func() {
+		campaignControllerInstance = &campaignController{
+			campaignService: service.NewCampaignService(),
+		}
+	})
+	return campaignControllerInstance
+}
+
+func (cc *campaignController) CreateCampaign(c *gin.Context) {
+	campaign, ok := bindCampaign(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := cc.campaignService.CreateCampaign(campaign)
+	if err != nil {
+		respondCampaignError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewCampaign(campaign))
+}
+
+func (cc *campaignController) UpdateCampaign(c *gin.Context) {
+	campaignID, ok := bindCampaignID(c)
+	if !ok {
+		return
+	}
+
+	campaign, ok := bindCampaign(c)
+	if !ok {
+		return
+	}
+
+	campaign.ID = campaignID
+	campaign, err := cc.campaignService.UpdateCampaign(campaign)
+	if err != nil {
+		respondCampaignError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewCampaign(campaign))
+}
+
+func (cc *campaignController) PauseCampaign(c *gin.Context) {
+	cc.transitCampaign(c, cc.campaignService.PauseCampaign)
+}
+
+func (cc *campaignController) ResumeCampaign(c *gin.Context) {
+	cc.transitCampaign(c, cc.campaignService.ResumeCampaign)
+}
+
+func (cc *campaignController) ArchiveCampaign(c *gin.Context) {
+	cc.transitCampaign(c, cc.campaignService.ArchiveCampaign)
+}
+
+func (cc *campaignController) GetCampaign(c *gin.Context) {
+	campaignID, ok := bindCampaignID(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := cc.campaignService.GetCampaign(campaignID)
+	if err != nil {
+		respondCampaignError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewCampaign(campaign))
+}
+
+func (cc *campaignController) SearchCampaigns(c *gin.Context) {
+	var query request.SearchCampaignsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	var statuses []model.CampaignStatus
+	for _, status := range query.Status {
+		statuses = append(statuses, model.CampaignStatus(status))
+	}
+
+	campaigns, err := cc.campaignService.SearchCampaigns(statuses)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewCampaignCollection(campaigns))
+}
+
+func (cc *campaignController) transitCampaign(c *gin.Context, transit func(campaignID int) (*model.Campaign, error)) {
+	campaignID, ok := bindCampaignID(c)
+	if !ok {
+		return
+	}
+
+	campaign, err := transit(campaignID)
+	if err != nil {
+		respondCampaignError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewCampaign(campaign))
+}
+
+func bindCampaignID(c *gin.Context) (int, bool) {
+	campaignID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "invalid campaign id"})
+		return 0, false
+	}
+
+	return campaignID, true
+}
+
+func bindCampaign(c *gin.Context) (*model.Campaign, bool) {
+	var body request.CampaignRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return nil, false
+	}
+
+	timezone := body.Timezone
+	if timezone == "" {
+		timezone = model.DefaultTimezone
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return nil, false
+	}
+
+	startTime, err := parseCampaignStartTime(body.StartTime, location)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return nil, false
+	}
+
+	campaign := model.NewCampaign(body.Name, body.Pools, startTime, model.PeriodType(body.PeriodType), body.Periods)
+	campaign.PeriodCron = body.PeriodCron
+	campaign.Timezone = timezone
+	campaign.BudgetPerPeriod = body.BudgetPerPeriod
+	campaign.BudgetSchedule = body.BudgetSchedule
+	if body.BudgetDecay > 0 {
+		campaign.BudgetDecay = body.BudgetDecay
+	}
+	campaign.OnboardingAmount = body.OnboardingAmount
+	campaign.OnboardingReward = body.OnboardingReward
+
+	return campaign, true
+}
+
+// parseCampaignStartTime accepts an RFC3339 timestamp, or a plain date which is
+// taken as midnight in the campaign timezone.
+func parseCampaignStartTime(value string, location *time.Location) (time.Time, error) {
+	if startTime, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		return startTime, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func respondCampaignError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exception.CampaignNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.InvalidCampaignError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	}
+}
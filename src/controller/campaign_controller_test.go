@@ -0,0 +1,184 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type campaignControllerTestSuite struct {
+	campaignController    CampaignController
+	mockedCampaignService *service.MockCampaignService
+}
+
+func (s *campaignControllerTestSuite) setUp(t *testing.T) {
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.campaignController = &campaignController{
+		campaignService: s.mockedCampaignService,
+	}
+}
+
+func TestCampaignController(t *testing.T) {
+	testSuite := &campaignControllerTestSuite{}
+	startTime, _ := time.Parse(time.RFC3339, "2024-09-01T00:00:00Z")
+	campaign := &model.Campaign{
+		ID:               1,
+		Name:             "test_campaign",
+		Pools:            []string{"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"},
+		StartTime:        startTime,
+		PeriodType:       model.PeriodTypeWeekly,
+		Timezone:         model.DefaultTimezone,
+		Periods:          4,
+		BudgetPerPeriod:  10000,
+		OnboardingAmount: 1000,
+		OnboardingReward: 100,
+		Status:           model.CampaignStatusActive,
+	}
+
+	createRequestBody := func() *bytes.Buffer {
+		body, _ := json.Marshal(gin.H{
+			"name":              "test_campaign",
+			"pools":             []string{"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"},
+			"start_time":        "2024-09-01T00:00:00Z",
+			"period_type":       "weekly",
+			"periods":           4,
+			"budget_per_period": 10000,
+			"onboarding_amount": 1000,
+			"onboarding_reward": 100,
+		})
+		return bytes.NewBuffer(body)
+	}
+
+	t.Run("CreateCampaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns", createRequestBody())
+
+		testSuite.mockedCampaignService.EXPECT().CreateCampaign(mock.MatchedBy(func(c *model.Campaign) bool {
+			return c.Name == "test_campaign" && c.StartTime.Equal(startTime) && c.PeriodType == model.PeriodTypeWeekly &&
+				c.Periods == 4 && c.BudgetPerPeriod == 10000 && c.OnboardingAmount == 1000 && c.OnboardingReward == 100
+		})).Return(campaign, nil).Times(1)
+
+		testSuite.campaignController.CreateCampaign(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var campaignFromRes response.Campaign
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &campaignFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, campaignFromRes.ID)
+		assert.Equal(t, startTime.Add(time.Hour*24*7*4), campaignFromRes.EndTime)
+	})
+
+	t.Run("CreateCampaign with invalid body", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns", bytes.NewBufferString(`{"name": "test_campaign"}`))
+
+		testSuite.campaignController.CreateCampaign(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("UpdateCampaign rejected by service", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "1"}}
+		testContext.Request = httptest.NewRequest(http.MethodPut, "/api/admin/campaigns/1", createRequestBody())
+
+		testSuite.mockedCampaignService.EXPECT().UpdateCampaign(mock.MatchedBy(func(c *model.Campaign) bool {
+			return c.ID == 1
+		})).Return(nil, exception.InvalidCampaignError).Times(1)
+
+		testSuite.campaignController.UpdateCampaign(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("PauseCampaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "1"}}
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/pause", nil)
+
+		pausedCampaign := *campaign
+		pausedCampaign.Status = model.CampaignStatusPaused
+		testSuite.mockedCampaignService.EXPECT().PauseCampaign(1).Return(&pausedCampaign, nil).Times(1)
+
+		testSuite.campaignController.PauseCampaign(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var campaignFromRes response.Campaign
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &campaignFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, string(model.CampaignStatusPaused), campaignFromRes.Status)
+	})
+
+	t.Run("GetCampaign not found", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "2"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/campaigns/2", nil)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(2).Return(nil, exception.CampaignNotFoundError).Times(1)
+
+		testSuite.campaignController.GetCampaign(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testContext.Writer.Status())
+	})
+
+	t.Run("GetCampaign with invalid id", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "abc"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/campaigns/abc", nil)
+
+		testSuite.campaignController.GetCampaign(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("SearchCampaigns by status", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/campaigns?status=active&status=paused", nil)
+
+		testSuite.mockedCampaignService.EXPECT().
+			SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive, model.CampaignStatusPaused}).
+			Return([]*model.Campaign{campaign}, nil).Times(1)
+
+		testSuite.campaignController.SearchCampaigns(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var campaignsFromRes response.CampaignCollection
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &campaignsFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(campaignsFromRes))
+	})
+}
@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type ClaimController interface {
+	RequestClaim(c *gin.Context)
+	GetClaimsOfAddress(c *gin.Context)
+}
+
+type claimController struct {
+	claimService service.ClaimService
+}
+
+var (
+	claimControllerInstance *claimController
+	claimControllerOnce     sync.Once
+)
+
+func GetClaimControllerInstance() ClaimController {
+	claimControllerOnce.Do(func() {
+		claimControllerInstance = &claimController{
+			claimService: service.NewClaimService(),
+		}
+	})
+	return claimControllerInstance
+}
+
+// RequestClaim claims the balance of the address, part of the next Merkle
+// distribution.
+func (cc *claimController) RequestClaim(c *gin.Context) {
+	address := c.Param("address")
+	claimed, err := cc.claimService.RequestClaim(address, time.Now().UTC())
+
+	switch {
+	case errors.Is(err, exception.InvalidAddressError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.UserNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.NothingToClaimError):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.MerkleClaimsDisabledError):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"exception": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusAccepted, response.NewClaimRequest(common.HexToAddress(address).Hex(), claimed))
+	}
+}
+
+// GetClaimsOfAddress returns, per campaign, the proof of the address in the
+// latest Merkle distribution.
+func (cc *claimController) GetClaimsOfAddress(c *gin.Context) {
+	claims, err := cc.claimService.GetClaims(c.Param("address"))
+
+	if errors.Is(err, exception.InvalidAddressError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewClaimCollection(claims))
+}
@@ -0,0 +1,144 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type claimControllerTestSuite struct {
+	claimController    ClaimController
+	mockedClaimService *service.MockClaimService
+}
+
+func (s *claimControllerTestSuite) setUp(t *testing.T) {
+	s.mockedClaimService = service.NewMockClaimService(t)
+	s.claimController = &claimController{
+		claimService: s.mockedClaimService,
+	}
+}
+
+func TestClaimController(t *testing.T) {
+	testSuite := &claimControllerTestSuite{}
+	address := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+	t.Run("GetClaimsOfAddress", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/claims/"+address, nil)
+
+		testSuite.mockedClaimService.EXPECT().GetClaims(address).Return([]*model.ClaimOfCampaign{{
+			Distribution: &model.MerkleDistribution{ID: 3, CampaignID: 1, PeriodIndex: 2, Root: "0xroot", TokenDecimals: 18},
+			Claim:        &model.Claim{DistributionID: 3, Address: address, Amount: "1000000000000000000", Proof: []string{"0xa", "0xb"}},
+		}}, nil).Times(1)
+
+		testSuite.claimController.GetClaimsOfAddress(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var claims []*response.Claim
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &claims)
+		assert.Nil(t, err)
+		assert.Equal(t, []*response.Claim{{
+			CampaignID:    1,
+			PeriodIndex:   2,
+			Root:          "0xroot",
+			TokenDecimals: 18,
+			Address:       address,
+			Amount:        "1000000000000000000",
+			Proof:         []string{"0xa", "0xb"},
+		}}, claims)
+	})
+
+	t.Run("No Claim", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/claims/"+address, nil)
+
+		testSuite.mockedClaimService.EXPECT().GetClaims(address).Return(nil, nil).Times(1)
+
+		testSuite.claimController.GetClaimsOfAddress(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.Equal(t, "[]", testResponseWriter.Body.String())
+	})
+
+	t.Run("RequestClaim", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/claims/"+address, nil)
+
+		testSuite.mockedClaimService.EXPECT().RequestClaim(address, mock.Anything).Return(2500.25, nil).Times(1)
+
+		testSuite.claimController.RequestClaim(testContext)
+
+		assert.Equal(t, http.StatusAccepted, testContext.Writer.Status())
+
+		var claimRequest response.ClaimRequest
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &claimRequest)
+		assert.Nil(t, err)
+		assert.Equal(t, response.ClaimRequest{Address: address, ClaimedPoints: 2500.25}, claimRequest)
+	})
+
+	t.Run("Nothing To Claim", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/claims/"+address, nil)
+
+		testSuite.mockedClaimService.EXPECT().RequestClaim(address, mock.Anything).Return(0, exception.NothingToClaimError).Times(1)
+
+		testSuite.claimController.RequestClaim(testContext)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, testContext.Writer.Status())
+	})
+
+	t.Run("Merkle Claims Disabled", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/claims/"+address, nil)
+
+		testSuite.mockedClaimService.EXPECT().RequestClaim(address, mock.Anything).Return(0, exception.MerkleClaimsDisabledError).Times(1)
+
+		testSuite.claimController.RequestClaim(testContext)
+
+		assert.Equal(t, http.StatusServiceUnavailable, testContext.Writer.Status())
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/claims/test_user", nil)
+
+		testSuite.mockedClaimService.EXPECT().GetClaims("test_user").Return(nil, exception.InvalidAddressError).Times(1)
+
+		testSuite.claimController.GetClaimsOfAddress(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
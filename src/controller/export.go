@@ -0,0 +1,65 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"io"
+	"log"
+	"net/http"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+// negotiateExportFormat picks the export format from the Accept header, JSON
+// stays the default so the paginated responses are unchanged.
+func negotiateExportFormat(c *gin.Context) (model.ExportFormat, bool) {
+	switch c.NegotiateFormat(gin.MIMEJSON, model.ExportFormatCSV.ContentType(), model.ExportFormatNDJSON.ContentType()) {
+	case model.ExportFormatCSV.ContentType():
+		return model.ExportFormatCSV, true
+	case model.ExportFormatNDJSON.ContentType():
+		return model.ExportFormatNDJSON, true
+	}
+	return "", false
+}
+
+// streamExport writes the export as an attachment. Errors raised before the
+// first row are answered as JSON, later ones can only cut the stream.
+func streamExport(c *gin.Context, name string, format model.ExportFormat, export func(w io.Writer) error) {
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.%s", name, format))
+	c.Status(http.StatusOK)
+
+	err := export(c.Writer)
+	if err == nil {
+		return
+	}
+
+	if c.Writer.Written() {
+		log.Printf("Failed to stream %s export: %v", name, err)
+		c.Abort()
+		return
+	}
+
+	c.Writer.Header().Del("Content-Type")
+	c.Writer.Header().Del("Content-Disposition")
+	respondExportError(c, err)
+}
+
+func respondExportError(c *gin.Context, err error) {
+	if errors.Is(err, exception.InvalidExportError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	respondCampaignError(c, err)
+}
+
+// parseOptionalTime parses an RFC3339 query value, an empty value is the zero time.
+func parseOptionalTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type LeaderboardController interface {
+	GetLeaderboard(c *gin.Context)
+}
+
+type leaderboardController struct {
+	leaderboardService service.LeaderboardService
+}
+
+var (
+	leaderboardControllerInstance *leaderboardController
+	leaderboardControllerOnce     sync.Once
+)
+
+func GetLeaderboardControllerInstance() LeaderboardController {
+	leaderboardControllerOnce.Do(func() {
+		leaderboardControllerInstance = &leaderboardController{
+			leaderboardService: service.NewLeaderboardService(),
+		}
+	})
+	return leaderboardControllerInstance
+}
+
+func (l *leaderboardController) GetLeaderboard(c *gin.Context) {
+	var query request.GetLeaderboardRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	leaderboard, err := l.leaderboardService.GetLeaderboard(&model.LeaderboardQuery{
+		CampaignID:  query.CampaignID,
+		PeriodIndex: query.Period,
+		SortBy:      model.LeaderboardSortBy(query.SortBy),
+		Page:        query.Page,
+		PageSize:    query.PageSize,
+		UserID:      query.User,
+	})
+
+	if err != nil {
+		respondCampaignError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewLeaderboard(leaderboard))
+}
@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type leaderboardControllerTestSuite struct {
+	leaderboardController    LeaderboardController
+	mockedLeaderboardService *service.MockLeaderboardService
+}
+
+func (s *leaderboardControllerTestSuite) setUp(t *testing.T) {
+	s.mockedLeaderboardService = service.NewMockLeaderboardService(t)
+	s.leaderboardController = &leaderboardController{
+		leaderboardService: s.mockedLeaderboardService,
+	}
+}
+
+func TestLeaderboardController(t *testing.T) {
+	testSuite := &leaderboardControllerTestSuite{}
+
+	t.Run("GetLeaderboard", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet,
+			"/api/leaderboard?campaign_id=1&period=0&sort_by=points&page=2&page_size=10&user_address=test_user_2", nil)
+
+		periodIndex := 0
+		testSuite.mockedLeaderboardService.EXPECT().GetLeaderboard(mock.MatchedBy(func(query *model.LeaderboardQuery) bool {
+			return query.CampaignID == 1 && *query.PeriodIndex == 0 && query.SortBy == model.LeaderboardSortByPoints &&
+				query.Page == 2 && query.PageSize == 10 && query.UserID == "test_user_2"
+		})).Return(&model.Leaderboard{
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+			SortBy:      model.LeaderboardSortByPoints,
+			Page:        2,
+			PageSize:    10,
+			Total:       11,
+			Entries:     []*model.LeaderboardEntry{{Rank: 11, UserID: "test_user_2", Points: 10, Tier: "gold"}},
+			Me:          &model.LeaderboardEntry{Rank: 11, UserID: "test_user_2", Points: 10},
+		}, nil).Times(1)
+
+		testSuite.leaderboardController.GetLeaderboard(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var leaderboardFromRes response.Leaderboard
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &leaderboardFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 11, leaderboardFromRes.Total)
+		assert.Equal(t, "test_user_2", leaderboardFromRes.Entries[0].UserAddress)
+		assert.Equal(t, "gold", leaderboardFromRes.Entries[0].Tier)
+		assert.Equal(t, 11, leaderboardFromRes.Me.Rank)
+	})
+
+	t.Run("GetLeaderboard with invalid sort", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/leaderboard?campaign_id=1&sort_by=name", nil)
+
+		testSuite.leaderboardController.GetLeaderboard(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("GetLeaderboard of unknown campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/leaderboard?campaign_id=9", nil)
+
+		testSuite.mockedLeaderboardService.EXPECT().GetLeaderboard(mock.Anything).Return(nil, exception.CampaignNotFoundError).Times(1)
+
+		testSuite.leaderboardController.GetLeaderboard(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testContext.Writer.Status())
+	})
+}
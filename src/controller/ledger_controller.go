@@ -0,0 +1,42 @@
+package controller
+
+import (
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type LedgerController interface {
+	GetBalanceMismatches(c *gin.Context)
+}
+
+type ledgerController struct {
+	ledgerService service.LedgerService
+}
+
+var (
+	ledgerControllerInstance *ledgerController
+	ledgerControllerOnce     sync.Once
+)
+
+func GetLedgerControllerInstance() LedgerController {
+	ledgerControllerOnce.Do(func() {
+		ledgerControllerInstance = &ledgerController{
+			ledgerService: service.NewLedgerService(),
+		}
+	})
+	return ledgerControllerInstance
+}
+
+// GetBalanceMismatches lists the users flagged by the last reconciliation.
+func (lc *ledgerController) GetBalanceMismatches(c *gin.Context) {
+	mismatches, err := lc.ledgerService.GetBalanceMismatches()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewBalanceMismatchCollection(mismatches))
+}
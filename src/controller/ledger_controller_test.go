@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type ledgerControllerTestSuite struct {
+	ledgerController    LedgerController
+	mockedLedgerService *service.MockLedgerService
+}
+
+func (s *ledgerControllerTestSuite) setUp(t *testing.T) {
+	s.mockedLedgerService = service.NewMockLedgerService(t)
+	s.ledgerController = &ledgerController{
+		ledgerService: s.mockedLedgerService,
+	}
+}
+
+func TestLedgerController(t *testing.T) {
+	testSuite := &ledgerControllerTestSuite{}
+
+	t.Run("GetBalanceMismatches", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/ledger/mismatches", nil)
+
+		testSuite.mockedLedgerService.EXPECT().GetBalanceMismatches().Return([]*model.BalanceMismatch{
+			{UserID: "test_user_id", CachedPoints: 150, LedgerPoints: 100, DetectedAt: time.Now().UTC()},
+		}, nil).Times(1)
+
+		testSuite.ledgerController.GetBalanceMismatches(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var mismatchesFromRes []*response.BalanceMismatch
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &mismatchesFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(mismatchesFromRes))
+		assert.Equal(t, 50.0, mismatchesFromRes[0].Difference)
+	})
+}
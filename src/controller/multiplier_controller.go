@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"sync"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/service"
+)
+
+type MultiplierController interface {
+	CreateMultiplier(c *gin.Context)
+	SearchMultipliers(c *gin.Context)
+	DeleteMultiplier(c *gin.Context)
+}
+
+type multiplierController struct {
+	multiplierService service.MultiplierService
+}
+
+var (
+	multiplierControllerInstance *multiplierController
+	multiplierControllerOnce     sync.Once
+)
+
+func GetMultiplierControllerInstance() MultiplierController {
+	multiplierControllerOnce.Do(func() {
+		multiplierControllerInstance = &multiplierController{
+			multiplierService: service.NewMultiplierService(),
+		}
+	})
+	return multiplierControllerInstance
+}
+
+func (mc *multiplierController) CreateMultiplier(c *gin.Context) {
+	var body request.CreateMultiplierRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	multiplier, err := mc.multiplierService.CreateMultiplier(&model.Multiplier{
+		Name:       body.Name,
+		Factor:     body.Factor,
+		CampaignID: body.CampaignID,
+		UserIDs:    body.UserIDs,
+		StartTime:  body.StartTime.UTC(),
+		EndTime:    body.EndTime,
+	})
+
+	if err != nil {
+		respondMultiplierError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, multiplier)
+}
+
+// SearchMultipliers lists the multipliers of the campaign of the query, the
+// ones of every campaign included, or all of them.
+func (mc *multiplierController) SearchMultipliers(c *gin.Context) {
+	var query request.SearchMultipliersRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	multipliers, err := mc.multiplierService.GetMultipliers(query.CampaignID)
+	if err != nil {
+		respondMultiplierError(c, err)
+		return
+	}
+
+	if multipliers == nil {
+		multipliers = []*model.Multiplier{}
+	}
+
+	c.JSON(http.StatusOK, multipliers)
+}
+
+// DeleteMultiplier stops a multiplier. Rewards it already boosted keep it on
+// their record.
+func (mc *multiplierController) DeleteMultiplier(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "invalid multiplier id"})
+		return
+	}
+
+	if err := mc.multiplierService.DeleteMultiplier(id); err != nil {
+		respondMultiplierError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func respondMultiplierError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exception.InvalidMultiplierError), errors.Is(err, exception.InvalidAddressError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.MultiplierNotFoundError), errors.Is(err, exception.CampaignNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	}
+}
@@ -0,0 +1,102 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type multiplierControllerTestSuite struct {
+	multiplierController    MultiplierController
+	mockedMultiplierService *service.MockMultiplierService
+}
+
+func (s *multiplierControllerTestSuite) setUp(t *testing.T) {
+	s.mockedMultiplierService = service.NewMockMultiplierService(t)
+	s.multiplierController = &multiplierController{
+		multiplierService: s.mockedMultiplierService,
+	}
+}
+
+func TestMultiplierController(t *testing.T) {
+	testSuite := &multiplierControllerTestSuite{}
+
+	t.Run("CreateMultiplier", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/multipliers",
+			strings.NewReader(`{"name": "weekend", "factor": 2, "campaign_id": 1, "start_time": "2024-09-07T00:00:00Z", "end_time": "2024-09-09T00:00:00Z"}`))
+
+		startTime := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+		testSuite.mockedMultiplierService.EXPECT().CreateMultiplier(mock.MatchedBy(func(multiplier *model.Multiplier) bool {
+			return multiplier.Name == "weekend" && multiplier.Factor == 2 && multiplier.CampaignID == 1 &&
+				multiplier.StartTime.Equal(startTime) && multiplier.EndTime.Equal(startTime.Add(48*time.Hour))
+		})).RunAndReturn(func(multiplier *model.Multiplier) (*model.Multiplier, error) {
+			multiplier.ID = 1
+			return multiplier, nil
+		}).Times(1)
+
+		testSuite.multiplierController.CreateMultiplier(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var multiplierFromRes map[string]any
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &multiplierFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1.0, multiplierFromRes["id"])
+	})
+
+	t.Run("CreateMultiplier with invalid factor", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/multipliers",
+			strings.NewReader(`{"name": "weekend", "factor": -1, "start_time": "2024-09-07T00:00:00Z"}`))
+
+		testSuite.multiplierController.CreateMultiplier(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("SearchMultipliers", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/multipliers?campaign_id=1", nil)
+
+		testSuite.mockedMultiplierService.EXPECT().GetMultipliers(1).Return(nil, nil).Times(1)
+
+		testSuite.multiplierController.SearchMultipliers(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.Equal(t, "[]", testResponseWriter.Body.String())
+	})
+
+	t.Run("DeleteMultiplier", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "id", Value: "3"}}
+		testContext.Request = httptest.NewRequest(http.MethodDelete, "/api/admin/multipliers/3", nil)
+
+		testSuite.mockedMultiplierService.EXPECT().DeleteMultiplier(3).Return(exception.MultiplierNotFoundError).Times(1)
+
+		testSuite.multiplierController.DeleteMultiplier(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testContext.Writer.Status())
+	})
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"trading-ace/src/repository"
+	"trading-ace/src/request"
+)
+
+const defaultPageLimit = 50
+
+func newPage(query request.PageRequest) *repository.Page {
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultPageLimit
+	}
+
+	return &repository.Page{
+		Cursor: query.Cursor,
+		Limit:  limit,
+		SortBy: query.SortBy,
+		Order:  repository.SortOrder(query.Order),
+	}
+}
@@ -0,0 +1,165 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/request"
+	"trading-ace/src/service"
+)
+
+type RedemptionController interface {
+	GetCatalogue(c *gin.Context)
+	RequestRedemption(c *gin.Context)
+	GetRedemptionsOfAddress(c *gin.Context)
+	SearchRedemptions(c *gin.Context)
+	FulfillRedemption(c *gin.Context)
+	CancelRedemption(c *gin.Context)
+}
+
+type redemptionController struct {
+	redemptionService service.RedemptionService
+}
+
+var (
+	redemptionControllerInstance *redemptionController
+	redemptionControllerOnce     sync.Once
+)
+
+func GetRedemptionControllerInstance() RedemptionController {
+	redemptionControllerOnce.Do(func() {
+		redemptionControllerInstance = &redemptionController{
+			redemptionService: service.NewRedemptionService(),
+		}
+	})
+	return redemptionControllerInstance
+}
+
+func (rc *redemptionController) GetCatalogue(c *gin.Context) {
+	catalogue := rc.redemptionService.GetCatalogue()
+	if catalogue == nil {
+		catalogue = []*model.CatalogueItem{}
+	}
+
+	c.JSON(http.StatusOK, catalogue)
+}
+
+// RequestRedemption spends the points of the address on a catalogue item.
+func (rc *redemptionController) RequestRedemption(c *gin.Context) {
+	var body request.RedemptionRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	redemption, err := rc.redemptionService.RequestRedemption(c.Param("address"), body.ItemID, time.Now().UTC())
+	if err != nil {
+		respondRedemptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, redemption)
+}
+
+// GetRedemptionsOfAddress returns the redemptions of the address, latest first.
+func (rc *redemptionController) GetRedemptionsOfAddress(c *gin.Context) {
+	redemptions, err := rc.redemptionService.GetRedemptions(c.Param("address"))
+	if err != nil {
+		respondRedemptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, nonNilRedemptions(redemptions))
+}
+
+func (rc *redemptionController) SearchRedemptions(c *gin.Context) {
+	var query request.SearchRedemptionsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	var statuses []model.RedemptionStatus
+	for _, status := range query.Status {
+		statuses = append(statuses, model.RedemptionStatus(status))
+	}
+
+	redemptions, err := rc.redemptionService.SearchRedemptions(statuses)
+	if err != nil {
+		respondRedemptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, nonNilRedemptions(redemptions))
+}
+
+func (rc *redemptionController) FulfillRedemption(c *gin.Context) {
+	id, ok := bindRedemptionID(c)
+	if !ok {
+		return
+	}
+
+	redemption, err := rc.redemptionService.FulfillRedemption(id, c.GetString(AdminOperatorKey), time.Now().UTC())
+	if err != nil {
+		respondRedemptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, redemption)
+}
+
+// CancelRedemption cancels a pending redemption and refunds its points.
+func (rc *redemptionController) CancelRedemption(c *gin.Context) {
+	id, ok := bindRedemptionID(c)
+	if !ok {
+		return
+	}
+
+	var body request.CancelRedemptionRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	redemption, err := rc.redemptionService.CancelRedemption(id, body.Reason, c.GetString(AdminOperatorKey), time.Now().UTC())
+	if err != nil {
+		respondRedemptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, redemption)
+}
+
+func bindRedemptionID(c *gin.Context) (int, bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "invalid redemption id"})
+		return 0, false
+	}
+	return id, true
+}
+
+func nonNilRedemptions(redemptions []*model.Redemption) []*model.Redemption {
+	if redemptions == nil {
+		return []*model.Redemption{}
+	}
+	return redemptions
+}
+
+func respondRedemptionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exception.InvalidAddressError), errors.Is(err, exception.InvalidRedemptionError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.UserNotFoundError), errors.Is(err, exception.RedemptionNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.InsufficientPointsError), errors.Is(err, exception.RedemptionNotPendingError):
+		c.JSON(http.StatusConflict, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	}
+}
@@ -0,0 +1,129 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type redemptionControllerTestSuite struct {
+	redemptionController    RedemptionController
+	mockedRedemptionService *service.MockRedemptionService
+}
+
+func (s *redemptionControllerTestSuite) setUp(t *testing.T) {
+	s.mockedRedemptionService = service.NewMockRedemptionService(t)
+	s.redemptionController = &redemptionController{
+		redemptionService: s.mockedRedemptionService,
+	}
+}
+
+func TestRedemptionController(t *testing.T) {
+	testSuite := &redemptionControllerTestSuite{}
+
+	newContext := func(method string, params gin.Params, body string) (*gin.Context, *httptest.ResponseRecorder) {
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = params
+		testContext.Set(AdminOperatorKey, "ops")
+		testContext.Request = httptest.NewRequest(method, "/api/redemptions", strings.NewReader(body))
+		return testContext, testResponseWriter
+	}
+
+	t.Run("RequestRedemption", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, testResponseWriter := newContext(http.MethodPost, gin.Params{{Key: "address", Value: "test_user_id"}}, `{"item_id": "mug"}`)
+
+		testSuite.mockedRedemptionService.EXPECT().RequestRedemption("test_user_id", "mug", mock.Anything).Return(&model.Redemption{
+			ID:     1,
+			ItemID: "mug",
+			Points: 60,
+			Status: model.RedemptionStatusPending,
+		}, nil).Times(1)
+
+		testSuite.redemptionController.RequestRedemption(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var redemptionFromRes model.Redemption
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &redemptionFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, model.RedemptionStatusPending, redemptionFromRes.Status)
+	})
+
+	t.Run("RequestRedemption with Insufficient Points", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(http.MethodPost, gin.Params{{Key: "address", Value: "test_user_id"}}, `{"item_id": "mug"}`)
+
+		testSuite.mockedRedemptionService.EXPECT().RequestRedemption("test_user_id", "mug", mock.Anything).
+			Return(nil, exception.InsufficientPointsError).Times(1)
+
+		testSuite.redemptionController.RequestRedemption(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+	})
+
+	t.Run("RequestRedemption without Item", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(http.MethodPost, gin.Params{{Key: "address", Value: "test_user_id"}}, `{}`)
+
+		testSuite.redemptionController.RequestRedemption(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("CancelRedemption", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(http.MethodPost, gin.Params{{Key: "id", Value: "1"}}, `{"reason": "out of stock"}`)
+
+		testSuite.mockedRedemptionService.EXPECT().CancelRedemption(1, "out of stock", "ops", mock.Anything).Return(&model.Redemption{
+			ID:     1,
+			Status: model.RedemptionStatusCancelled,
+		}, nil).Times(1)
+
+		testSuite.redemptionController.CancelRedemption(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+	})
+
+	t.Run("FulfillRedemption not Pending", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(http.MethodPost, gin.Params{{Key: "id", Value: "1"}}, ``)
+
+		testSuite.mockedRedemptionService.EXPECT().FulfillRedemption(1, "ops", mock.Anything).
+			Return(nil, exception.RedemptionNotPendingError).Times(1)
+
+		testSuite.redemptionController.FulfillRedemption(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+	})
+
+	t.Run("FulfillRedemption with Invalid ID", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, _ := newContext(http.MethodPost, gin.Params{{Key: "id", Value: "abc"}}, ``)
+
+		testSuite.redemptionController.FulfillRedemption(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("GetRedemptionsOfAddress", func(t *testing.T) {
+		testSuite.setUp(t)
+		testContext, testResponseWriter := newContext(http.MethodGet, gin.Params{{Key: "address", Value: "test_user_id"}}, ``)
+
+		testSuite.mockedRedemptionService.EXPECT().GetRedemptions("test_user_id").Return(nil, nil).Times(1)
+
+		testSuite.redemptionController.GetRedemptionsOfAddress(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.Equal(t, "[]", testResponseWriter.Body.String())
+	})
+}
@@ -0,0 +1,96 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"trading-ace/src/exception"
+	"trading-ace/src/request"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type ReferralController interface {
+	GetReferralInfo(c *gin.Context)
+	RegisterCode(c *gin.Context)
+	LinkReferral(c *gin.Context)
+}
+
+type referralController struct {
+	referralService service.ReferralService
+}
+
+var (
+	referralControllerInstance *referralController
+	referralControllerOnce     sync.Once
+)
+
+func GetReferralControllerInstance() ReferralController {
+	referralControllerOnce.Do(func() {
+		referralControllerInstance = &referralController{
+			referralService: service.NewReferralService(),
+		}
+	})
+	return referralControllerInstance
+}
+
+// GetReferralInfo returns the code of the address, its referrer and referees.
+func (rc *referralController) GetReferralInfo(c *gin.Context) {
+	info, err := rc.referralService.GetReferralInfo(c.Param("address"))
+	if err != nil {
+		respondReferralError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewReferralInfo(c.Param("address"), info, rc.referralService.GetRewards()))
+}
+
+func (rc *referralController) RegisterCode(c *gin.Context) {
+	var body request.RegisterReferralCodeRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	code, err := rc.referralService.RegisterCode(c.Param("address"), body.Code)
+	if err != nil {
+		respondReferralError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, code)
+}
+
+// LinkReferral links a new address to a referral code. It needs no session,
+// the signature of the address proves it agrees.
+func (rc *referralController) LinkReferral(c *gin.Context) {
+	var body request.LinkReferralRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	referral, err := rc.referralService.LinkReferral(c.Param("address"), body.Code, body.Signature)
+	if err != nil {
+		respondReferralError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, referral)
+}
+
+func respondReferralError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exception.InvalidAddressError), errors.Is(err, exception.InvalidReferralCodeError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.InvalidReferralError):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.UserNotFoundError), errors.Is(err, exception.ReferralCodeNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.ReferralCodeAlreadyExistsError), errors.Is(err, exception.ReferralAlreadyLinkedError):
+		c.JSON(http.StatusConflict, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	}
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type referralControllerTestSuite struct {
+	referralController    ReferralController
+	mockedReferralService *service.MockReferralService
+}
+
+func (s *referralControllerTestSuite) setUp(t *testing.T) {
+	s.mockedReferralService = service.NewMockReferralService(t)
+	s.referralController = &referralController{
+		referralService: s.mockedReferralService,
+	}
+}
+
+func TestReferralController(t *testing.T) {
+	testSuite := &referralControllerTestSuite{}
+	const referrer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	const referee = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+	t.Run("GetReferralInfo", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/referrals/"+referrer, nil)
+		testContext.Params = gin.Params{{Key: "address", Value: referrer}}
+
+		testSuite.mockedReferralService.EXPECT().GetReferralInfo(referrer).Return(&model.ReferralInfo{
+			Code:      &model.ReferralCode{Code: "ALICE", UserID: referrer},
+			Referrals: []*model.Referral{{RefereeID: referee, ReferrerID: referrer, Code: "ALICE"}},
+		}, nil).Times(1)
+		testSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{OnboardingReward: 50, SharedPoolShare: 0.1}).Times(1)
+
+		testSuite.referralController.GetReferralInfo(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var infoFromRes map[string]any
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &infoFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, "ALICE", infoFromRes["code"])
+		assert.Equal(t, "", infoFromRes["referred_by"])
+		assert.Equal(t, 1, len(infoFromRes["referrals"].([]any)))
+	})
+
+	t.Run("RegisterCode", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/referrals/"+referrer+"/code", strings.NewReader(`{"code": "alice"}`))
+		testContext.Params = gin.Params{{Key: "address", Value: referrer}}
+
+		testSuite.mockedReferralService.EXPECT().RegisterCode(referrer, "alice").Return(&model.ReferralCode{Code: "ALICE", UserID: referrer}, nil).Times(1)
+
+		testSuite.referralController.RegisterCode(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+	})
+
+	t.Run("RegisterCode already taken", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/referrals/"+referrer+"/code", strings.NewReader(`{"code": "alice"}`))
+		testContext.Params = gin.Params{{Key: "address", Value: referrer}}
+
+		testSuite.mockedReferralService.EXPECT().RegisterCode(referrer, "alice").Return(nil, exception.ReferralCodeAlreadyExistsError).Times(1)
+
+		testSuite.referralController.RegisterCode(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+	})
+
+	t.Run("LinkReferral", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/referrals/"+referee+"/link", strings.NewReader(`{"code": "ALICE", "signature": "0x1234"}`))
+		testContext.Params = gin.Params{{Key: "address", Value: referee}}
+
+		testSuite.mockedReferralService.EXPECT().LinkReferral(referee, "ALICE", "0x1234").Return(&model.Referral{RefereeID: referee, ReferrerID: referrer, Code: "ALICE"}, nil).Times(1)
+
+		testSuite.referralController.LinkReferral(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var referralFromRes map[string]any
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &referralFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, referrer, referralFromRes["referrer_id"])
+	})
+
+	t.Run("LinkReferral with invalid signature", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/referrals/"+referee+"/link", strings.NewReader(`{"code": "ALICE", "signature": "0x1234"}`))
+		testContext.Params = gin.Params{{Key: "address", Value: referee}}
+
+		testSuite.mockedReferralService.EXPECT().LinkReferral(referee, "ALICE", "0x1234").Return(nil, exception.InvalidReferralError).Times(1)
+
+		testSuite.referralController.LinkReferral(testContext)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, testContext.Writer.Status())
+	})
+
+	t.Run("LinkReferral without signature", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/referrals/"+referee+"/link", strings.NewReader(`{"code": "ALICE"}`))
+		testContext.Params = gin.Params{{Key: "address", Value: referee}}
+
+		testSuite.referralController.LinkReferral(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
@@ -1,10 +1,15 @@
 package controller
 
 import (
+	"errors"
 	"github.com/gin-gonic/gin"
+	"io"
 	"net/http"
 	"sync"
 	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
 	"trading-ace/src/request"
 	"trading-ace/src/response"
 	"trading-ace/src/service"
@@ -12,10 +17,14 @@ import (
 
 type RewardController interface {
 	GetRewardHistoryOfUser(c *gin.Context)
+	GetRewardProjectionOfUser(c *gin.Context)
+	ExportRewardRecords(c *gin.Context)
 }
 
 type rewardController struct {
-	rewardService service.RewardService
+	rewardService      service.RewardService
+	periodStatsService service.PeriodStatsService
+	exportService      service.ExportService
 }
 
 var (
@@ -26,7 +35,9 @@ var (
 func GetRewardControllerInstance() RewardController {
 	rewardControllerOnce.Do(func() {
 		rewardControllerInstance = &rewardController{
-			rewardService: service.NewRewardService(),
+			rewardService:      service.NewRewardService(),
+			periodStatsService: service.NewPeriodStatsService(),
+			exportService:      service.NewExportService(),
 		}
 	})
 	return rewardControllerInstance
@@ -39,6 +50,11 @@ func (r *rewardController) GetRewardHistoryOfUser(c *gin.Context) {
 		return
 	}
 
+	if format, ok := negotiateExportFormat(c); ok {
+		r.exportRewardHistory(c, &query, format)
+		return
+	}
+
 	startTime, err := time.Parse(time.RFC3339, query.StartTime)
 	endTime, err := time.Parse(time.RFC3339, query.EndTime)
 
@@ -47,7 +63,13 @@ func (r *rewardController) GetRewardHistoryOfUser(c *gin.Context) {
 		return
 	}
 
-	rewardRecords, err := r.rewardService.GetRewardHistory(query.User, startTime, endTime.Sub(startTime))
+	page := newPage(query.PageRequest)
+	rewardRecords, err := r.rewardService.GetRewardHistory(query.User, startTime, endTime.Sub(startTime), page)
+
+	if errors.Is(err, exception.InvalidPageError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
@@ -55,5 +77,71 @@ func (r *rewardController) GetRewardHistoryOfUser(c *gin.Context) {
 	}
 
 	pointHistoryCollection := response.CreatePointHistoryCollection(&rewardRecords)
-	c.JSON(http.StatusOK, pointHistoryCollection)
+	c.JSON(http.StatusOK, response.NewCursorPage(*pointHistoryCollection, repository.NextRewardRecordsCursor(rewardRecords, page)))
+}
+
+// ExportRewardRecords streams the reward records of any user or of a whole
+// campaign, as CSV unless NDJSON is accepted, so it's only served to the admin
+// API.
+func (r *rewardController) ExportRewardRecords(c *gin.Context) {
+	var query request.GetRewordHistoryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	format, ok := negotiateExportFormat(c)
+	if !ok {
+		format = model.ExportFormatCSV
+	}
+
+	r.exportRewardHistory(c, &query, format)
+}
+
+// exportRewardHistory streams the reward records of a user, a campaign or a
+// campaign period. The time range is optional and not capped.
+func (r *rewardController) exportRewardHistory(c *gin.Context, query *request.GetRewordHistoryRequest, format model.ExportFormat) {
+	startTime, err := parseOptionalTime(query.StartTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	endTime, err := parseOptionalTime(query.EndTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	exportQuery := &model.ExportQuery{
+		Format:      format,
+		UserID:      query.User,
+		CampaignID:  query.CampaignID,
+		PeriodIndex: query.Period,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+
+	streamExport(c, "reward-history", format, func(w io.Writer) error {
+		return r.exportService.ExportRewardRecords(c.Request.Context(), exportQuery, w)
+	})
+}
+
+// GetRewardProjectionOfUser projects the shared pool reward of the running
+// periods from the swaps processed so far.
+func (r *rewardController) GetRewardProjectionOfUser(c *gin.Context) {
+	var query request.GetRewardProjectionRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	projections, err := r.periodStatsService.GetCurrentProjections(query.User, time.Now().UTC())
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewRewardProjectionCollection(projections))
 }
@@ -5,24 +5,33 @@ import (
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 	"trading-ace/mock/service"
+	"trading-ace/src/exception"
 	"trading-ace/src/model"
+	"trading-ace/src/repository"
 	"trading-ace/src/response"
 )
 
 type rewardControllerTestSuite struct {
-	rewardController    RewardController
-	mockedRewardService *service.MockRewardService
+	rewardController         RewardController
+	mockedRewardService      *service.MockRewardService
+	mockedPeriodStatsService *service.MockPeriodStatsService
+	mockedExportService      *service.MockExportService
 }
 
 func (s *rewardControllerTestSuite) setUp(t *testing.T) {
 	s.mockedRewardService = service.NewMockRewardService(t)
+	s.mockedPeriodStatsService = service.NewMockPeriodStatsService(t)
+	s.mockedExportService = service.NewMockExportService(t)
 	s.rewardController = &rewardController{
-		rewardService: s.mockedRewardService,
+		rewardService:      s.mockedRewardService,
+		periodStatsService: s.mockedPeriodStatsService,
+		exportService:      s.mockedExportService,
 	}
 }
 
@@ -71,14 +80,14 @@ func TestGetRewardHistoryOfUser(t *testing.T) {
 		endTime, _ := time.Parse(time.RFC3339, endTimeStr)
 
 		testSuite.mockedRewardService.EXPECT().
-			GetRewardHistory(testUser, startTime, endTime.Sub(startTime)).
+			GetRewardHistory(testUser, startTime, endTime.Sub(startTime), &repository.Page{Limit: defaultPageLimit}).
 			Return(recordHistory, nil)
 
 		testSuite.rewardController.GetRewardHistoryOfUser(testContext)
 
 		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
 
-		expectedRes := response.CreatePointHistoryCollection(&recordHistory)
+		expectedRes := response.NewCursorPage(*response.CreatePointHistoryCollection(&recordHistory), "")
 
 		expectedResStr, err := json.Marshal(expectedRes)
 
@@ -106,7 +115,7 @@ func TestGetRewardHistoryOfUser(t *testing.T) {
 		}
 
 		testSuite.mockedRewardService.EXPECT().
-			GetRewardHistory("test_user_id", startTime, endTime.Sub(startTime)).
+			GetRewardHistory("test_user_id", startTime, endTime.Sub(startTime), &repository.Page{Limit: defaultPageLimit}).
 			Return(nil, assert.AnError)
 
 		testSuite.rewardController.GetRewardHistoryOfUser(testContext)
@@ -120,3 +129,134 @@ func TestGetRewardHistoryOfUser(t *testing.T) {
 		assert.Equal(t, assert.AnError.Error(), exception["exception"])
 	})
 }
+
+func TestExportRewardHistory(t *testing.T) {
+	testSuite := &rewardControllerTestSuite{}
+
+	t.Run("Stream NDJSON", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet,
+			"/api/reward-history?user_address=test_user_id&start_time=2024-08-01T00:00:00Z&end_time=2024-12-01T00:00:00Z", nil)
+		testContext.Request.Header.Set("Accept", "application/x-ndjson")
+
+		testSuite.mockedExportService.EXPECT().ExportRewardRecords(mock.Anything, &model.ExportQuery{
+			Format:    model.ExportFormatNDJSON,
+			UserID:    "test_user_id",
+			StartTime: time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+		}, mock.Anything).Return(nil).Times(1)
+
+		testSuite.rewardController.GetRewardHistoryOfUser(testContext)
+
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "application/x-ndjson", testResponseWriter.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=reward-history.ndjson", testResponseWriter.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("Campaign Not Found", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/reward-history?campaign_id=9&period=0", nil)
+		testContext.Request.Header.Set("Accept", "text/csv")
+
+		testSuite.mockedExportService.EXPECT().ExportRewardRecords(mock.Anything, mock.Anything, mock.Anything).
+			Return(exception.CampaignNotFoundError).Times(1)
+
+		testSuite.rewardController.GetRewardHistoryOfUser(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testResponseWriter.Code)
+	})
+}
+
+func TestExportRewardRecords(t *testing.T) {
+	testSuite := &rewardControllerTestSuite{}
+
+	t.Run("Stream CSV By Default", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/reward-records/export?campaign_id=1&period=2", nil)
+
+		periodIndex := 2
+		testSuite.mockedExportService.EXPECT().ExportRewardRecords(mock.Anything, &model.ExportQuery{
+			Format:      model.ExportFormatCSV,
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+		}, mock.Anything).Return(nil).Times(1)
+
+		testSuite.rewardController.ExportRewardRecords(testContext)
+
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "text/csv", testResponseWriter.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=reward-history.csv", testResponseWriter.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("Invalid Export", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/reward-records/export?period=2", nil)
+		testContext.Request.Header.Set("Accept", "application/x-ndjson")
+
+		testSuite.mockedExportService.EXPECT().ExportRewardRecords(mock.Anything, mock.Anything, mock.Anything).
+			Return(exception.InvalidExportError).Times(1)
+
+		testSuite.rewardController.ExportRewardRecords(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testResponseWriter.Code)
+	})
+}
+
+func TestGetRewardProjectionOfUser(t *testing.T) {
+	testSuite := &rewardControllerTestSuite{}
+
+	t.Run("GetRewardProjectionOfUser", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/reward-projection?user_address=test_user_id", nil)
+
+		testSuite.mockedPeriodStatsService.EXPECT().GetCurrentProjections("test_user_id", mock.Anything).Return([]*model.RewardProjection{
+			{
+				CampaignID:      1,
+				Budget:          10000,
+				UserVolume:      1000,
+				Onboarded:       true,
+				Totals:          model.PeriodTotals{Volume: 5000, EligibleVolume: 4000},
+				Share:           0.25,
+				ProjectedPoints: 2500,
+			},
+		}, nil).Times(1)
+
+		testSuite.rewardController.GetRewardProjectionOfUser(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var projectionsFromRes response.RewardProjectionCollection
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &projectionsFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(projectionsFromRes))
+		assert.Equal(t, 5000.0, projectionsFromRes[0].PoolVolume)
+		assert.Equal(t, 2500.0, projectionsFromRes[0].ProjectedPoints)
+	})
+
+	t.Run("GetRewardProjectionOfUser without user", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/reward-projection", nil)
+
+		testSuite.rewardController.GetRewardProjectionOfUser(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
@@ -0,0 +1,141 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"strconv"
+	"sync"
+	"trading-ace/src/exception"
+	"trading-ace/src/request"
+	"trading-ace/src/response"
+	"trading-ace/src/scheduler"
+	"trading-ace/src/service"
+)
+
+type SettlementController interface {
+	PreviewSettlement(c *gin.Context)
+	ExecuteSettlement(c *gin.Context)
+}
+
+type settlementController struct {
+	settlementService service.SettlementService
+	locker            scheduler.Locker
+}
+
+var (
+	settlementControllerInstance *settlementController
+	settlementControllerOnce     sync.Once
+)
+
+func GetSettlementControllerInstance() SettlementController {
+	settlementControllerOnce.Do(func() {
+		settlementControllerInstance = &settlementController{
+			settlementService: service.NewSettlementService(),
+			locker:            scheduler.NewPostgresAdvisoryLocker(),
+		}
+	})
+	return settlementControllerInstance
+}
+
+// PreviewSettlement is a dry run of the period settlement, nobody is rewarded.
+func (sc *settlementController) PreviewSettlement(c *gin.Context) {
+	campaignID, periodIndex, ok := bindCampaignPeriod(c)
+	if !ok {
+		return
+	}
+
+	preview, err := sc.settlementService.PreviewSettlement(campaignID, periodIndex)
+	if err != nil {
+		respondSettlementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewSettlementPreview(preview))
+}
+
+// ExecuteSettlement settles the period now. It takes the same lock as the
+// scheduled sweep and fails fast instead of waiting when the sweep is running.
+func (sc *settlementController) ExecuteSettlement(c *gin.Context) {
+	campaignID, periodIndex, ok := bindCampaignPeriod(c)
+	if !ok {
+		return
+	}
+
+	var body request.ExecuteSettlementRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	operator := c.GetString(AdminOperatorKey)
+	if operator == "" {
+		operator = body.Operator
+	}
+
+	if operator == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "operator is required"})
+		return
+	}
+
+	lock, err := sc.locker.TryLock(c.Request.Context(), scheduler.SettlementLockKey)
+	if errors.Is(err, scheduler.ErrLockHeld) {
+		c.JSON(http.StatusConflict, gin.H{"exception": "another settlement is in progress"})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-lock.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	settlement, err := sc.settlementService.ExecuteSettlement(ctx, campaignID, periodIndex, operator)
+	if unlockErr := lock.Unlock(); err == nil && unlockErr != nil {
+		err = unlockErr
+	}
+
+	if err != nil {
+		respondSettlementError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, response.NewSettlement(settlement))
+}
+
+func bindCampaignPeriod(c *gin.Context) (int, int, bool) {
+	campaignID, ok := bindCampaignID(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	periodIndex, err := strconv.Atoi(c.Param("period"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": "invalid period index"})
+		return 0, 0, false
+	}
+
+	return campaignID, periodIndex, true
+}
+
+func respondSettlementError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, exception.SettlementAlreadyExistsError):
+		c.JSON(http.StatusConflict, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.InvalidSettlementError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	default:
+		respondCampaignError(c, err)
+	}
+}
@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+	"trading-ace/src/scheduler"
+)
+
+type fakeLock struct {
+	lost     chan struct{}
+	unlocked bool
+}
+
+func (l *fakeLock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *fakeLock) Unlock() error {
+	l.unlocked = true
+	return nil
+}
+
+type fakeLocker struct {
+	lock *fakeLock
+	err  error
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, _ string) (scheduler.Lock, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+	return l.lock, nil
+}
+
+type settlementControllerTestSuite struct {
+	settlementController    SettlementController
+	mockedSettlementService *service.MockSettlementService
+	locker                  *fakeLocker
+}
+
+func (s *settlementControllerTestSuite) setUp(t *testing.T) {
+	s.mockedSettlementService = service.NewMockSettlementService(t)
+	s.locker = &fakeLocker{lock: &fakeLock{lost: make(chan struct{})}}
+	s.settlementController = &settlementController{
+		settlementService: s.mockedSettlementService,
+		locker:            s.locker,
+	}
+}
+
+func TestSettlementController(t *testing.T) {
+	testSuite := &settlementControllerTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	periodParams := gin.Params{{Key: "id", Value: "1"}, {Key: "period", Value: "0"}}
+	executeBody := func() *bytes.Buffer {
+		return bytes.NewBufferString(`{"operator": "ops@example.com", "confirm": true}`)
+	}
+
+	t.Run("PreviewSettlement", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/campaigns/1/periods/0/settlement", nil)
+
+		testSuite.mockedSettlementService.EXPECT().PreviewSettlement(1, 0).Return(&model.SettlementPreview{
+			CampaignID: 1,
+			Budget:     10000,
+			Payouts: []*model.SharedPoolPayout{
+				{TaskID: 1, UserID: "test_user_1", SwapAmount: 10, Share: 1, Points: 10000},
+			},
+		}, nil).Times(1)
+
+		testSuite.settlementController.PreviewSettlement(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var previewFromRes response.SettlementPreview
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &previewFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(previewFromRes.Payouts))
+		assert.Equal(t, "test_user_1", previewFromRes.Payouts[0].UserAddress)
+	})
+
+	t.Run("PreviewSettlement with invalid period", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/campaigns/1/periods/0/settlement", nil)
+
+		testSuite.mockedSettlementService.EXPECT().PreviewSettlement(1, 0).Return(nil, exception.InvalidSettlementError).Times(1)
+
+		testSuite.settlementController.PreviewSettlement(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("ExecuteSettlement", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement", executeBody())
+
+		testSuite.mockedSettlementService.EXPECT().ExecuteSettlement(mock.Anything, 1, 0, "ops@example.com").Return(&model.Settlement{
+			ID:         7,
+			CampaignID: 1,
+			StartTime:  startTime,
+			EndTime:    startTime.Add(time.Hour * 24 * 7),
+		}, nil).Times(1)
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+		assert.True(t, testSuite.locker.lock.unlocked)
+	})
+
+	t.Run("ExecuteSettlement by API key", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Set(AdminOperatorKey, "ops-key")
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement",
+			bytes.NewBufferString(`{"confirm": true}`))
+
+		testSuite.mockedSettlementService.EXPECT().ExecuteSettlement(mock.Anything, 1, 0, "ops-key").
+			Return(&model.Settlement{ID: 7, CampaignID: 1, StartTime: startTime, EndTime: startTime.Add(time.Hour * 24 * 7)}, nil).Times(1)
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+	})
+
+	t.Run("ExecuteSettlement without operator", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement",
+			bytes.NewBufferString(`{"confirm": true}`))
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("ExecuteSettlement without confirmation", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement",
+			bytes.NewBufferString(`{"operator": "ops@example.com", "confirm": false}`))
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("ExecuteSettlement while sweep is running", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.locker.err = scheduler.ErrLockHeld
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement", executeBody())
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+	})
+
+	t.Run("ExecuteSettlement already settled", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = periodParams
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/admin/campaigns/1/periods/0/settlement", executeBody())
+
+		testSuite.mockedSettlementService.EXPECT().ExecuteSettlement(mock.Anything, 1, 0, "ops@example.com").
+			Return(nil, exception.SettlementAlreadyExistsError).Times(1)
+
+		testSuite.settlementController.ExecuteSettlement(testContext)
+
+		assert.Equal(t, http.StatusConflict, testContext.Writer.Status())
+		assert.True(t, testSuite.locker.lock.unlocked)
+	})
+}
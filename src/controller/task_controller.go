@@ -1,10 +1,17 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"github.com/gin-gonic/gin"
+	"io"
+	"log"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
 	"trading-ace/src/repository"
 	"trading-ace/src/request"
 	"trading-ace/src/response"
@@ -13,13 +20,18 @@ import (
 
 type TaskController interface {
 	SearchTasks(c *gin.Context)
+	ExportTasks(c *gin.Context)
 }
 
 type taskController struct {
 	taskService   service.TaskService
 	rewardService service.RewardService
+	exportService service.ExportService
 }
 
+// defaultTaskSearchWindow is the time range searched when the query has no start time.
+const defaultTaskSearchWindow = 30 * 24 * time.Hour
+
 var (
 	taskControllerInstance *taskController
 	taskControllerOnce     sync.Once
@@ -30,53 +42,209 @@ func GetTaskControllerInstance() TaskController {
 		taskControllerInstance = &taskController{
 			taskService:   service.NewTaskService(),
 			rewardService: service.NewRewardService(),
+			exportService: service.NewExportService(),
 		}
 	})
 	return taskControllerInstance
 }
 
 func (t *taskController) SearchTasks(c *gin.Context) {
-	var query request.GetRewordHistoryRequest
+	var query request.GetTaskRequest
 	if err := c.ShouldBindQuery(&query); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
 		return
 	}
 
-	startTime, err := time.Parse(time.RFC3339, query.StartTime)
-	endTime, err := time.Parse(time.RFC3339, query.EndTime)
+	// users export their own tasks, campaign wide exports are served by the
+	// admin API
+	if format, ok := negotiateExportFormat(c); ok {
+		if query.User == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"exception": "user_address is required to export tasks"})
+			return
+		}
+
+		t.exportTasks(c, &query, format)
+		return
+	}
 
+	condition, err := newSearchTasksCondition(&query, time.Now().UTC())
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
 		return
 	}
 
-	tasks, err := t.taskService.SearchTasks(&repository.SearchTasksCondition{
-		UserID:    query.User,
-		StartTime: startTime,
-		EndTime:   endTime,
-	})
+	page := condition.Page
+	tasks, err := t.taskService.SearchTasks(condition)
+
+	if errors.Is(err, exception.InvalidPageError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
 
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
 		return
 	}
 
-	var tasksRes response.TaskCollection
+	tasksRes := make(response.TaskCollection, 0, len(*tasks))
+	taskIDs := make([]int, 0, len(*tasks))
 	for _, task := range *tasks {
-		distributedPoint := 0.0
-		rewardRecord, _ := t.rewardService.GetRewardHistoryByTaskID(task.ID)
+		taskIDs = append(taskIDs, task.ID)
+	}
+
+	// Points are private, only the tasks of a single user, whose session was
+	// checked by RequireQueriedAddressOwner, carry them.
+	withPoints := query.User != ""
 
-		if rewardRecord != nil {
-			distributedPoint = rewardRecord.Points
+	var rewardRecords map[int]*model.RewardRecord
+	if withPoints && len(taskIDs) > 0 {
+		rewardRecords, err = t.rewardService.GetRewardHistoryByTaskIDs(taskIDs)
+		if err != nil {
+			log.Printf("Failed to load reward records of tasks: %v", err)
 		}
+	}
 
-		taskRes := response.NewTask(task, distributedPoint)
+	for _, task := range *tasks {
+		var distributedPoints *float64
+
+		if withPoints {
+			points := 0.0
+			if rewardRecord := rewardRecords[task.ID]; rewardRecord != nil {
+				points = rewardRecord.Points
+			}
+			distributedPoints = &points
+		}
+
+		taskRes := response.NewTask(task, distributedPoints)
 		tasksRes = append(tasksRes, taskRes)
 	}
 
-	if tasksRes == nil {
-		tasksRes = response.TaskCollection{}
+	c.JSON(http.StatusOK, response.NewCursorPage(tasksRes, repository.NextTasksCursor(*tasks, page)))
+}
+
+// newSearchTasksCondition validates the filters of the query. Without a time
+// range the last defaultTaskSearchWindow up to now is searched, a missing
+// bound is derived from the other one.
+func newSearchTasksCondition(query *request.GetTaskRequest, now time.Time) (*repository.SearchTasksCondition, error) {
+	types, statuses, err := parseTaskFilters(query)
+	if err != nil {
+		return nil, err
+	}
+
+	condition := &repository.SearchTasksCondition{
+		UserID:     query.User,
+		CampaignID: query.CampaignID,
+		Types:      types,
+		Statuses:   statuses,
+		Page:       newPage(query.PageRequest),
+	}
+
+	condition.EndTime = now
+	if query.EndTime != "" {
+		if condition.EndTime, err = time.Parse(time.RFC3339, query.EndTime); err != nil {
+			return nil, err
+		}
+	}
+
+	condition.StartTime = condition.EndTime.Add(-defaultTaskSearchWindow)
+	if query.StartTime != "" {
+		if condition.StartTime, err = time.Parse(time.RFC3339, query.StartTime); err != nil {
+			return nil, err
+		}
+	}
+
+	if condition.StartTime.After(condition.EndTime) {
+		return nil, fmt.Errorf("start time should be before end time")
 	}
 
-	c.JSON(http.StatusOK, &tasksRes)
+	return condition, nil
+}
+
+// ExportTasks streams the tasks of any user or of a whole campaign, as CSV
+// unless NDJSON is accepted, so it's only served to the admin API.
+func (t *taskController) ExportTasks(c *gin.Context) {
+	var query request.GetTaskRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	format, ok := negotiateExportFormat(c)
+	if !ok {
+		format = model.ExportFormatCSV
+	}
+
+	t.exportTasks(c, &query, format)
+}
+
+// exportTasks streams every task matching the query, without pagination nor
+// default time range.
+func (t *taskController) exportTasks(c *gin.Context, query *request.GetTaskRequest, format model.ExportFormat) {
+	types, statuses, err := parseTaskFilters(query)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	startTime, err := parseOptionalTime(query.StartTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	endTime, err := parseOptionalTime(query.EndTime)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	exportQuery := &model.ExportQuery{
+		Format:      format,
+		UserID:      query.User,
+		CampaignID:  query.CampaignID,
+		PeriodIndex: query.Period,
+		Types:       types,
+		Statuses:    statuses,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+
+	streamExport(c, "tasks", format, func(w io.Writer) error {
+		return t.exportService.ExportTasks(c.Request.Context(), exportQuery, w)
+	})
+}
+
+func parseTaskFilters(query *request.GetTaskRequest) ([]model.TaskType, []model.TaskStatus, error) {
+	var types []model.TaskType
+	for _, value := range splitQueryValues(query.Type) {
+		taskType := model.TaskType(value)
+		if !taskType.IsValid() {
+			return nil, nil, fmt.Errorf("invalid task type %q", value)
+		}
+		types = append(types, taskType)
+	}
+
+	var statuses []model.TaskStatus
+	for _, value := range splitQueryValues(query.Status) {
+		status := model.TaskStatus(value)
+		if !status.IsValid() {
+			return nil, nil, fmt.Errorf("invalid task status %q", value)
+		}
+		statuses = append(statuses, status)
+	}
+
+	return types, statuses, nil
+}
+
+// splitQueryValues accepts both repeated and comma separated query values.
+func splitQueryValues(values []string) []string {
+	var result []string
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				result = append(result, part)
+			}
+		}
+	}
+	return result
 }
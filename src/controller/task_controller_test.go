@@ -1,17 +1,22 @@
 package controller
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 	"time"
 	"trading-ace/mock/service"
+	"trading-ace/src/exception"
 	"trading-ace/src/model"
 	"trading-ace/src/repository"
+	"trading-ace/src/request"
 	"trading-ace/src/response"
 )
 
@@ -19,14 +24,17 @@ type taskControllerTestSuite struct {
 	taskController      TaskController
 	mockedTaskService   *service.MockTaskService
 	mockedRewardService *service.MockRewardService
+	mockedExportService *service.MockExportService
 }
 
 func (s *taskControllerTestSuite) setUp(t *testing.T) {
 	s.mockedTaskService = service.NewMockTaskService(t)
 	s.mockedRewardService = service.NewMockRewardService(t)
+	s.mockedExportService = service.NewMockExportService(t)
 	s.taskController = &taskController{
 		taskService:   s.mockedTaskService,
 		rewardService: s.mockedRewardService,
+		exportService: s.mockedExportService,
 	}
 }
 
@@ -82,6 +90,8 @@ func TestGetTasksOfUser(t *testing.T) {
 		},
 	}
 
+	points := func(points float64) *float64 { return &points }
+
 	t.Run("SearchTasks", func(t *testing.T) {
 		testSuite.setUp(t)
 
@@ -105,20 +115,19 @@ func TestGetTasksOfUser(t *testing.T) {
 				UserID:    "test_user_id",
 				StartTime: startTime,
 				EndTime:   endTime,
+				Page:      &repository.Page{Limit: defaultPageLimit},
 			}).
 			Return(&tasks, nil)
 
-		for taskID, record := range rewardMap {
-			testSuite.mockedRewardService.EXPECT().
-				GetRewardHistoryByTaskID(taskID).
-				Return(record, nil)
-		}
+		testSuite.mockedRewardService.EXPECT().
+			GetRewardHistoryByTaskIDs([]int{1, 2, 3}).
+			Return(rewardMap, nil).Times(1)
 
 		testSuite.taskController.SearchTasks(testContext)
 
 		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
 
-		var tasksFromRes response.TaskCollection
+		var tasksFromRes response.CursorPage[*response.Task]
 		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &tasksFromRes)
 
 		expectedRes := response.TaskCollection{
@@ -127,7 +136,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeSharedPool),
 				User:              "test_user_id",
 				SwapAmount:        10.0,
-				DistributedPoints: 10.0,
+				DistributedPoints: points(10.0),
 				CreatedAt:         tasks[0].CreatedAt,
 			},
 			{
@@ -135,7 +144,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeOnboarding),
 				User:              "test_user_id",
 				SwapAmount:        10000.0,
-				DistributedPoints: 100.0,
+				DistributedPoints: points(100.0),
 				CreatedAt:         tasks[1].CreatedAt,
 			},
 			{
@@ -143,15 +152,16 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeSharedPool),
 				User:              "test_user_id",
 				SwapAmount:        10.0,
-				DistributedPoints: 55.0,
+				DistributedPoints: points(55.0),
 				CreatedAt:         tasks[2].CreatedAt,
 			},
 		}
 		assert.Nil(t, err)
-		assert.Equal(t, expectedRes, tasksFromRes)
+		assert.Equal(t, expectedRes, response.TaskCollection(tasksFromRes.Data))
+		assert.Nil(t, tasksFromRes.NextCursor)
 	})
 
-	t.Run("Get Reward with error", func(t *testing.T) {
+	t.Run("Task without reward", func(t *testing.T) {
 		testSuite.setUp(t)
 
 		testResponseWriter := httptest.NewRecorder()
@@ -174,26 +184,19 @@ func TestGetTasksOfUser(t *testing.T) {
 				UserID:    "test_user_id",
 				StartTime: startTime,
 				EndTime:   endTime,
+				Page:      &repository.Page{Limit: defaultPageLimit},
 			}).
 			Return(&tasks, nil)
 
-		for taskID, record := range rewardMap {
-			if taskID == 1 {
-				testSuite.mockedRewardService.EXPECT().
-					GetRewardHistoryByTaskID(taskID).
-					Return(nil, assert.AnError)
-			} else {
-				testSuite.mockedRewardService.EXPECT().
-					GetRewardHistoryByTaskID(taskID).
-					Return(record, nil)
-			}
-		}
+		testSuite.mockedRewardService.EXPECT().
+			GetRewardHistoryByTaskIDs([]int{1, 2, 3}).
+			Return(map[int]*model.RewardRecord{2: rewardMap[2], 3: rewardMap[3]}, nil).Times(1)
 
 		testSuite.taskController.SearchTasks(testContext)
 
 		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
 
-		var tasksFromRes response.TaskCollection
+		var tasksFromRes response.CursorPage[*response.Task]
 		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &tasksFromRes)
 
 		expectedRes := response.TaskCollection{
@@ -202,7 +205,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeSharedPool),
 				User:              "test_user_id",
 				SwapAmount:        10.0,
-				DistributedPoints: 0.0,
+				DistributedPoints: points(0.0),
 				CreatedAt:         tasks[0].CreatedAt,
 			},
 			{
@@ -210,7 +213,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeOnboarding),
 				User:              "test_user_id",
 				SwapAmount:        10000.0,
-				DistributedPoints: 100.0,
+				DistributedPoints: points(100.0),
 				CreatedAt:         tasks[1].CreatedAt,
 			},
 			{
@@ -218,12 +221,53 @@ func TestGetTasksOfUser(t *testing.T) {
 				Type:              string(model.TaskTypeSharedPool),
 				User:              "test_user_id",
 				SwapAmount:        10.0,
-				DistributedPoints: 55.0,
+				DistributedPoints: points(55.0),
 				CreatedAt:         tasks[2].CreatedAt,
 			},
 		}
 		assert.Nil(t, err)
-		assert.Equal(t, expectedRes, tasksFromRes)
+		assert.Equal(t, expectedRes, response.TaskCollection(tasksFromRes.Data))
+		assert.Nil(t, tasksFromRes.NextCursor)
+	})
+
+	t.Run("Get Reward with error", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet,
+			"/api/tasks?user_address=test_user_id&start_time=2021-01-01T00:00:00Z&end_time=2021-01-02T00:00:00Z", nil)
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(mock.Anything).Return(&tasks, nil)
+		testSuite.mockedRewardService.EXPECT().GetRewardHistoryByTaskIDs([]int{1, 2, 3}).Return(nil, assert.AnError).Times(1)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var tasksFromRes response.CursorPage[*response.Task]
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &tasksFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(tasksFromRes.Data))
+		for _, task := range tasksFromRes.Data {
+			assert.Equal(t, 0.0, *task.DistributedPoints)
+		}
+	})
+
+	t.Run("Points Are Not Disclosed Without User Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet,
+			"/api/tasks?start_time=2021-01-01T00:00:00Z&end_time=2021-01-02T00:00:00Z", nil)
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(mock.Anything).Return(&tasks, nil)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+		assert.NotContains(t, testResponseWriter.Body.String(), "distributed_points")
 	})
 
 	t.Run("SearchTasks with error", func(t *testing.T) {
@@ -249,6 +293,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				UserID:    "test_user_id",
 				StartTime: startTime,
 				EndTime:   endTime,
+				Page:      &repository.Page{Limit: defaultPageLimit},
 			}).
 			Return(nil, assert.AnError)
 
@@ -286,6 +331,7 @@ func TestGetTasksOfUser(t *testing.T) {
 				UserID:    "test_user_id",
 				StartTime: startTime,
 				EndTime:   endTime,
+				Page:      &repository.Page{Limit: defaultPageLimit},
 			}).
 			Return(&[]*model.Task{}, nil)
 
@@ -293,11 +339,219 @@ func TestGetTasksOfUser(t *testing.T) {
 
 		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
 
-		var tasksFromRes response.TaskCollection
+		var tasksFromRes response.CursorPage[*response.Task]
 		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &tasksFromRes)
 
 		expectedRes := response.TaskCollection{}
 		assert.Nil(t, err)
-		assert.Equal(t, expectedRes, tasksFromRes)
+		assert.Equal(t, expectedRes, response.TaskCollection(tasksFromRes.Data))
+		assert.Nil(t, tasksFromRes.NextCursor)
+	})
+
+	t.Run("Paginate", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(
+			http.MethodGet,
+			"/api/tasks?user_address=test_user_id&start_time=2021-01-01T00:00:00Z&end_time=2021-01-02T00:00:00Z&limit=3&sort_by=swap_amount&order=asc",
+			nil)
+
+		testSuite.mockedTaskService.EXPECT().
+			SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
+				return *condition.Page == repository.Page{Limit: 3, SortBy: "swap_amount", Order: repository.SortOrderAsc}
+			})).
+			Return(&tasks, nil)
+		testSuite.mockedRewardService.EXPECT().GetRewardHistoryByTaskIDs([]int{1, 2, 3}).Return(nil, nil).Times(1)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var tasksFromRes response.CursorPage[*response.Task]
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &tasksFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(tasksFromRes.Data))
+		assert.NotNil(t, tasksFromRes.NextCursor)
+	})
+
+	t.Run("Invalid Cursor", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(
+			http.MethodGet,
+			"/api/tasks?user_address=test_user_id&start_time=2021-01-01T00:00:00Z&end_time=2021-01-02T00:00:00Z&cursor=invalid",
+			nil)
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(mock.Anything).Return(nil, exception.InvalidPageError)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
+
+func TestNewSearchTasksCondition(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Multi Value Filters", func(t *testing.T) {
+		condition, err := newSearchTasksCondition(&request.GetTaskRequest{
+			User:   "test_user_id",
+			Type:   []string{"on_boarding,shared_pool"},
+			Status: []string{"pending", "done"},
+		}, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []model.TaskType{model.TaskTypeOnboarding, model.TaskTypeSharedPool}, condition.Types)
+		assert.Equal(t, []model.TaskStatus{model.TaskStatusPending, model.TaskStatusDone}, condition.Statuses)
+	})
+
+	t.Run("Default Time Range", func(t *testing.T) {
+		condition, err := newSearchTasksCondition(&request.GetTaskRequest{}, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, now, condition.EndTime)
+		assert.Equal(t, now.Add(-defaultTaskSearchWindow), condition.StartTime)
+
+		condition, err = newSearchTasksCondition(&request.GetTaskRequest{EndTime: "2024-09-10T00:00:00Z"}, now)
+
+		assert.Nil(t, err)
+		assert.Equal(t, time.Date(2024, 8, 11, 0, 0, 0, 0, time.UTC), condition.StartTime)
+	})
+
+	t.Run("Invalid Filters", func(t *testing.T) {
+		for _, query := range []*request.GetTaskRequest{
+			{Type: []string{"swap"}},
+			{Status: []string{"pending,cancelled"}},
+			{StartTime: "2024-09-01"},
+			{StartTime: "2024-10-01T00:00:00Z"},
+		} {
+			condition, err := newSearchTasksCondition(query, now)
+			assert.NotNil(t, err)
+			assert.Nil(t, condition)
+		}
+	})
+}
+
+func TestSearchTasksWithFilters(t *testing.T) {
+	testSuite := &taskControllerTestSuite{}
+
+	t.Run("Filter By Type And Status", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet,
+			"/api/tasks?user_address=test_user_id&type=on_boarding&type=shared_pool&status=done", nil)
+
+		testSuite.mockedTaskService.EXPECT().
+			SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
+				return condition.UserID == "test_user_id" &&
+					len(condition.Types) == 2 &&
+					len(condition.Statuses) == 1 && condition.Statuses[0] == model.TaskStatusDone &&
+					condition.EndTime.Sub(condition.StartTime) == defaultTaskSearchWindow
+			})).
+			Return(&[]*model.Task{}, nil)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+	})
+
+	t.Run("Invalid Type", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/tasks?user_address=test_user_id&type=swap", nil)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+}
+
+func TestExportTasks(t *testing.T) {
+	testSuite := &taskControllerTestSuite{}
+
+	t.Run("Stream CSV By Default", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/tasks/export?campaign_id=1&period=2&type=shared_pool", nil)
+
+		periodIndex := 2
+		testSuite.mockedExportService.EXPECT().ExportTasks(mock.Anything, &model.ExportQuery{
+			Format:      model.ExportFormatCSV,
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+			Types:       []model.TaskType{model.TaskTypeSharedPool},
+		}, mock.Anything).RunAndReturn(func(_ context.Context, _ *model.ExportQuery, w io.Writer) error {
+			_, err := io.WriteString(w, "id,campaign_id\n")
+			return err
+		}).Times(1)
+
+		testSuite.taskController.ExportTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "text/csv", testResponseWriter.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=tasks.csv", testResponseWriter.Header().Get("Content-Disposition"))
+		assert.Equal(t, "id,campaign_id\n", testResponseWriter.Body.String())
+	})
+
+	t.Run("Invalid Export", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/admin/tasks/export?period=2", nil)
+		testContext.Request.Header.Set("Accept", "application/x-ndjson")
+
+		testSuite.mockedExportService.EXPECT().ExportTasks(mock.Anything, mock.Anything, mock.Anything).
+			Return(exception.InvalidExportError).Times(1)
+
+		testSuite.taskController.ExportTasks(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testResponseWriter.Code)
+		assert.Contains(t, testResponseWriter.Header().Get("Content-Type"), "application/json")
+	})
+
+	t.Run("Stream Tasks Of the Owner", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/tasks?user_address=test_user_id&status=done", nil)
+		testContext.Request.Header.Set("Accept", "application/x-ndjson")
+
+		testSuite.mockedExportService.EXPECT().ExportTasks(mock.Anything, &model.ExportQuery{
+			Format:   model.ExportFormatNDJSON,
+			UserID:   "test_user_id",
+			Statuses: []model.TaskStatus{model.TaskStatusDone},
+		}, mock.Anything).Return(nil).Times(1)
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "application/x-ndjson", testResponseWriter.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=tasks.ndjson", testResponseWriter.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("Campaign Export Requires the Admin API", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/tasks?campaign_id=1", nil)
+		testContext.Request.Header.Set("Accept", "text/csv")
+
+		testSuite.taskController.SearchTasks(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testResponseWriter.Code)
+		assert.Empty(t, testResponseWriter.Header().Get("Content-Disposition"))
 	})
 }
@@ -57,8 +57,10 @@ func (u *uniSwapEventController) HandleUniSwapV2Event(event *contract.UniSwapV2S
 	}
 
 	task, err := job.NewUniSwapTransactionTask(&job.UniSwapTransactionPayload{
-		SenderID:   senderID,
-		SwapAmount: swapAmountFloat,
+		SwapID:      event.ID(),
+		SenderID:    senderID,
+		PoolAddress: event.Pool.String(),
+		SwapAmount:  swapAmountFloat,
 	})
 
 	if err != nil {
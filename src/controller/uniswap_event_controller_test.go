@@ -28,6 +28,8 @@ func TestHandleUniSwapV2Event(t *testing.T) {
 
 	testSender := "0x0000000000000000000000000000001234567890"
 	testReiciver := "0x00000000000000000000000000000056767890"
+	testPool := "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"
+	testTxHash := "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060"
 
 	t.Run("HandleUniSwapV2Event - USDC to WETH", func(t *testing.T) {
 		testSuite.setUp(t)
@@ -36,13 +38,18 @@ func TestHandleUniSwapV2Event(t *testing.T) {
 			Amount0Out: big.NewInt(123456),
 			Amount1In:  big.NewInt(1234567890),
 			Amount1Out: big.NewInt(0),
+			Pool:       common.HexToAddress(testPool),
 			Sender:     common.HexToAddress(testSender),
 			To:         common.HexToAddress(testReiciver),
+			TxHash:     common.HexToHash(testTxHash),
+			LogIndex:   3,
 		}
 
 		createdTask, err := realJob.NewUniSwapTransactionTask(&realJob.UniSwapTransactionPayload{
-			SenderID:   testSender,
-			SwapAmount: 0.123456,
+			SwapID:      testTxHash + ":3",
+			SenderID:    testSender,
+			PoolAddress: testPool,
+			SwapAmount:  0.123456,
 		})
 		assert.Nil(t, err)
 
@@ -60,13 +67,18 @@ func TestHandleUniSwapV2Event(t *testing.T) {
 			Amount0Out: big.NewInt(0),
 			Amount1In:  big.NewInt(0),
 			Amount1Out: big.NewInt(1234567890),
+			Pool:       common.HexToAddress(testPool),
 			Sender:     common.HexToAddress(testSender),
 			To:         common.HexToAddress(testReiciver),
+			TxHash:     common.HexToHash(testTxHash),
+			LogIndex:   3,
 		}
 
 		createdTask, err := realJob.NewUniSwapTransactionTask(&realJob.UniSwapTransactionPayload{
-			SenderID:   testSender,
-			SwapAmount: 0.123456,
+			SwapID:      testTxHash + ":3",
+			SenderID:    testSender,
+			PoolAddress: testPool,
+			SwapAmount:  0.123456,
 		})
 		assert.Nil(t, err)
 
@@ -89,13 +101,18 @@ func TestHandleUniSwapV2Event(t *testing.T) {
 			Amount0Out: big.NewInt(0),
 			Amount1In:  big.NewInt(0),
 			Amount1Out: big.NewInt(1234567890),
+			Pool:       common.HexToAddress(testPool),
 			Sender:     common.HexToAddress(testSender),
 			To:         common.HexToAddress(testReiciver),
+			TxHash:     common.HexToHash(testTxHash),
+			LogIndex:   3,
 		}
 
 		createdTask, err := realJob.NewUniSwapTransactionTask(&realJob.UniSwapTransactionPayload{
-			SenderID:   testSender,
-			SwapAmount: 0.123456,
+			SwapID:      testTxHash + ":3",
+			SenderID:    testSender,
+			PoolAddress: testPool,
+			SwapAmount:  0.123456,
 		})
 		assert.Nil(t, err)
 
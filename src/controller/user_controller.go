@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type UserController interface {
+	GetUserProfile(c *gin.Context)
+	GetExpiringPoints(c *gin.Context)
+	GetTierHistory(c *gin.Context)
+}
+
+type userController struct {
+	userProfileService service.UserProfileService
+	expiryService      service.ExpiryService
+	tierService        service.TierService
+}
+
+var (
+	userControllerInstance *userController
+	userControllerOnce     sync.Once
+)
+
+func GetUserControllerInstance() UserController {
+	userControllerOnce.Do(func() {
+		userControllerInstance = &userController{
+			userProfileService: service.NewUserProfileService(),
+			expiryService:      service.NewExpiryService(),
+			tierService:        service.NewTierService(),
+		}
+	})
+	return userControllerInstance
+}
+
+func (u *userController) GetUserProfile(c *gin.Context) {
+	profile, err := u.userProfileService.GetUserProfile(c.Param("address"), time.Now().UTC())
+
+	if errors.Is(err, exception.UserNotFoundError) {
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewUserProfile(profile))
+}
+
+// GetExpiringPoints returns the points of the user expiring soon, soonest first.
+func (u *userController) GetExpiringPoints(c *gin.Context) {
+	now := time.Now().UTC()
+	expiring, err := u.expiryService.GetExpiringPoints(c.Param("address"), now)
+
+	if errors.Is(err, exception.InvalidAddressError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewExpiringPoints(expiring))
+}
+
+// GetTierHistory returns the tier changes of the user, latest first.
+func (u *userController) GetTierHistory(c *gin.Context) {
+	history, err := u.tierService.GetTierHistory(c.Param("address"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewTierHistory(c.Param("address"), history))
+}
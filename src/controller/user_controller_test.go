@@ -0,0 +1,145 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type userControllerTestSuite struct {
+	userController           UserController
+	mockedUserProfileService *service.MockUserProfileService
+	mockedExpiryService      *service.MockExpiryService
+	mockedTierService        *service.MockTierService
+}
+
+func (s *userControllerTestSuite) setUp(t *testing.T) {
+	s.mockedUserProfileService = service.NewMockUserProfileService(t)
+	s.mockedExpiryService = service.NewMockExpiryService(t)
+	s.mockedTierService = service.NewMockTierService(t)
+	s.userController = &userController{
+		userProfileService: s.mockedUserProfileService,
+		expiryService:      s.mockedExpiryService,
+		tierService:        s.mockedTierService,
+	}
+}
+
+func TestUserController(t *testing.T) {
+	testSuite := &userControllerTestSuite{}
+
+	t.Run("GetUserProfile", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/users/test_user_id", nil)
+
+		testSuite.mockedUserProfileService.EXPECT().GetUserProfile("test_user_id", mock.Anything).Return(&model.UserProfile{
+			User: &model.User{ID: "test_user_id", Points: 260, Tier: "silver"},
+			Campaigns: []*model.UserCampaignProfile{
+				{CampaignID: 1, Onboarded: true, LifetimeVolume: 3000, SwapCount: 3, Rank: 4},
+			},
+		}, nil).Times(1)
+
+		testSuite.userController.GetUserProfile(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var profileFromRes response.UserProfile
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &profileFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 260.0, profileFromRes.TotalPoints)
+		assert.Equal(t, "silver", profileFromRes.Tier)
+		assert.Equal(t, 4, profileFromRes.Campaigns[0].Rank)
+	})
+
+	t.Run("GetUserProfile of unknown user", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "unknown"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/users/unknown", nil)
+
+		testSuite.mockedUserProfileService.EXPECT().GetUserProfile("unknown", mock.Anything).Return(nil, exception.UserNotFoundError).Times(1)
+
+		testSuite.userController.GetUserProfile(testContext)
+
+		assert.Equal(t, http.StatusNotFound, testContext.Writer.Status())
+	})
+
+	t.Run("GetExpiringPoints", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/users/test_user_id/expiring-points", nil)
+
+		expiresAt := time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second)
+		testSuite.mockedExpiryService.EXPECT().GetExpiringPoints("test_user_id", mock.Anything).Return([]*model.ExpiringPoints{
+			{Points: 30, ExpiresAt: expiresAt},
+			{Points: 20, ExpiresAt: expiresAt.Add(time.Hour)},
+		}, nil).Times(1)
+
+		testSuite.userController.GetExpiringPoints(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var expiringFromRes response.ExpiringPoints
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &expiringFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 50.0, expiringFromRes.Total)
+		assert.Equal(t, 2, len(expiringFromRes.Expiring))
+	})
+
+	t.Run("GetExpiringPoints with Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/users/test_user_id/expiring-points", nil)
+
+		testSuite.mockedExpiryService.EXPECT().GetExpiringPoints("test_user_id", mock.Anything).
+			Return(nil, exception.InvalidAddressError).Times(1)
+
+		testSuite.userController.GetExpiringPoints(testContext)
+
+		assert.Equal(t, http.StatusBadRequest, testContext.Writer.Status())
+	})
+
+	t.Run("GetTierHistory", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: "test_user_id"}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/users/test_user_id/tiers", nil)
+
+		testSuite.mockedTierService.EXPECT().GetTierHistory("test_user_id").Return([]*model.TierChange{
+			{ID: 2, UserID: "test_user_id", FromTier: "bronze", ToTier: "silver", RollingVolume: 12000},
+			{ID: 1, UserID: "test_user_id", ToTier: "bronze", RollingVolume: 500},
+		}, nil).Times(1)
+
+		testSuite.userController.GetTierHistory(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var historyFromRes response.TierHistory
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &historyFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(historyFromRes.History))
+		assert.Equal(t, "silver", historyFromRes.History[0].ToTier)
+	})
+}
@@ -0,0 +1,72 @@
+package controller
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"sync"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/response"
+	"trading-ace/src/service"
+)
+
+type VoucherController interface {
+	IssueVoucher(c *gin.Context)
+	GetVouchersOfAddress(c *gin.Context)
+}
+
+type voucherController struct {
+	voucherService service.VoucherService
+}
+
+var (
+	voucherControllerInstance *voucherController
+	voucherControllerOnce     sync.Once
+)
+
+func GetVoucherControllerInstance() VoucherController {
+	voucherControllerOnce.Do(func() {
+		voucherControllerInstance = &voucherController{
+			voucherService: service.NewVoucherService(),
+		}
+	})
+	return voucherControllerInstance
+}
+
+// IssueVoucher signs a new EIP-712 voucher of the claimable points of the address.
+func (vc *voucherController) IssueVoucher(c *gin.Context) {
+	voucher, err := vc.voucherService.IssueVoucher(c.Param("address"), time.Now().UTC())
+
+	switch {
+	case errors.Is(err, exception.InvalidAddressError):
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.UserNotFoundError):
+		c.JSON(http.StatusNotFound, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.NothingToClaimError):
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"exception": err.Error()})
+	case errors.Is(err, exception.VoucherSignerNotConfiguredError), errors.Is(err, exception.VouchersDisabledError):
+		c.JSON(http.StatusServiceUnavailable, gin.H{"exception": err.Error()})
+	case err != nil:
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+	default:
+		c.JSON(http.StatusCreated, response.NewVoucher(voucher))
+	}
+}
+
+// GetVouchersOfAddress returns the vouchers issued to the address, latest first.
+func (vc *voucherController) GetVouchersOfAddress(c *gin.Context) {
+	vouchers, err := vc.voucherService.GetVouchers(c.Param("address"))
+
+	if errors.Is(err, exception.InvalidAddressError) {
+		c.JSON(http.StatusBadRequest, gin.H{"exception": err.Error()})
+		return
+	}
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"exception": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, response.NewVoucherCollection(vouchers))
+}
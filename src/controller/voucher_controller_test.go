@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"encoding/json"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/response"
+)
+
+type voucherControllerTestSuite struct {
+	voucherController    VoucherController
+	mockedVoucherService *service.MockVoucherService
+}
+
+func (s *voucherControllerTestSuite) setUp(t *testing.T) {
+	s.mockedVoucherService = service.NewMockVoucherService(t)
+	s.voucherController = &voucherController{
+		voucherService: s.mockedVoucherService,
+	}
+}
+
+func TestVoucherController(t *testing.T) {
+	testSuite := &voucherControllerTestSuite{}
+	address := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+	t.Run("IssueVoucher", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodPost, "/api/vouchers/"+address, nil)
+
+		testSuite.mockedVoucherService.EXPECT().IssueVoucher(address, mock.Anything).Return(&model.Voucher{
+			Account:   address,
+			Nonce:     2,
+			Amount:    "1000000000000000000",
+			Expiry:    time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC),
+			Signature: "0xsignature",
+			Domain:    &model.VoucherDomain{Name: "TradingAce", Version: "1", ChainID: 1},
+		}, nil).Times(1)
+
+		testSuite.voucherController.IssueVoucher(testContext)
+
+		assert.Equal(t, http.StatusCreated, testContext.Writer.Status())
+
+		var voucherFromRes response.Voucher
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &voucherFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), voucherFromRes.Nonce)
+		assert.Equal(t, int64(1725235200), voucherFromRes.Expiry)
+		assert.Equal(t, int64(1), voucherFromRes.Domain.ChainID)
+	})
+
+	for _, testCase := range []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"Invalid Address", exception.InvalidAddressError, http.StatusBadRequest},
+		{"Unknown User", exception.UserNotFoundError, http.StatusNotFound},
+		{"Nothing To Claim", exception.NothingToClaimError, http.StatusUnprocessableEntity},
+		{"Signer Not Configured", exception.VoucherSignerNotConfiguredError, http.StatusServiceUnavailable},
+		{"Vouchers Disabled", exception.VouchersDisabledError, http.StatusServiceUnavailable},
+	} {
+		t.Run("IssueVoucher with "+testCase.name, func(t *testing.T) {
+			testSuite.setUp(t)
+
+			testResponseWriter := httptest.NewRecorder()
+			testContext, _ := gin.CreateTestContext(testResponseWriter)
+			testContext.Params = gin.Params{{Key: "address", Value: address}}
+			testContext.Request = httptest.NewRequest(http.MethodPost, "/api/vouchers/"+address, nil)
+
+			testSuite.mockedVoucherService.EXPECT().IssueVoucher(address, mock.Anything).Return(nil, testCase.err).Times(1)
+
+			testSuite.voucherController.IssueVoucher(testContext)
+
+			assert.Equal(t, testCase.status, testContext.Writer.Status())
+		})
+	}
+
+	t.Run("GetVouchersOfAddress", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testResponseWriter := httptest.NewRecorder()
+		testContext, _ := gin.CreateTestContext(testResponseWriter)
+		testContext.Params = gin.Params{{Key: "address", Value: address}}
+		testContext.Request = httptest.NewRequest(http.MethodGet, "/api/vouchers/"+address, nil)
+
+		testSuite.mockedVoucherService.EXPECT().GetVouchers(address).Return([]*model.Voucher{
+			{Account: address, Nonce: 1}, {Account: address, Nonce: 0},
+		}, nil).Times(1)
+
+		testSuite.voucherController.GetVouchersOfAddress(testContext)
+
+		assert.Equal(t, http.StatusOK, testContext.Writer.Status())
+
+		var vouchersFromRes []*response.Voucher
+		err := json.Unmarshal(testResponseWriter.Body.Bytes(), &vouchersFromRes)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(vouchersFromRes))
+		assert.Nil(t, vouchersFromRes[0].Domain)
+	})
+}
@@ -0,0 +1,5 @@
+package exception
+
+import "errors"
+
+var InvalidAdjustmentError = errors.New("invalid adjustment")
@@ -0,0 +1,7 @@
+package exception
+
+import "errors"
+
+var APIKeyNotFoundError = errors.New("api key not found")
+
+var InvalidAPIKeyError = errors.New("invalid api key")
@@ -0,0 +1,9 @@
+package exception
+
+import "errors"
+
+var InvalidNonceError = errors.New("invalid or expired nonce")
+
+var AuthenticationError = errors.New("authentication failed")
+
+var UnauthenticatedError = errors.New("missing or invalid session token")
@@ -0,0 +1,7 @@
+package exception
+
+import "errors"
+
+var CampaignNotFoundError = errors.New("campaign not found")
+
+var InvalidCampaignError = errors.New("invalid campaign")
@@ -0,0 +1,9 @@
+package exception
+
+import "errors"
+
+var DistributionAlreadyExistsError = errors.New("distribution already exists")
+
+var MerkleClaimsDisabledError = errors.New("merkle claims are disabled")
+
+var InvalidAddressError = errors.New("invalid address")
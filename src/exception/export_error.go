@@ -0,0 +1,5 @@
+package exception
+
+import "errors"
+
+var InvalidExportError = errors.New("invalid export")
@@ -0,0 +1,7 @@
+package exception
+
+import "errors"
+
+var InsufficientPointsError = errors.New("insufficient points")
+
+var UnbalancedLedgerTransactionError = errors.New("unbalanced ledger transaction")
@@ -0,0 +1,7 @@
+package exception
+
+import "errors"
+
+var MultiplierNotFoundError = errors.New("multiplier not found")
+
+var InvalidMultiplierError = errors.New("invalid multiplier")
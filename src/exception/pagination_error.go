@@ -0,0 +1,5 @@
+package exception
+
+import "errors"
+
+var InvalidPageError = errors.New("invalid page")
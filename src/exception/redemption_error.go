@@ -0,0 +1,9 @@
+package exception
+
+import "errors"
+
+var RedemptionNotFoundError = errors.New("redemption not found")
+
+var InvalidRedemptionError = errors.New("invalid redemption")
+
+var RedemptionNotPendingError = errors.New("redemption is not pending")
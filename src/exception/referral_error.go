@@ -0,0 +1,13 @@
+package exception
+
+import "errors"
+
+var ReferralCodeNotFoundError = errors.New("referral code not found")
+
+var InvalidReferralCodeError = errors.New("invalid referral code")
+
+var ReferralCodeAlreadyExistsError = errors.New("referral code already exists")
+
+var InvalidReferralError = errors.New("invalid referral")
+
+var ReferralAlreadyLinkedError = errors.New("address already linked to a referral code")
@@ -0,0 +1,5 @@
+package exception
+
+import "errors"
+
+var TaskAlreadyRewardedError = errors.New("task already rewarded")
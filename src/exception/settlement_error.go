@@ -0,0 +1,7 @@
+package exception
+
+import "errors"
+
+var SettlementAlreadyExistsError = errors.New("settlement already exists")
+
+var InvalidSettlementError = errors.New("invalid settlement")
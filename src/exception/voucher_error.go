@@ -0,0 +1,11 @@
+package exception
+
+import "errors"
+
+var VoucherSignerNotConfiguredError = errors.New("voucher signer is not configured")
+
+var VouchersDisabledError = errors.New("vouchers are disabled")
+
+var NothingToClaimError = errors.New("nothing to claim")
+
+var VoucherNonceAlreadyUsedError = errors.New("voucher nonce already used")
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/service"
+)
+
+// runExport implements the `export` command, which streams tasks or reward
+// records as CSV or NDJSON to a file or stdout:
+//
+//	main export -resource rewards -campaign 1 -period 0 -format csv -out rewards.csv
+func runExport(args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	resource := flags.String("resource", "rewards", "rows to export: rewards or tasks")
+	format := flags.String("format", string(model.ExportFormatCSV), "csv or ndjson")
+	user := flags.String("user", "", "user address")
+	campaignID := flags.Int("campaign", 0, "campaign ID")
+	period := flags.Int("period", -1, "period index of the campaign, -1 for the whole campaign")
+	taskTypes := flags.String("type", "", "comma separated task types, tasks only")
+	taskStatuses := flags.String("status", "", "comma separated task statuses, tasks only")
+	startTime := flags.String("start", "", "start time, RFC3339")
+	endTime := flags.String("end", "", "end time, RFC3339")
+	out := flags.String("out", "", "output file, stdout when empty")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	query := &model.ExportQuery{
+		Format:     model.ExportFormat(*format),
+		UserID:     *user,
+		CampaignID: *campaignID,
+	}
+
+	if *period >= 0 {
+		query.PeriodIndex = period
+	}
+
+	for _, value := range splitFlagValues(*taskTypes) {
+		query.Types = append(query.Types, model.TaskType(value))
+	}
+
+	for _, value := range splitFlagValues(*taskStatuses) {
+		query.Statuses = append(query.Statuses, model.TaskStatus(value))
+	}
+
+	var err error
+	if *startTime != "" {
+		if query.StartTime, err = time.Parse(time.RFC3339, *startTime); err != nil {
+			return err
+		}
+	}
+
+	if *endTime != "" {
+		if query.EndTime, err = time.Parse(time.RFC3339, *endTime); err != nil {
+			return err
+		}
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+
+	buffered := bufio.NewWriter(w)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	exportService := service.NewExportService()
+	switch *resource {
+	case "rewards":
+		err = exportService.ExportRewardRecords(ctx, query, buffered)
+	case "tasks":
+		err = exportService.ExportTasks(ctx, query, buffered)
+	default:
+		return fmt.Errorf("unknown resource %q", *resource)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return buffered.Flush()
+}
+
+func splitFlagValues(value string) []string {
+	var values []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+	return values
+}
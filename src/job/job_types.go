@@ -12,8 +12,10 @@ const (
 )
 
 type UniSwapTransactionPayload struct {
-	SenderID   string  `json:"sender_id"`
-	SwapAmount float64 `json:"swap_amount"`
+	SwapID      string  `json:"swap_id"`
+	SenderID    string  `json:"sender_id"`
+	PoolAddress string  `json:"pool_address"`
+	SwapAmount  float64 `json:"swap_amount"`
 }
 
 func NewUniSwapTransactionTask(payload *UniSwapTransactionPayload) (*asynq.Task, error) {
@@ -18,16 +18,24 @@ func NewUniSwapTransactionProcessor() *UniSwapTransactionProcessor {
 	}
 }
 
-func (processor *UniSwapTransactionProcessor) ProcessTask(_ context.Context, t *asynq.Task) error {
+func (processor *UniSwapTransactionProcessor) ProcessTask(ctx context.Context, t *asynq.Task) error {
 	var payload UniSwapTransactionPayload
 	if err := json.Unmarshal(t.Payload(), &payload); err != nil {
 		return err
 	}
 
+	// jobs enqueued before swaps had an ID are identified by the job, the
+	// retries of a job share its ID
+	swapID := payload.SwapID
+	if swapID == "" {
+		swapID, _ = asynq.GetTaskID(ctx)
+	}
+
 	senderID := payload.SenderID
+	poolAddress := payload.PoolAddress
 	swapAmount := payload.SwapAmount
 
-	log.Println("Processing UniSwap transaction for senderID: ", senderID, " swapAmount: ", swapAmount)
+	log.Println("Processing UniSwap transaction for senderID: ", senderID, " pool: ", poolAddress, " swapAmount: ", swapAmount)
 
-	return processor.uniSwapService.ProcessUniSwapTransaction(senderID, swapAmount)
+	return processor.uniSwapService.ProcessUniSwapTransaction(swapID, senderID, poolAddress, swapAmount)
 }
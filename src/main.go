@@ -4,18 +4,40 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/gin-gonic/gin"
 	"log"
+	"os"
+	"strings"
 	"trading-ace/src/config"
 	"trading-ace/src/contract"
 	"trading-ace/src/controller"
 	"trading-ace/src/database"
 	"trading-ace/src/job"
+	"trading-ace/src/model"
 	"trading-ace/src/router"
 	"trading-ace/src/scheduler"
+	"trading-ace/src/service"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := runExport(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "api-key" {
+		database.MigrateDB("file://migrations", config.GetAppConfig().Database)
+		if err := runAPIKey(os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	database.MigrateDB("file://migrations", config.GetAppConfig().Database)
 
+	campaignService := service.NewCampaignService()
+	seedCampaign(campaignService, config.GetAppConfig().Campaign)
+
 	job.SetUpJobProcessor()
 	defer job.ShutDownJobProcessor()
 
@@ -26,10 +48,16 @@ func main() {
 		return
 	}
 
-	contractAddress := "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"
-	uniSwapContract, err := contract.NewUniSwapV2Contract(contractAddress, "abi/uniswapv2.abi.json", ethClient)
+	for _, poolAddress := range listenedPools(campaignService) {
+		uniSwapContract, err := contract.NewUniSwapV2Contract(poolAddress, "abi/uniswapv2.abi.json", ethClient)
+		if err != nil {
+			log.Fatal(err)
+			return
+		}
 
-	uniSwapContract.ListenSwapEvents(controller.GetUniSwapEventControllerInstance().HandleUniSwapV2Event)
+		log.Printf("Listening swap events of pool: %s\n", poolAddress)
+		uniSwapContract.ListenSwapEvents(controller.GetUniSwapEventControllerInstance().HandleUniSwapV2Event)
+	}
 
 	if config.GetAppConfig().AppEnv == "production" {
 		gin.SetMode(gin.ReleaseMode)
@@ -45,3 +73,54 @@ func main() {
 		return
 	}
 }
+
+// seedCampaign imports the campaign from the config file when the database has
+// no campaign yet.
+func seedCampaign(campaignService service.CampaignService, campaignConfig *config.CampaignConfig) {
+	if campaignConfig == nil {
+		return
+	}
+
+	campaigns, err := campaignService.SearchCampaigns(nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(campaigns) > 0 {
+		return
+	}
+
+	campaign := model.NewCampaign(campaignConfig.Name, campaignConfig.Pools, campaignConfig.GetCampaignStartTime(),
+		model.PeriodType(campaignConfig.GetPeriod()), campaignConfig.GetPeriods())
+	campaign.Timezone = campaignConfig.GetLocation().String()
+	campaign, err = campaignService.CreateCampaign(campaign)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Campaign %d seeded from config\n", campaign.ID)
+}
+
+// listenedPools collects the pools of every campaign that is not archived. Pools
+// of campaigns created later are picked up on the next restart.
+func listenedPools(campaignService service.CampaignService) []string {
+	campaigns, err := campaignService.SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive, model.CampaignStatusPaused})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	seen := make(map[string]bool)
+	var pools []string
+	for _, campaign := range campaigns {
+		for _, pool := range campaign.Pools {
+			if seen[strings.ToLower(pool)] {
+				continue
+			}
+
+			seen[strings.ToLower(pool)] = true
+			pools = append(pools, pool)
+		}
+	}
+
+	return pools
+}
@@ -0,0 +1,127 @@
+// Package merkle builds the Merkle trees of a cumulative distributor, such as
+// 1inch's CumulativeMerkleDrop: the owner replaces the root with every
+// distribution and an account claims the difference between its cumulative
+// amount and what it claimed so far.
+//
+// A leaf is keccak256(abi.encodePacked(address account, uint256 cumulativeAmount)),
+// leaves are sorted and every pair is hashed in sorted order, so proofs verify
+// with OpenZeppelin's MerkleProof. A node without sibling is promoted as is.
+package merkle
+
+import (
+	"bytes"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"math/big"
+	"sort"
+)
+
+// Balance is the cumulative amount an account can claim.
+type Balance struct {
+	Account common.Address
+	Amount  *big.Int
+}
+
+type BalanceTree struct {
+	balances []Balance
+	layers   [][]common.Hash
+	// positions maps a leaf to its position in the first layer.
+	positions map[common.Hash]int
+}
+
+// NewBalanceTree builds the tree of the balances. The balances are sorted by
+// account, the index of a balance is its position in that order. The index is
+// not part of the leaf, so it can change between distributions.
+func NewBalanceTree(balances []Balance) *BalanceTree {
+	sorted := make([]Balance, len(balances))
+	copy(sorted, balances)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Account.Bytes(), sorted[j].Account.Bytes()) < 0
+	})
+
+	leaves := make([]common.Hash, 0, len(sorted))
+	for _, balance := range sorted {
+		leaves = append(leaves, LeafHash(balance.Account, balance.Amount))
+	}
+
+	sort.Slice(leaves, func(i, j int) bool {
+		return bytes.Compare(leaves[i].Bytes(), leaves[j].Bytes()) < 0
+	})
+
+	positions := make(map[common.Hash]int, len(leaves))
+	for position, leaf := range leaves {
+		positions[leaf] = position
+	}
+
+	layers := [][]common.Hash{leaves}
+	for len(layers[len(layers)-1]) > 1 {
+		layers = append(layers, nextLayer(layers[len(layers)-1]))
+	}
+
+	return &BalanceTree{balances: sorted, layers: layers, positions: positions}
+}
+
+// Balances returns the balances in index order.
+func (t *BalanceTree) Balances() []Balance {
+	return t.balances
+}
+
+// Root is the Merkle root, the zero hash for an empty tree.
+func (t *BalanceTree) Root() common.Hash {
+	top := t.layers[len(t.layers)-1]
+	if len(top) == 0 {
+		return common.Hash{}
+	}
+	return top[0]
+}
+
+// Proof returns the proof of the balance at index.
+func (t *BalanceTree) Proof(index int) []common.Hash {
+	balance := t.balances[index]
+	position := t.positions[LeafHash(balance.Account, balance.Amount)]
+
+	var proof []common.Hash
+	for _, layer := range t.layers[:len(t.layers)-1] {
+		if sibling := position ^ 1; sibling < len(layer) {
+			proof = append(proof, layer[sibling])
+		}
+		position /= 2
+	}
+
+	return proof
+}
+
+func LeafHash(account common.Address, cumulativeAmount *big.Int) common.Hash {
+	return crypto.Keccak256Hash(
+		account.Bytes(),
+		common.LeftPadBytes(cumulativeAmount.Bytes(), 32),
+	)
+}
+
+// VerifyProof checks a proof the way MerkleProof.verify does on chain.
+func VerifyProof(proof []common.Hash, root common.Hash, leaf common.Hash) bool {
+	computed := leaf
+	for _, node := range proof {
+		computed = combinedHash(computed, node)
+	}
+	return computed == root
+}
+
+func nextLayer(layer []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(layer)+1)/2)
+	for i := 0; i < len(layer); i += 2 {
+		if i+1 == len(layer) {
+			next = append(next, layer[i])
+			continue
+		}
+		next = append(next, combinedHash(layer[i], layer[i+1]))
+	}
+	return next
+}
+
+func combinedHash(first common.Hash, second common.Hash) common.Hash {
+	if bytes.Compare(first.Bytes(), second.Bytes()) > 0 {
+		first, second = second, first
+	}
+	return crypto.Keccak256Hash(first.Bytes(), second.Bytes())
+}
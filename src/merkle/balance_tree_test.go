@@ -0,0 +1,86 @@
+package merkle
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"testing"
+)
+
+func TestBalanceTree(t *testing.T) {
+	alice := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	bob := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	carol := common.HexToAddress("0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC")
+
+	t.Run("Leaf Is Packed Account And Cumulative Amount", func(t *testing.T) {
+		// abi.encodePacked(address, uint256): 20 bytes of address, 32 bytes of amount
+		packed := common.FromHex("0x" +
+			"70997970c51812dc3a010c7d01b50e0d17dc79c8" +
+			"0000000000000000000000000000000000000000000000000000000000000064")
+
+		assert.Equal(t, 52, len(packed))
+		assert.Equal(t, crypto.Keccak256Hash(packed), LeafHash(bob, big.NewInt(100)))
+	})
+
+	t.Run("Leaf Does Not Depend On Other Accounts", func(t *testing.T) {
+		first := NewBalanceTree([]Balance{{Account: bob, Amount: big.NewInt(100)}})
+		second := NewBalanceTree([]Balance{{Account: bob, Amount: big.NewInt(100)}, {Account: carol, Amount: big.NewInt(5)}})
+
+		// bob's index moves from 0 to 1, his leaf stays the same
+		assert.Equal(t, bob, second.Balances()[1].Account)
+		assert.Equal(t, first.Root(), LeafHash(bob, big.NewInt(100)))
+		assert.True(t, VerifyProof(second.Proof(1), second.Root(), LeafHash(bob, big.NewInt(100))))
+	})
+
+	t.Run("Two Leaves", func(t *testing.T) {
+		tree := NewBalanceTree([]Balance{{Account: bob, Amount: big.NewInt(100)}, {Account: alice, Amount: big.NewInt(101)}})
+
+		balances := tree.Balances()
+		assert.Equal(t, bob, balances[0].Account)
+		assert.Equal(t, alice, balances[1].Account)
+
+		first, second := LeafHash(bob, big.NewInt(100)), LeafHash(alice, big.NewInt(101))
+		assert.Equal(t, combinedHash(second, first), tree.Root())
+		assert.Equal(t, crypto.Keccak256Hash(minHash(first, second).Bytes(), maxHash(first, second).Bytes()), tree.Root())
+		assert.Equal(t, []common.Hash{second}, tree.Proof(0))
+	})
+
+	t.Run("Every Proof Verifies", func(t *testing.T) {
+		var balances []Balance
+		for i := 1; i <= 7; i++ {
+			account := common.BigToAddress(big.NewInt(int64(i * 7919)))
+			balances = append(balances, Balance{Account: account, Amount: big.NewInt(int64(i) * 1e6)})
+		}
+		balances = append(balances, Balance{Account: carol, Amount: new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)})
+
+		tree := NewBalanceTree(balances)
+		for index, balance := range tree.Balances() {
+			leaf := LeafHash(balance.Account, balance.Amount)
+			assert.True(t, VerifyProof(tree.Proof(index), tree.Root(), leaf))
+			assert.False(t, VerifyProof(tree.Proof(index), tree.Root(), LeafHash(balance.Account, big.NewInt(1))))
+		}
+	})
+
+	t.Run("Single And Empty Tree", func(t *testing.T) {
+		tree := NewBalanceTree([]Balance{{Account: alice, Amount: big.NewInt(5)}})
+		assert.Equal(t, LeafHash(alice, big.NewInt(5)), tree.Root())
+		assert.Empty(t, tree.Proof(0))
+
+		assert.Equal(t, common.Hash{}, NewBalanceTree(nil).Root())
+	})
+}
+
+func minHash(a, b common.Hash) common.Hash {
+	if a.Big().Cmp(b.Big()) < 0 {
+		return a
+	}
+	return b
+}
+
+func maxHash(a, b common.Hash) common.Hash {
+	if a.Big().Cmp(b.Big()) < 0 {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,75 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// Role is the level of access of an API key, each role includes the ones below.
+type Role string
+
+const (
+	RoleViewer   Role = "viewer"
+	RoleOperator Role = "operator"
+	RoleAdmin    Role = "admin"
+)
+
+var roleRanks = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+func (r Role) IsValid() bool {
+	return roleRanks[r] > 0
+}
+
+// Includes tells whether the role grants at least the access of other.
+func (r Role) Includes(other Role) bool {
+	return r.IsValid() && roleRanks[r] >= roleRanks[other]
+}
+
+// APIKeyScope is the admin resource an API key can access.
+type APIKeyScope string
+
+const (
+	APIKeyScopeAll         APIKeyScope = "*"
+	APIKeyScopeCampaigns   APIKeyScope = "campaigns"
+	APIKeyScopeSettlements APIKeyScope = "settlements"
+	APIKeyScopeAPIKeys     APIKeyScope = "api_keys"
+	APIKeyScopeAdjustments APIKeyScope = "adjustments"
+	APIKeyScopeRedemptions APIKeyScope = "redemptions"
+	// APIKeyScopeExports reads the campaign wide exports.
+	APIKeyScopeExports APIKeyScope = "exports"
+)
+
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case APIKeyScopeAll, APIKeyScopeCampaigns, APIKeyScopeSettlements, APIKeyScopeAPIKeys, APIKeyScopeAdjustments,
+		APIKeyScopeRedemptions, APIKeyScopeExports:
+		return true
+	}
+	return false
+}
+
+// APIKey authenticates callers of the admin API. Only the SHA-256 hash of the
+// key is stored, Key is set once when the key is created.
+type APIKey struct {
+	ID        int           `json:"id"`
+	Name      string        `json:"name"`
+	Prefix    string        `json:"prefix"`
+	KeyHash   string        `json:"-"`
+	Key       string        `json:"key,omitempty"`
+	Role      Role          `json:"role"`
+	Scopes    []APIKeyScope `json:"scopes"`
+	CreatedAt time.Time     `json:"created_at"`
+	RevokedAt *time.Time    `json:"revoked_at"`
+}
+
+// Allows tells whether the key has the role and the scope.
+func (k *APIKey) Allows(scope APIKeyScope, role Role) bool {
+	if k.RevokedAt != nil || !k.Role.Includes(role) {
+		return false
+	}
+	return slices.Contains(k.Scopes, APIKeyScopeAll) || slices.Contains(k.Scopes, scope)
+}
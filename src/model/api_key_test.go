@@ -0,0 +1,25 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestAPIKey_Allows(t *testing.T) {
+	operator := &APIKey{Role: RoleOperator, Scopes: []APIKeyScope{APIKeyScopeCampaigns}}
+	assert.True(t, operator.Allows(APIKeyScopeCampaigns, RoleViewer))
+	assert.True(t, operator.Allows(APIKeyScopeCampaigns, RoleOperator))
+	assert.False(t, operator.Allows(APIKeyScopeCampaigns, RoleAdmin))
+	assert.False(t, operator.Allows(APIKeyScopeSettlements, RoleViewer))
+
+	admin := &APIKey{Role: RoleAdmin, Scopes: []APIKeyScope{APIKeyScopeAll}}
+	assert.True(t, admin.Allows(APIKeyScopeAPIKeys, RoleAdmin))
+
+	revokedAt := time.Now()
+	admin.RevokedAt = &revokedAt
+	assert.False(t, admin.Allows(APIKeyScopeCampaigns, RoleViewer))
+
+	unknownRole := &APIKey{Role: "root", Scopes: []APIKeyScope{APIKeyScopeAll}}
+	assert.False(t, unknownRole.Allows(APIKeyScopeCampaigns, RoleViewer))
+}
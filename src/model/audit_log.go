@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+type AuditAction string
+
+const (
+	AuditActionExecuteSettlement AuditAction = "execute_settlement"
+	AuditActionAdjustPoints      AuditAction = "adjust_points"
+	AuditActionFulfillRedemption AuditAction = "fulfill_redemption"
+	AuditActionCancelRedemption  AuditAction = "cancel_redemption"
+	// AuditActionAdminRequest is any admin API call changing state.
+	AuditActionAdminRequest AuditAction = "admin_request"
+)
+
+// AuditLog records an operator action that changed user balances or campaign
+// state outside the regular flow.
+type AuditLog struct {
+	ID        int            `json:"id"`
+	Operator  string         `json:"operator"`
+	Action    AuditAction    `json:"action"`
+	Target    string         `json:"target"`
+	Detail    map[string]any `json:"detail"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+func NewAuditLog(operator string, action AuditAction, target string, detail map[string]any) *AuditLog {
+	return &AuditLog{
+		Operator:  operator,
+		Action:    action,
+		Target:    target,
+		Detail:    detail,
+		CreatedAt: time.Now().UTC(),
+	}
+}
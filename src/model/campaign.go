@@ -0,0 +1,228 @@
+package model
+
+import (
+	"fmt"
+	"github.com/robfig/cron/v3"
+	"math"
+	"strings"
+	"time"
+)
+
+type CampaignStatus string
+
+const (
+	CampaignStatusActive   CampaignStatus = "active"
+	CampaignStatusPaused   CampaignStatus = "paused"
+	CampaignStatusArchived CampaignStatus = "archived"
+)
+
+type PeriodType string
+
+const (
+	PeriodTypeDaily   PeriodType = "daily"
+	PeriodTypeWeekly  PeriodType = "weekly"
+	PeriodTypeMonthly PeriodType = "monthly"
+	PeriodTypeCron    PeriodType = "cron"
+)
+
+const (
+	DefaultBudgetPerPeriod  = 10000.0
+	DefaultOnboardingAmount = 1000.0
+	DefaultOnboardingReward = 100.0
+	DefaultTimezone         = "UTC"
+)
+
+type Campaign struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Pools      []string   `json:"pools"`
+	StartTime  time.Time  `json:"start_time"`
+	PeriodType PeriodType `json:"period_type"`
+	// PeriodCron is a standard 5 field cron expression marking period ends, only
+	// used by PeriodTypeCron.
+	PeriodCron string `json:"period_cron"`
+	// Timezone is the IANA zone period boundaries are computed in, so a daily
+	// period ends at local midnight across DST changes.
+	Timezone        string  `json:"timezone"`
+	Periods         int     `json:"periods"`
+	BudgetPerPeriod float64 `json:"budget_per_period"`
+	// BudgetDecay multiplies the budget of every following period, 1 keeps it flat.
+	BudgetDecay float64 `json:"budget_decay"`
+	// BudgetSchedule overrides the budget of the first len(BudgetSchedule) periods.
+	BudgetSchedule   []float64      `json:"budget_schedule"`
+	OnboardingAmount float64        `json:"onboarding_amount"`
+	OnboardingReward float64        `json:"onboarding_reward"`
+	Status           CampaignStatus `json:"status"`
+	CreatedAt        time.Time      `json:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at"`
+
+	// location is the zone of Timezone, resolved by ResolveLocation.
+	location *time.Location
+}
+
+func NewCampaign(name string, pools []string, startTime time.Time, periodType PeriodType, periods int) *Campaign {
+	now := time.Now().UTC()
+	return &Campaign{
+		Name:             name,
+		Pools:            pools,
+		StartTime:        startTime.UTC(),
+		PeriodType:       periodType,
+		Timezone:         DefaultTimezone,
+		Periods:          periods,
+		BudgetPerPeriod:  DefaultBudgetPerPeriod,
+		BudgetDecay:      1,
+		OnboardingAmount: DefaultOnboardingAmount,
+		OnboardingReward: DefaultOnboardingReward,
+		Status:           CampaignStatusActive,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// ValidateSchedule checks that period boundaries can be computed.
+func (c *Campaign) ValidateSchedule() error {
+	if _, err := time.LoadLocation(c.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %s", c.Timezone)
+	}
+
+	switch c.PeriodType {
+	case PeriodTypeDaily, PeriodTypeWeekly, PeriodTypeMonthly:
+		return nil
+	case PeriodTypeCron:
+		if _, err := c.cronSchedule(); err != nil {
+			return fmt.Errorf("invalid period cron %s: %v", c.PeriodCron, err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("invalid period type %s", c.PeriodType)
+	}
+}
+
+// ResolveLocation loads the zone of Timezone once, e.g. when the campaign is
+// loaded, instead of at every period boundary computation.
+func (c *Campaign) ResolveLocation() {
+	c.location = loadLocation(c.Timezone)
+}
+
+// Location returns the zone of Timezone, the resolved one unless Timezone
+// changed since.
+func (c *Campaign) Location() *time.Location {
+	if c.location != nil && c.location.String() == c.Timezone {
+		return c.location
+	}
+	return loadLocation(c.Timezone)
+}
+
+func loadLocation(timezone string) *time.Location {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// PeriodWindow returns the [start, end) window of the period at index.
+func (c *Campaign) PeriodWindow(index int) (time.Time, time.Time) {
+	boundaries := c.boundaries(index + 1)
+	return boundaries[index], boundaries[index+1]
+}
+
+func (c *Campaign) EndTime() time.Time {
+	boundaries := c.boundaries(c.Periods)
+	return boundaries[len(boundaries)-1]
+}
+
+// PeriodIndexAt returns the index of the period containing t, or false when t
+// falls outside the campaign.
+func (c *Campaign) PeriodIndexAt(t time.Time) (int, bool) {
+	boundaries := c.boundaries(c.Periods)
+	for i := 0; i < c.Periods; i++ {
+		if !t.Before(boundaries[i]) && t.Before(boundaries[i+1]) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func (c *Campaign) BudgetOfPeriod(index int) float64 {
+	if index < len(c.BudgetSchedule) {
+		return c.BudgetSchedule[index]
+	}
+
+	decay := c.BudgetDecay
+	if decay <= 0 {
+		decay = 1
+	}
+
+	return c.BudgetPerPeriod * math.Pow(decay, float64(index))
+}
+
+func (c *Campaign) HasPool(poolAddress string) bool {
+	for _, pool := range c.Pools {
+		if strings.EqualFold(pool, poolAddress) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRunningAt tells whether swaps at t accrue to the campaign.
+func (c *Campaign) IsRunningAt(t time.Time) bool {
+	_, inWindow := c.PeriodIndexAt(t)
+	return c.Status == CampaignStatusActive && inWindow
+}
+
+// boundaries returns the first n+1 period boundaries in UTC, boundary i being
+// the start of period i.
+func (c *Campaign) boundaries(n int) []time.Time {
+	start := c.StartTime.In(c.Location())
+	boundaries := make([]time.Time, 0, n+1)
+	boundaries = append(boundaries, start.UTC())
+
+	var schedule cron.Schedule
+	if c.PeriodType == PeriodTypeCron {
+		schedule, _ = c.cronSchedule()
+	}
+
+	current := start
+	for i := 1; i <= n; i++ {
+		switch c.PeriodType {
+		case PeriodTypeDaily:
+			current = start.AddDate(0, 0, i)
+		case PeriodTypeMonthly:
+			current = addMonths(start, i)
+		case PeriodTypeCron:
+			if schedule == nil {
+				current = current.AddDate(0, 0, 7)
+			} else {
+				current = schedule.Next(current)
+			}
+		default:
+			current = start.AddDate(0, 0, 7*i)
+		}
+
+		boundaries = append(boundaries, current.UTC())
+	}
+
+	return boundaries
+}
+
+func (c *Campaign) cronSchedule() (cron.Schedule, error) {
+	return cron.ParseStandard(fmt.Sprintf("CRON_TZ=%s %s", c.Timezone, c.PeriodCron))
+}
+
+// addMonths adds months to t keeping the day of month, clamped to the last day
+// of the target month, so a period starting on the 31st ends on the 30th in
+// shorter months instead of overflowing into the next one.
+func addMonths(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	target := firstOfMonth.AddDate(0, months, 0)
+	lastDay := target.AddDate(0, 1, -1).Day()
+
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(target.Year(), target.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,105 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestCampaign_PeriodWindow(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	t.Run("Daily Across DST", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 3, 9, 0, 0, 0, 0, newYork), PeriodTypeDaily, 3)
+		campaign.Timezone = "America/New_York"
+
+		start, end := campaign.PeriodWindow(1)
+		assert.Equal(t, time.Date(2024, 3, 10, 0, 0, 0, 0, newYork).UTC(), start)
+		assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, newYork).UTC(), end)
+		assert.Equal(t, 23*time.Hour, end.Sub(start))
+	})
+
+	t.Run("Weekly", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), PeriodTypeWeekly, 4)
+
+		start, end := campaign.PeriodWindow(3)
+		assert.Equal(t, time.Date(2024, 9, 22, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, 9, 29, 0, 0, 0, 0, time.UTC), end)
+		assert.Equal(t, end, campaign.EndTime())
+	})
+
+	t.Run("Monthly From End Of Month", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), PeriodTypeMonthly, 3)
+
+		start, end := campaign.PeriodWindow(1)
+		assert.Equal(t, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), end)
+	})
+
+	t.Run("Cron", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC), PeriodTypeCron, 2)
+		campaign.PeriodCron = "0 0 * * 5"
+		assert.NoError(t, campaign.ValidateSchedule())
+
+		start, end := campaign.PeriodWindow(0)
+		assert.Equal(t, time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC), end)
+
+		start, end = campaign.PeriodWindow(1)
+		assert.Equal(t, time.Date(2024, 9, 6, 0, 0, 0, 0, time.UTC), start)
+		assert.Equal(t, time.Date(2024, 9, 13, 0, 0, 0, 0, time.UTC), end)
+	})
+}
+
+func TestCampaign_Location(t *testing.T) {
+	t.Run("Resolved", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), PeriodTypeDaily, 2)
+		campaign.Timezone = "America/New_York"
+		campaign.ResolveLocation()
+
+		assert.Same(t, campaign.location, campaign.Location())
+	})
+
+	t.Run("Timezone Changed", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), PeriodTypeDaily, 2)
+		campaign.ResolveLocation()
+		campaign.Timezone = "Asia/Tokyo"
+
+		assert.Equal(t, "Asia/Tokyo", campaign.Location().String())
+	})
+
+	t.Run("Invalid Timezone", func(t *testing.T) {
+		campaign := NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), PeriodTypeDaily, 2)
+		campaign.Timezone = "Mars/Olympus_Mons"
+		campaign.ResolveLocation()
+
+		assert.Equal(t, time.UTC, campaign.Location())
+	})
+}
+
+func TestCampaign_PeriodIndexAt(t *testing.T) {
+	campaign := NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), PeriodTypeDaily, 2)
+
+	index, ok := campaign.PeriodIndexAt(time.Date(2024, 9, 2, 12, 0, 0, 0, time.UTC))
+	assert.True(t, ok)
+	assert.Equal(t, 1, index)
+
+	_, ok = campaign.PeriodIndexAt(time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+
+	_, ok = campaign.PeriodIndexAt(time.Date(2024, 8, 31, 23, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
+
+func TestCampaign_BudgetOfPeriod(t *testing.T) {
+	campaign := NewCampaign("test", nil, time.Now(), PeriodTypeWeekly, 4)
+	campaign.BudgetPerPeriod = 1000
+	campaign.BudgetDecay = 0.5
+
+	assert.Equal(t, 1000.0, campaign.BudgetOfPeriod(0))
+	assert.Equal(t, 250.0, campaign.BudgetOfPeriod(2))
+
+	campaign.BudgetSchedule = []float64{5000, 3000}
+	assert.Equal(t, 3000.0, campaign.BudgetOfPeriod(1))
+	assert.Equal(t, 250.0, campaign.BudgetOfPeriod(2))
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// MerkleDistribution is the Merkle tree of the cumulative token amounts of a
+// campaign, built when a period is settled. Amounts are decimal strings of
+// token base units, as they don't fit in a float.
+type MerkleDistribution struct {
+	ID            int       `json:"id"`
+	CampaignID    int       `json:"campaign_id"`
+	PeriodIndex   int       `json:"period_index"`
+	Root          string    `json:"root"`
+	TokenDecimals int       `json:"token_decimals"`
+	TotalAmount   string    `json:"total_amount"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// Claim is the leaf of an address in a distribution and its proof. Amount is
+// the cumulative amount of the address, not what is left to claim.
+type Claim struct {
+	DistributionID int      `json:"distribution_id"`
+	Address        string   `json:"address"`
+	Amount         string   `json:"amount"`
+	Proof          []string `json:"proof"`
+}
+
+// ClaimOfCampaign is the claim of an address with its distribution.
+type ClaimOfCampaign struct {
+	Distribution *MerkleDistribution
+	Claim        *Claim
+}
@@ -0,0 +1,129 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// ExpiryRule tells when earned points expire: Days after they are earned,
+// when their campaign ends, or whichever comes first when both are set.
+// Points earned once their campaign ended, such as the payout of its last
+// period or a late settlement, only expire after Days.
+type ExpiryRule struct {
+	Days          int
+	AtCampaignEnd bool
+}
+
+func (r *ExpiryRule) IsEnabled() bool {
+	return r != nil && (r.Days > 0 || r.AtCampaignEnd)
+}
+
+// ExpiresAt is when points earned at earnedAt expire, nil when they never do.
+// campaignEnd is the end of the campaign the points were earned in, nil for
+// points earned outside of any campaign.
+func (r *ExpiryRule) ExpiresAt(earnedAt time.Time, campaignEnd *time.Time) *time.Time {
+	if !r.IsEnabled() {
+		return nil
+	}
+
+	var expiresAt *time.Time
+	if r.Days > 0 {
+		t := earnedAt.AddDate(0, 0, r.Days)
+		expiresAt = &t
+	}
+
+	if r.AtCampaignEnd && campaignEnd != nil && campaignEnd.After(earnedAt) && (expiresAt == nil || campaignEnd.Before(*expiresAt)) {
+		t := *campaignEnd
+		expiresAt = &t
+	}
+
+	return expiresAt
+}
+
+// PointLot is the points of one ledger credit of a user, points expire lot by lot.
+type PointLot struct {
+	TransactionID int
+	CampaignID    int
+	Points        float64
+	EarnedAt      time.Time
+	// Remaining is what debits left of the lot, see ConsumeLots.
+	Remaining float64
+	ExpiresAt *time.Time
+}
+
+// ConsumeLots spends the debited points from the lots expiring first, the
+// oldest first among lots expiring together and lots that never expire last,
+// and sets what is left of each lot. ExpiresAt must be set.
+//
+// Lots are consumed in expiry order rather than in the order they were earned
+// since lots of concurrent campaigns don't expire in that order: an expiry
+// debit must consume the lots it expired, which always expire before the lots
+// earned after it, or the next run would expire them again.
+func ConsumeLots(lots []*PointLot, debited float64) {
+	sorted := slices.Clone(lots)
+	slices.SortStableFunc(sorted, func(a, b *PointLot) int {
+		switch {
+		case a.ExpiresAt == nil && b.ExpiresAt == nil:
+			return a.EarnedAt.Compare(b.EarnedAt)
+		case a.ExpiresAt == nil:
+			return 1
+		case b.ExpiresAt == nil:
+			return -1
+		case !a.ExpiresAt.Equal(*b.ExpiresAt):
+			return a.ExpiresAt.Compare(*b.ExpiresAt)
+		default:
+			return a.EarnedAt.Compare(b.EarnedAt)
+		}
+	})
+
+	for _, lot := range sorted {
+		spent := min(debited, lot.Points)
+		lot.Remaining = lot.Points - spent
+		debited -= spent
+	}
+}
+
+// ExpiredPoints is what is left of the lots expired at now.
+func ExpiredPoints(lots []*PointLot, now time.Time) float64 {
+	expired := 0.0
+	for _, lot := range lots {
+		if lot.Remaining > 0 && lot.ExpiresAt != nil && !lot.ExpiresAt.After(now) {
+			expired += lot.Remaining
+		}
+	}
+	return expired
+}
+
+// ExpiringPoints is points of a user expiring at the same time.
+type ExpiringPoints struct {
+	Points    float64   `json:"points"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// ExpiringSoon groups what is left of the lots expiring after now and until
+// now + window by expiry time, soonest first.
+func ExpiringSoon(lots []*PointLot, now time.Time, window time.Duration) []*ExpiringPoints {
+	var expiring []*ExpiringPoints
+	byTime := make(map[time.Time]*ExpiringPoints)
+
+	for _, lot := range lots {
+		if lot.Remaining <= 0 || lot.ExpiresAt == nil || !lot.ExpiresAt.After(now) || lot.ExpiresAt.After(now.Add(window)) {
+			continue
+		}
+
+		if points, ok := byTime[*lot.ExpiresAt]; ok {
+			points.Points += lot.Remaining
+			continue
+		}
+
+		points := &ExpiringPoints{Points: lot.Remaining, ExpiresAt: *lot.ExpiresAt}
+		byTime[*lot.ExpiresAt] = points
+		expiring = append(expiring, points)
+	}
+
+	slices.SortFunc(expiring, func(a, b *ExpiringPoints) int {
+		return a.ExpiresAt.Compare(b.ExpiresAt)
+	})
+
+	return expiring
+}
@@ -0,0 +1,133 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestExpiryRule_ExpiresAt(t *testing.T) {
+	earnedAt := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	campaignEnd := time.Date(2024, 9, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Disabled", func(t *testing.T) {
+		assert.Nil(t, (&ExpiryRule{}).ExpiresAt(earnedAt, &campaignEnd))
+	})
+
+	t.Run("Days After Earned", func(t *testing.T) {
+		expiresAt := (&ExpiryRule{Days: 30}).ExpiresAt(earnedAt, &campaignEnd)
+		assert.Equal(t, earnedAt.AddDate(0, 0, 30), *expiresAt)
+	})
+
+	t.Run("At Campaign End", func(t *testing.T) {
+		expiresAt := (&ExpiryRule{AtCampaignEnd: true}).ExpiresAt(earnedAt, &campaignEnd)
+		assert.Equal(t, campaignEnd, *expiresAt)
+
+		assert.Nil(t, (&ExpiryRule{AtCampaignEnd: true}).ExpiresAt(earnedAt, nil))
+	})
+
+	t.Run("Earliest of Both", func(t *testing.T) {
+		expiresAt := (&ExpiryRule{Days: 30, AtCampaignEnd: true}).ExpiresAt(earnedAt, &campaignEnd)
+		assert.Equal(t, campaignEnd, *expiresAt)
+
+		expiresAt = (&ExpiryRule{Days: 7, AtCampaignEnd: true}).ExpiresAt(earnedAt, &campaignEnd)
+		assert.Equal(t, earnedAt.AddDate(0, 0, 7), *expiresAt)
+	})
+
+	t.Run("Earned After Campaign End", func(t *testing.T) {
+		lateEarnedAt := campaignEnd.Add(time.Hour)
+
+		assert.Nil(t, (&ExpiryRule{AtCampaignEnd: true}).ExpiresAt(lateEarnedAt, &campaignEnd))
+		assert.Nil(t, (&ExpiryRule{AtCampaignEnd: true}).ExpiresAt(campaignEnd, &campaignEnd))
+
+		expiresAt := (&ExpiryRule{Days: 30, AtCampaignEnd: true}).ExpiresAt(lateEarnedAt, &campaignEnd)
+		assert.Equal(t, lateEarnedAt.AddDate(0, 0, 30), *expiresAt)
+	})
+}
+
+func TestConsumeLots(t *testing.T) {
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	expired := now.Add(-time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+	nextMonth := now.AddDate(0, 1, 0)
+
+	newLots := func() []*PointLot {
+		return []*PointLot{
+			{TransactionID: 1, Points: 100, ExpiresAt: &expired},
+			{TransactionID: 2, Points: 50, ExpiresAt: &tomorrow},
+			{TransactionID: 3, Points: 30, ExpiresAt: &tomorrow},
+			{TransactionID: 4, Points: 20, ExpiresAt: &nextMonth},
+			{TransactionID: 5, Points: 10},
+		}
+	}
+
+	t.Run("Debits Spend the Lots Expiring First", func(t *testing.T) {
+		lots := newLots()
+		ConsumeLots(lots, 120)
+
+		assert.Equal(t, 0.0, lots[0].Remaining)
+		assert.Equal(t, 30.0, lots[1].Remaining)
+		assert.Equal(t, 30.0, lots[2].Remaining)
+		assert.Equal(t, 0.0, ExpiredPoints(lots, now))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		lots := newLots()
+		ConsumeLots(lots, 40)
+
+		assert.Equal(t, 60.0, ExpiredPoints(lots, now))
+
+		// the expiry debit consumes the expired lot, nothing expires twice
+		ConsumeLots(lots, 40+60)
+		assert.Equal(t, 0.0, ExpiredPoints(lots, now))
+	})
+
+	t.Run("Expiring Soon", func(t *testing.T) {
+		lots := newLots()
+		ConsumeLots(lots, 110)
+
+		assert.Equal(t, []*ExpiringPoints{{Points: 70, ExpiresAt: tomorrow}}, ExpiringSoon(lots, now, 7*24*time.Hour))
+		assert.Equal(t, []*ExpiringPoints{
+			{Points: 70, ExpiresAt: tomorrow},
+			{Points: 20, ExpiresAt: nextMonth},
+		}, ExpiringSoon(lots, now, 60*24*time.Hour))
+	})
+}
+
+func TestConsumeLots_RepeatedExpiry(t *testing.T) {
+	rule := &ExpiryRule{Days: 90, AtCampaignEnd: true}
+	start := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	campaignEnd := start.AddDate(0, 0, 10)
+
+	// a 90 day lot of no campaign, then a lot of a campaign ending before it
+	// expires, then a lot earned after the campaign ended
+	lots := []*PointLot{
+		{TransactionID: 1, Points: 100, EarnedAt: start},
+		{TransactionID: 2, CampaignID: 1, Points: 50, EarnedAt: start.AddDate(0, 0, 5)},
+		{TransactionID: 3, CampaignID: 1, Points: 20, EarnedAt: start.AddDate(0, 0, 12)},
+	}
+	for _, lot := range lots {
+		var end *time.Time
+		if lot.CampaignID != 0 {
+			end = &campaignEnd
+		}
+		lot.ExpiresAt = rule.ExpiresAt(lot.EarnedAt, end)
+	}
+
+	// a redemption of 30 and the expiry debits of every hourly run
+	debited := 30.0
+	expiredTotal := 0.0
+	for now := start.AddDate(0, 0, 11); now.Before(start.AddDate(0, 0, 120)); now = now.Add(time.Hour) {
+		ConsumeLots(lots, debited)
+		expired := ExpiredPoints(lots, now)
+		debited += expired
+		expiredTotal += expired
+	}
+
+	// the campaign lot expires once net of the redemption, then both 90 day lots
+	assert.InDelta(t, 20.0+100+20, expiredTotal, 1e-9)
+	ConsumeLots(lots, debited)
+	for _, lot := range lots {
+		assert.Equal(t, 0.0, lot.Remaining)
+	}
+}
@@ -0,0 +1,38 @@
+package model
+
+import "time"
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV    ExportFormat = "csv"
+	ExportFormatNDJSON ExportFormat = "ndjson"
+)
+
+func (f ExportFormat) IsValid() bool {
+	switch f {
+	case ExportFormatCSV, ExportFormatNDJSON:
+		return true
+	}
+	return false
+}
+
+func (f ExportFormat) ContentType() string {
+	if f == ExportFormatNDJSON {
+		return "application/x-ndjson"
+	}
+	return "text/csv"
+}
+
+// ExportQuery selects the rows of an export. PeriodIndex needs CampaignID and
+// replaces the time range by the window of the period.
+type ExportQuery struct {
+	Format      ExportFormat
+	UserID      string
+	CampaignID  int
+	PeriodIndex *int
+	Types       []TaskType
+	Statuses    []TaskStatus
+	StartTime   time.Time
+	EndTime     time.Time
+}
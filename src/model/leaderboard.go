@@ -0,0 +1,42 @@
+package model
+
+type LeaderboardSortBy string
+
+const (
+	LeaderboardSortByVolume LeaderboardSortBy = "volume"
+	LeaderboardSortByPoints LeaderboardSortBy = "points"
+)
+
+type LeaderboardQuery struct {
+	CampaignID  int
+	PeriodIndex *int
+	SortBy      LeaderboardSortBy
+	Page        int
+	PageSize    int
+	// UserID asks for the rank of that user next to the page.
+	UserID string
+}
+
+type LeaderboardEntry struct {
+	Rank      int     `json:"rank"`
+	UserID    string  `json:"user_id"`
+	Volume    float64 `json:"volume"`
+	Points    float64 `json:"points"`
+	SwapCount int     `json:"swap_count"`
+	// Tier is the current tier of the user, whatever the ranked period.
+	Tier string `json:"tier"`
+}
+
+// Leaderboard is one page of a campaign ranking. PeriodIndex is nil when the
+// whole campaign is ranked.
+type Leaderboard struct {
+	CampaignID  int                 `json:"campaign_id"`
+	PeriodIndex *int                `json:"period_index"`
+	SortBy      LeaderboardSortBy   `json:"sort_by"`
+	Page        int                 `json:"page"`
+	PageSize    int                 `json:"page_size"`
+	Total       int                 `json:"total"`
+	Entries     []*LeaderboardEntry `json:"entries"`
+	// Me is the entry of the requested user, nil when not requested or ranked.
+	Me *LeaderboardEntry `json:"me"`
+}
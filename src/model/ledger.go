@@ -0,0 +1,115 @@
+package model
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// LedgerAccount holds points. Every user has an account and every movement of
+// points is balanced by one of the system accounts.
+type LedgerAccount string
+
+const (
+	// LedgerAccountRewards issues the points of task rewards.
+	LedgerAccountRewards LedgerAccount = "system:rewards"
+	// LedgerAccountAdjustments is the counterpart of manual adjustments.
+	LedgerAccountAdjustments LedgerAccount = "system:adjustments"
+	// LedgerAccountRedemptions receives the points users spend, and refunds them.
+	LedgerAccountRedemptions LedgerAccount = "system:redemptions"
+	// LedgerAccountExpiry receives the points users didn't spend in time.
+	LedgerAccountExpiry LedgerAccount = "system:expiry"
+	// LedgerAccountClaims holds the points users can claim on chain, they
+	// can't be redeemed or expire anymore.
+	LedgerAccountClaims LedgerAccount = "system:claims"
+)
+
+const userLedgerAccountPrefix = "user:"
+
+func UserLedgerAccount(userID string) LedgerAccount {
+	return LedgerAccount(userLedgerAccountPrefix + userID)
+}
+
+// UserID is the user owning the account, empty for system accounts.
+func (a LedgerAccount) UserID() string {
+	if userID, ok := strings.CutPrefix(string(a), userLedgerAccountPrefix); ok {
+		return userID
+	}
+	return ""
+}
+
+// LedgerTransactionKind tells what moved the points of a transaction.
+type LedgerTransactionKind string
+
+const (
+	LedgerTransactionKindTaskReward LedgerTransactionKind = "task_reward"
+	LedgerTransactionKindAdjustment LedgerTransactionKind = "adjustment"
+	LedgerTransactionKindRedemption LedgerTransactionKind = "redemption"
+	// LedgerTransactionKindRedemptionRefund gives back the points of a cancelled redemption.
+	LedgerTransactionKindRedemptionRefund LedgerTransactionKind = "redemption_refund"
+	LedgerTransactionKindExpiry           LedgerTransactionKind = "expiry"
+	LedgerTransactionKindClaim            LedgerTransactionKind = "claim"
+)
+
+// LedgerEntry is the change of one account in a transaction, positive for a
+// credit and negative for a debit.
+type LedgerEntry struct {
+	ID            int           `json:"id"`
+	TransactionID int           `json:"transaction_id"`
+	Account       LedgerAccount `json:"account"`
+	Amount        float64       `json:"amount"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// LedgerTransaction is an atomic movement of points, its entries sum to zero.
+type LedgerTransaction struct {
+	ID        int                   `json:"id"`
+	Kind      LedgerTransactionKind `json:"kind"`
+	Entries   []*LedgerEntry        `json:"entries"`
+	CreatedAt time.Time             `json:"created_at"`
+}
+
+// NewUserLedgerTransaction credits points to the account of the user from the
+// counterpart account, negative points debit the user instead.
+func NewUserLedgerTransaction(kind LedgerTransactionKind, userID string, counterpart LedgerAccount, points float64, createdAt time.Time) *LedgerTransaction {
+	return &LedgerTransaction{
+		Kind: kind,
+		Entries: []*LedgerEntry{
+			{Account: UserLedgerAccount(userID), Amount: points, CreatedAt: createdAt},
+			{Account: counterpart, Amount: -points, CreatedAt: createdAt},
+		},
+		CreatedAt: createdAt,
+	}
+}
+
+// ledgerTolerance absorbs the rounding of summing floating point amounts.
+const ledgerTolerance = 1e-6
+
+// IsBalanced reports whether the transaction moves points between at least
+// two accounts without creating or destroying any.
+func (t *LedgerTransaction) IsBalanced() bool {
+	if len(t.Entries) < 2 {
+		return false
+	}
+
+	sum := 0.0
+	for _, entry := range t.Entries {
+		sum += entry.Amount
+	}
+
+	return math.Abs(sum) < ledgerTolerance
+}
+
+// BalanceMismatch flags a user whose cached balance is not the sum of the
+// ledger entries of their account.
+type BalanceMismatch struct {
+	UserID       string    `json:"user_address"`
+	CachedPoints float64   `json:"cached_points"`
+	LedgerPoints float64   `json:"ledger_points"`
+	DetectedAt   time.Time `json:"detected_at"`
+}
+
+// Difference is what the cached balance has in excess of the ledger.
+func (m *BalanceMismatch) Difference() float64 {
+	return m.CachedPoints - m.LedgerPoints
+}
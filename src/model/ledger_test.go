@@ -0,0 +1,41 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLedgerAccount_UserID(t *testing.T) {
+	assert.Equal(t, "0xabc", UserLedgerAccount("0xabc").UserID())
+	assert.Equal(t, "", LedgerAccountRewards.UserID())
+}
+
+func TestLedgerTransaction_IsBalanced(t *testing.T) {
+	now := time.Now().UTC()
+
+	t.Run("Credit", func(t *testing.T) {
+		transaction := NewUserLedgerTransaction(LedgerTransactionKindTaskReward, "0xabc", LedgerAccountRewards, 0.1, now)
+		assert.True(t, transaction.IsBalanced())
+		assert.Equal(t, -0.1, transaction.Entries[1].Amount)
+	})
+
+	t.Run("Debit", func(t *testing.T) {
+		transaction := NewUserLedgerTransaction(LedgerTransactionKindAdjustment, "0xabc", LedgerAccountAdjustments, -40, now)
+		assert.True(t, transaction.IsBalanced())
+		assert.Equal(t, 40.0, transaction.Entries[1].Amount)
+	})
+
+	t.Run("Unbalanced", func(t *testing.T) {
+		transaction := &LedgerTransaction{Entries: []*LedgerEntry{
+			{Account: UserLedgerAccount("0xabc"), Amount: 10},
+			{Account: LedgerAccountRewards, Amount: -9},
+		}}
+		assert.False(t, transaction.IsBalanced())
+	})
+
+	t.Run("Single Entry", func(t *testing.T) {
+		transaction := &LedgerTransaction{Entries: []*LedgerEntry{{Account: LedgerAccountRewards}}}
+		assert.False(t, transaction.IsBalanced())
+	})
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// MilestoneRule is a one-off achievement: the first time a user reaches its
+// threshold, the user completes a task of its type and earns its reward.
+type MilestoneRule struct {
+	// Key identifies the milestone, each user achieves it once.
+	Key        string   `json:"key"`
+	TaskType   TaskType `json:"task_type"`
+	CampaignID int      `json:"campaign_id"`
+	Threshold  float64  `json:"threshold"`
+	Reward     float64  `json:"reward"`
+}
+
+// ReachedBy tells whether value, e.g. a swap amount or a volume, reaches the
+// threshold of the milestone.
+func (r *MilestoneRule) ReachedBy(value float64) bool {
+	return value >= r.Threshold
+}
+
+// OnboardingMilestone is onboarding a campaign, reached by a single swap of
+// the onboarding amount.
+func OnboardingMilestone(campaign *Campaign) *MilestoneRule {
+	return &MilestoneRule{
+		Key:        fmt.Sprintf("%s:%d", TaskTypeOnboarding, campaign.ID),
+		TaskType:   TaskTypeOnboarding,
+		CampaignID: campaign.ID,
+		Threshold:  campaign.OnboardingAmount,
+		Reward:     campaign.OnboardingReward,
+	}
+}
+
+// VolumeMilestone is reached by a lifetime volume across campaigns, it
+// belongs to no campaign.
+func VolumeMilestone(volume float64, reward float64) *MilestoneRule {
+	return &MilestoneRule{
+		Key:       "volume:" + strconv.FormatFloat(volume, 'f', -1, 64),
+		TaskType:  TaskTypeVolumeMilestone,
+		Threshold: volume,
+		Reward:    reward,
+	}
+}
@@ -0,0 +1,20 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestMilestoneRule(t *testing.T) {
+	onboarding := OnboardingMilestone(&Campaign{ID: 3, OnboardingAmount: 1000, OnboardingReward: 100})
+	assert.Equal(t, "on_boarding:3", onboarding.Key)
+	assert.Equal(t, 3, onboarding.CampaignID)
+	assert.True(t, onboarding.ReachedBy(1000))
+	assert.False(t, onboarding.ReachedBy(999))
+
+	volume := VolumeMilestone(1000000, 10000)
+	assert.Equal(t, "volume:1000000", volume.Key)
+	assert.Equal(t, TaskTypeVolumeMilestone, volume.TaskType)
+	assert.Equal(t, 0, volume.CampaignID)
+	assert.Equal(t, "volume:12500.5", VolumeMilestone(12500.5, 1).Key)
+}
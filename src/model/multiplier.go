@@ -0,0 +1,66 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Multiplier boosts the rewards of a promotion, e.g. 2x this weekend or 1.5x
+// for the holders of an NFT. Onboarding rewards are multiplied, shared pool
+// swaps weigh more in the split of the period budget.
+type Multiplier struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Factor float64 `json:"factor"`
+	// CampaignID is 0 for every campaign.
+	CampaignID int `json:"campaign_id"`
+	// UserIDs is empty for every user.
+	UserIDs   []string  `json:"user_ids"`
+	StartTime time.Time `json:"start_time"`
+	// EndTime is nil for a multiplier without end.
+	EndTime   *time.Time `json:"end_time"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// AppliesTo reports whether the multiplier boosts what the user earns in the
+// campaign at the given time.
+func (m *Multiplier) AppliesTo(userID string, campaignID int, at time.Time) bool {
+	if m.CampaignID != 0 && m.CampaignID != campaignID {
+		return false
+	}
+
+	if at.Before(m.StartTime) || (m.EndTime != nil && !at.Before(*m.EndTime)) {
+		return false
+	}
+
+	return len(m.UserIDs) == 0 || slices.ContainsFunc(m.UserIDs, func(id string) bool {
+		return strings.EqualFold(id, userID)
+	})
+}
+
+// AppliedMultiplier is recorded on the rewards a multiplier boosted.
+type AppliedMultiplier struct {
+	ID     int     `json:"id"`
+	Name   string  `json:"name"`
+	Factor float64 `json:"factor"`
+}
+
+// ApplyMultipliers returns the product of the factors of the multipliers
+// applying to the user in the campaign at the given time, 1 when none does,
+// and the multipliers it applied.
+func ApplyMultipliers(multipliers []*Multiplier, userID string, campaignID int, at time.Time) (float64, []*AppliedMultiplier) {
+	factor := 1.0
+	var applied []*AppliedMultiplier
+
+	for _, multiplier := range multipliers {
+		if !multiplier.AppliesTo(userID, campaignID, at) {
+			continue
+		}
+
+		factor *= multiplier.Factor
+		applied = append(applied, &AppliedMultiplier{ID: multiplier.ID, Name: multiplier.Name, Factor: multiplier.Factor})
+	}
+
+	return factor, applied
+}
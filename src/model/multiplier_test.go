@@ -0,0 +1,38 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestApplyMultipliers(t *testing.T) {
+	weekendStart := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+	weekendEnd := weekendStart.Add(48 * time.Hour)
+
+	multipliers := []*Multiplier{
+		{ID: 1, Name: "weekend", Factor: 2, StartTime: weekendStart, EndTime: &weekendEnd},
+		{ID: 2, Name: "nft holders", Factor: 1.5, UserIDs: []string{"0xABC"}, StartTime: weekendStart.AddDate(0, -1, 0)},
+		{ID: 3, Name: "other campaign", Factor: 3, CampaignID: 2, StartTime: weekendStart.AddDate(0, -1, 0)},
+	}
+
+	t.Run("Stacked", func(t *testing.T) {
+		factor, applied := ApplyMultipliers(multipliers, "0xabc", 1, weekendStart.Add(time.Hour))
+		assert.Equal(t, 3.0, factor)
+		assert.Equal(t, []*AppliedMultiplier{
+			{ID: 1, Name: "weekend", Factor: 2},
+			{ID: 2, Name: "nft holders", Factor: 1.5},
+		}, applied)
+	})
+
+	t.Run("Outside of the Window", func(t *testing.T) {
+		factor, applied := ApplyMultipliers(multipliers, "0xdef", 1, weekendEnd)
+		assert.Equal(t, 1.0, factor)
+		assert.Empty(t, applied)
+	})
+
+	t.Run("Campaign", func(t *testing.T) {
+		factor, _ := ApplyMultipliers(multipliers, "0xdef", 2, weekendStart.AddDate(0, 0, -1))
+		assert.Equal(t, 3.0, factor)
+	})
+}
@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// UserPeriodStats aggregates the swaps of a user during one campaign period. It
+// is updated on every processed swap so projections need not scan the tasks.
+type UserPeriodStats struct {
+	CampaignID  int     `json:"campaign_id"`
+	PeriodIndex int     `json:"period_index"`
+	UserID      string  `json:"user_id"`
+	Volume      float64 `json:"volume"`
+	SwapCount   int     `json:"swap_count"`
+	Onboarded   bool    `json:"onboarded"`
+	// Weight is the volume weighed as the shared pool weighs it, by the
+	// multipliers and the tier of the user when they swapped.
+	Weight float64 `json:"weight"`
+	// Points is the reward of the period, filled in when the period is settled.
+	Points    float64   `json:"points"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PeriodTotals struct {
+	Volume float64 `json:"volume"`
+	// EligibleVolume only counts onboarded users, it is the denominator of the
+	// shared pool formula.
+	EligibleVolume float64 `json:"eligible_volume"`
+	// EligibleWeight is the weight of the onboarded users, the shared pool
+	// splits the budget by it.
+	EligibleWeight float64 `json:"eligible_weight"`
+	Participants   int     `json:"participants"`
+}
+
+type RewardProjection struct {
+	CampaignID      int          `json:"campaign_id"`
+	CampaignName    string       `json:"campaign_name"`
+	PeriodIndex     int          `json:"period_index"`
+	StartTime       time.Time    `json:"start_time"`
+	EndTime         time.Time    `json:"end_time"`
+	Budget          float64      `json:"budget"`
+	UserVolume      float64      `json:"user_volume"`
+	Onboarded       bool         `json:"onboarded"`
+	Totals          PeriodTotals `json:"totals"`
+	Share           float64      `json:"share"`
+	ProjectedPoints float64      `json:"projected_points"`
+}
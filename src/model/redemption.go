@@ -0,0 +1,45 @@
+package model
+
+import "time"
+
+// CatalogueItem is something points can be redeemed for.
+type CatalogueItem struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Points float64 `json:"points"`
+}
+
+type RedemptionStatus string
+
+const (
+	// RedemptionStatusPending redemptions have spent their points and wait to be fulfilled.
+	RedemptionStatusPending   RedemptionStatus = "pending"
+	RedemptionStatusFulfilled RedemptionStatus = "fulfilled"
+	// RedemptionStatusCancelled redemptions had their points refunded.
+	RedemptionStatusCancelled RedemptionStatus = "cancelled"
+)
+
+func (s RedemptionStatus) IsValid() bool {
+	switch s {
+	case RedemptionStatusPending, RedemptionStatusFulfilled, RedemptionStatusCancelled:
+		return true
+	}
+	return false
+}
+
+type Redemption struct {
+	ID     int              `json:"id"`
+	UserID string           `json:"user_id"`
+	ItemID string           `json:"item_id"`
+	Points float64          `json:"points"`
+	Status RedemptionStatus `json:"status"`
+	// LedgerTransactionID spent the points, RefundLedgerTransactionID gave
+	// them back when the redemption was cancelled.
+	LedgerTransactionID       int `json:"ledger_transaction_id"`
+	RefundLedgerTransactionID int `json:"refund_ledger_transaction_id,omitempty"`
+	// Reason explains a cancellation, Operator fulfilled or cancelled the redemption.
+	Reason    string    `json:"reason,omitempty"`
+	Operator  string    `json:"operator,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var referralCodePattern = regexp.MustCompile(`^[A-Z0-9]{4,32}$`)
+
+// NormalizeReferralCode makes codes case-insensitive, they are stored upper
+// case.
+func NormalizeReferralCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// IsValidReferralCode tells whether the normalized code has 4 to 32 letters
+// and digits.
+func IsValidReferralCode(code string) bool {
+	return referralCodePattern.MatchString(code)
+}
+
+// ReferralLinkMessage is the text the referee personal_signs to link its
+// address to the code.
+func ReferralLinkMessage(code string, refereeID string) string {
+	return fmt.Sprintf("Link %s to the referral code %s", refereeID, code)
+}
+
+type ReferralCode struct {
+	Code      string    `json:"code"`
+	UserID    string    `json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Referral links a new address, the referee, to the code of its referrer. The
+// referrer is rewarded when the referee onboards, and then earns a share of
+// the shared pool rewards of the referee.
+type Referral struct {
+	RefereeID   string     `json:"referee_id"`
+	ReferrerID  string     `json:"referrer_id"`
+	Code        string     `json:"code"`
+	CreatedAt   time.Time  `json:"created_at"`
+	OnboardedAt *time.Time `json:"onboarded_at"`
+}
+
+// ReferralRewards are what a referrer earns from its referees.
+type ReferralRewards struct {
+	// OnboardingReward is the points of the referrer when a referee onboards.
+	OnboardingReward float64 `json:"onboarding_reward"`
+	// SharedPoolShare is the part of the shared pool points of a referee the
+	// referrer earns on top, e.g. 0.1 for 10%.
+	SharedPoolShare float64 `json:"shared_pool_share"`
+}
+
+// ReferralInfo is the referral code of a user, who referred it and who it
+// referred.
+type ReferralInfo struct {
+	Code       *ReferralCode
+	ReferredBy *Referral
+	Referrals  []*Referral
+}
@@ -0,0 +1,17 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestReferralCode(t *testing.T) {
+	assert.Equal(t, "ALICE42", NormalizeReferralCode(" alice42 "))
+	assert.True(t, IsValidReferralCode("ALICE42"))
+	assert.False(t, IsValidReferralCode("ABC"))
+	assert.False(t, IsValidReferralCode("ALICE-42"))
+	assert.False(t, IsValidReferralCode("alice42"))
+	assert.Equal(t,
+		"Link 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266 to the referral code ALICE42",
+		ReferralLinkMessage("ALICE42", "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"))
+}
@@ -2,12 +2,58 @@ package model
 
 import "time"
 
+// RewardRecordType tells how the points of a record were earned or lost.
+type RewardRecordType string
+
+const (
+	RewardRecordTypeTaskReward RewardRecordType = "task_reward"
+	// RewardRecordTypeAdjustment is a manual credit or debit, it has no task.
+	RewardRecordTypeAdjustment RewardRecordType = "adjustment"
+)
+
 type RewardRecord struct {
-	ID            int       `json:"id"`
-	UserID        string    `json:"user_id"`
-	Points        float64   `json:"points"`
-	TaskID        int       `json:"task_id"`
-	OriginPoints  float64   `json:"origin_points"`
-	UpdatedPoints float64   `json:"updated_points"`
-	CreatedAt     time.Time `json:"created_at"`
+	ID         int              `json:"id"`
+	UserID     string           `json:"user_id"`
+	CampaignID int              `json:"campaign_id"`
+	Type       RewardRecordType `json:"type"`
+	Points     float64          `json:"points"`
+	// TaskID is 0 for adjustments.
+	TaskID        int     `json:"task_id"`
+	OriginPoints  float64 `json:"origin_points"`
+	UpdatedPoints float64 `json:"updated_points"`
+	Reason        string  `json:"reason,omitempty"`
+	Operator      string  `json:"operator,omitempty"`
+	// LedgerTransactionID is the ledger transaction that moved the points.
+	LedgerTransactionID int `json:"ledger_transaction_id,omitempty"`
+	// Multipliers boosted the points of a task reward.
+	Multipliers []*AppliedMultiplier `json:"multipliers,omitempty"`
+	CreatedAt   time.Time            `json:"created_at"`
+}
+
+// AdjustmentDirection is whether an adjustment credits or debits the user.
+type AdjustmentDirection string
+
+const (
+	AdjustmentDirectionCredit AdjustmentDirection = "credit"
+	AdjustmentDirectionDebit  AdjustmentDirection = "debit"
+)
+
+// PointAdjustment is a manual correction of the points of a user, e.g. the
+// clawback of a double credit.
+type PointAdjustment struct {
+	UserID     string
+	CampaignID int
+	Direction  AdjustmentDirection
+	// Points is positive, Direction gives the sign.
+	Points   float64
+	Reason   string
+	Operator string
+}
+
+// SignedPoints is the change of balance of the adjustment.
+func (a *PointAdjustment) SignedPoints() float64 {
+	if a.Direction == AdjustmentDirectionDebit {
+		return -a.Points
+	}
+	return a.Points
 }
@@ -0,0 +1,10 @@
+package model
+
+import "time"
+
+// Session is opened by signing in with Ethereum, Token proves the caller owns Address.
+type Session struct {
+	Address   string    `json:"address"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
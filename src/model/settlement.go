@@ -0,0 +1,49 @@
+package model
+
+import "time"
+
+type Settlement struct {
+	ID          int       `json:"id"`
+	CampaignID  int       `json:"campaign_id"`
+	PeriodIndex int       `json:"period_index"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	SettledAt   time.Time `json:"settled_at"`
+	// CompletedAt is nil until every payout of the settlement is paid.
+	CompletedAt *time.Time `json:"completed_at"`
+	// DistributedAt is nil until the claims of the completed settlement are
+	// distributed.
+	DistributedAt *time.Time `json:"distributed_at"`
+}
+
+func (s *Settlement) IsCompleted() bool {
+	return s.CompletedAt != nil
+}
+
+func (s *Settlement) IsDistributed() bool {
+	return s.DistributedAt != nil
+}
+
+// SharedPoolPayout is the part of a period budget earned by a shared pool task.
+type SharedPoolPayout struct {
+	TaskID     int     `json:"task_id"`
+	UserID     string  `json:"user_id"`
+	SwapAmount float64 `json:"swap_amount"`
+	// Weight is the swap amount times the multipliers of the task, the share
+	// is the weight over the weight of every task of the period.
+	Weight      float64              `json:"weight"`
+	Multipliers []*AppliedMultiplier `json:"multipliers,omitempty"`
+	Share       float64              `json:"share"`
+	Points      float64              `json:"points"`
+}
+
+type SettlementPreview struct {
+	CampaignID      int                 `json:"campaign_id"`
+	PeriodIndex     int                 `json:"period_index"`
+	StartTime       time.Time           `json:"start_time"`
+	EndTime         time.Time           `json:"end_time"`
+	Budget          float64             `json:"budget"`
+	TotalSwapAmount float64             `json:"total_swap_amount"`
+	Settled         bool                `json:"settled"`
+	Payouts         []*SharedPoolPayout `json:"payouts"`
+}
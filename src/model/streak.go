@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// StreakPeriods are the periods streaks are counted in, UTC days and weeks
+// starting on Monday.
+var StreakPeriods = []PeriodType{PeriodTypeDaily, PeriodTypeWeekly}
+
+// Streak counts the consecutive periods a user swapped in.
+type Streak struct {
+	UserID  string     `json:"user_id"`
+	Period  PeriodType `json:"period"`
+	Current int        `json:"current"`
+	Best    int        `json:"best"`
+	// LastPeriod is the start of the last period the user swapped in.
+	LastPeriod time.Time `json:"last_period"`
+}
+
+// StreakPeriodStart returns the start of the daily or weekly period of at.
+func StreakPeriodStart(period PeriodType, at time.Time) time.Time {
+	at = at.UTC()
+	day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC)
+	if period == PeriodTypeWeekly {
+		// weeks start on Monday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	}
+	return day
+}
+
+// PreviousStreakPeriod returns the start of the period before the one
+// starting at start.
+func PreviousStreakPeriod(period PeriodType, start time.Time) time.Time {
+	if period == PeriodTypeWeekly {
+		return start.AddDate(0, 0, -7)
+	}
+	return start.AddDate(0, 0, -1)
+}
+
+// Start returns the start of the first period of the current streak.
+func (s *Streak) Start() time.Time {
+	start := s.LastPeriod
+	for i := 1; i < s.Current; i++ {
+		start = PreviousStreakPeriod(s.Period, start)
+	}
+	return start
+}
+
+// CurrentAt is the current streak at now, 0 once the user missed a period. A
+// streak is not broken until the period after its last one is over.
+func (s *Streak) CurrentAt(now time.Time) int {
+	if s == nil || s.LastPeriod.IsZero() {
+		return 0
+	}
+
+	previous := PreviousStreakPeriod(s.Period, StreakPeriodStart(s.Period, now))
+	if s.LastPeriod.Before(previous) {
+		return 0
+	}
+	return s.Current
+}
+
+// StreakMilestone rewards the users whose streak of the period reaches Length.
+// A user earns it again when a new streak reaches it after the previous one
+// broke.
+type StreakMilestone struct {
+	Period PeriodType `json:"period"`
+	Length int        `json:"length"`
+	Reward float64    `json:"reward"`
+}
+
+// Rule returns the milestone of streak. Its key names the first period of the
+// streak, so a user achieves it once per streak.
+func (m *StreakMilestone) Rule(streak *Streak) *MilestoneRule {
+	return &MilestoneRule{
+		Key:       fmt.Sprintf("streak:%s:%d:%s", m.Period, m.Length, streak.Start().Format(time.DateOnly)),
+		TaskType:  TaskTypeStreak,
+		Threshold: float64(m.Length),
+		Reward:    m.Reward,
+	}
+}
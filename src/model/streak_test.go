@@ -0,0 +1,41 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStreakPeriodStart(t *testing.T) {
+	// 2024-09-11 is a Wednesday
+	at := time.Date(2024, 9, 11, 15, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC), StreakPeriodStart(PeriodTypeDaily, at))
+	assert.Equal(t, time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC), StreakPeriodStart(PeriodTypeWeekly, at))
+	assert.Equal(t, time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC), StreakPeriodStart(PeriodTypeWeekly, time.Date(2024, 9, 15, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC), PreviousStreakPeriod(PeriodTypeWeekly, StreakPeriodStart(PeriodTypeWeekly, at)))
+}
+
+func TestStreak_CurrentAt(t *testing.T) {
+	streak := &Streak{Period: PeriodTypeDaily, Current: 3, Best: 5, LastPeriod: time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)}
+
+	assert.Equal(t, 3, streak.CurrentAt(time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 3, streak.CurrentAt(time.Date(2024, 9, 11, 23, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, streak.CurrentAt(time.Date(2024, 9, 12, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 0, (*Streak)(nil).CurrentAt(time.Now()))
+}
+
+func TestStreakMilestone_Rule(t *testing.T) {
+	milestone := &StreakMilestone{Period: PeriodTypeWeekly, Length: 4, Reward: 200}
+	streak := &Streak{Period: PeriodTypeWeekly, Current: 5, LastPeriod: time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC)}
+
+	rule := milestone.Rule(streak)
+	// the streak started 4 weeks before its last one
+	assert.Equal(t, "streak:weekly:4:2024-08-12", rule.Key)
+	assert.Equal(t, TaskTypeStreak, rule.TaskType)
+	assert.Equal(t, 200.0, rule.Reward)
+
+	// a new streak earns the milestone again
+	streak = &Streak{Period: PeriodTypeWeekly, Current: 4, LastPeriod: time.Date(2024, 11, 4, 0, 0, 0, 0, time.UTC)}
+	assert.Equal(t, "streak:weekly:4:2024-10-14", milestone.Rule(streak).Key)
+}
@@ -12,15 +12,40 @@ const (
 	TaskStatusDone    TaskStatus = "done"
 )
 
+func (s TaskStatus) IsValid() bool {
+	switch s {
+	case TaskStatusPending, TaskStatusDone:
+		return true
+	}
+	return false
+}
+
 type TaskType string
 
 const (
 	TaskTypeOnboarding TaskType = "on_boarding"
 	TaskTypeSharedPool TaskType = "shared_pool"
+	// TaskTypeReferral rewards a referrer for the onboarding or the shared
+	// pool rewards of a referee.
+	TaskTypeReferral TaskType = "referral"
+	// TaskTypeStreak rewards a streak milestone, it belongs to no campaign.
+	TaskTypeStreak TaskType = "streak"
+	// TaskTypeVolumeMilestone rewards a lifetime volume milestone, it belongs
+	// to no campaign.
+	TaskTypeVolumeMilestone TaskType = "volume_milestone"
 )
 
+func (t TaskType) IsValid() bool {
+	switch t {
+	case TaskTypeOnboarding, TaskTypeSharedPool, TaskTypeReferral, TaskTypeStreak, TaskTypeVolumeMilestone:
+		return true
+	}
+	return false
+}
+
 type Task struct {
 	ID          int          `json:"id"`
+	CampaignID  int          `json:"campaign_id"`
 	Status      TaskStatus   `json:"status"`
 	Type        TaskType     `json:"type"`
 	UserID      string       `json:"user_id"`
@@ -29,8 +54,9 @@ type Task struct {
 	CompletedAt sql.NullTime `json:"completed_at"`
 }
 
-func NewTask(userID string, taskType TaskType, swapAmount float64) *Task {
+func NewTask(userID string, campaignID int, taskType TaskType, swapAmount float64) *Task {
 	return &Task{
+		CampaignID:  campaignID,
 		Status:      TaskStatusPending,
 		UserID:      userID,
 		Type:        taskType,
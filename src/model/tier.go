@@ -0,0 +1,88 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Tier is a level of users reached by their rolling volume across campaigns.
+// Its multiplier boosts their rewards, up to BonusCap extra points per reward.
+type Tier struct {
+	Name       string  `json:"name"`
+	MinVolume  float64 `json:"min_volume"`
+	Multiplier float64 `json:"multiplier"`
+	// BonusCap is 0 for a bonus without cap.
+	BonusCap float64 `json:"bonus_cap"`
+}
+
+// Factor is the multiplier of the tier, 1 for a user without tier.
+func (t *Tier) Factor() float64 {
+	if t == nil || t.Multiplier <= 0 {
+		return 1
+	}
+	return t.Multiplier
+}
+
+// AppliedMultiplier records the tier on the rewards it boosted, nil when it
+// doesn't boost anything.
+func (t *Tier) AppliedMultiplier() *AppliedMultiplier {
+	if t.Factor() == 1 {
+		return nil
+	}
+	return &AppliedMultiplier{Name: t.Name + " tier", Factor: t.Multiplier}
+}
+
+// CapBonus caps the extra points the tier multiplier added to points.
+func (t *Tier) CapBonus(points float64) float64 {
+	if t == nil || t.BonusCap <= 0 || t.Factor() <= 1 {
+		return points
+	}
+
+	bonus := points - points/t.Factor()
+	return points - max(bonus-t.BonusCap, 0)
+}
+
+// TierSchedule is the list of tiers by increasing volume.
+type TierSchedule []*Tier
+
+func NewTierSchedule(tiers []*Tier) TierSchedule {
+	schedule := make(TierSchedule, len(tiers))
+	copy(schedule, tiers)
+	sort.SliceStable(schedule, func(i, j int) bool {
+		return schedule[i].MinVolume < schedule[j].MinVolume
+	})
+	return schedule
+}
+
+// TierOf returns the highest tier the volume reaches, nil when it reaches none.
+func (s TierSchedule) TierOf(volume float64) *Tier {
+	var tier *Tier
+	for _, candidate := range s {
+		if volume < candidate.MinVolume {
+			break
+		}
+		tier = candidate
+	}
+	return tier
+}
+
+// Get returns the tier of the name, nil when there's none.
+func (s TierSchedule) Get(name string) *Tier {
+	for _, tier := range s {
+		if tier.Name == name {
+			return tier
+		}
+	}
+	return nil
+}
+
+// TierChange is a row of the tier history of a user. FromTier is empty for the
+// first tier of the user, ToTier when the user dropped below every tier.
+type TierChange struct {
+	ID            int       `json:"id"`
+	UserID        string    `json:"user_id"`
+	FromTier      string    `json:"from_tier"`
+	ToTier        string    `json:"to_tier"`
+	RollingVolume float64   `json:"rolling_volume"`
+	CreatedAt     time.Time `json:"created_at"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTierSchedule(t *testing.T) {
+	gold := &Tier{Name: "gold", MinVolume: 100000, Multiplier: 1.5, BonusCap: 100}
+	silver := &Tier{Name: "silver", MinVolume: 10000, Multiplier: 1.2}
+	bronze := &Tier{Name: "bronze", MinVolume: 1000, Multiplier: 1}
+	schedule := NewTierSchedule([]*Tier{gold, bronze, silver})
+
+	t.Run("TierOf", func(t *testing.T) {
+		assert.Nil(t, schedule.TierOf(999))
+		assert.Equal(t, bronze, schedule.TierOf(1000))
+		assert.Equal(t, silver, schedule.TierOf(99999))
+		assert.Equal(t, gold, schedule.TierOf(1e7))
+	})
+
+	t.Run("Get", func(t *testing.T) {
+		assert.Equal(t, silver, schedule.Get("silver"))
+		assert.Nil(t, schedule.Get("platinum"))
+	})
+
+	t.Run("Benefits", func(t *testing.T) {
+		var none *Tier
+		assert.Equal(t, 1.0, none.Factor())
+		assert.Nil(t, none.AppliedMultiplier())
+		assert.Nil(t, bronze.AppliedMultiplier())
+		assert.Equal(t, &AppliedMultiplier{Name: "gold tier", Factor: 1.5}, gold.AppliedMultiplier())
+
+		assert.Equal(t, 150.0, gold.CapBonus(150))
+		assert.Equal(t, 1100.0, gold.CapBonus(1500))
+		assert.Equal(t, 1200.0, silver.CapBonus(1200))
+		assert.Equal(t, 42.0, none.CapBonus(42))
+	})
+}
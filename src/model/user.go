@@ -3,6 +3,8 @@ package model
 type User struct {
 	ID     string  `json:"id"`
 	Points float64 `json:"points"`
+	// Tier is empty until the volume of the user reaches a tier.
+	Tier string `json:"tier"`
 }
 
 func NewUser(id string) *User {
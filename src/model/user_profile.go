@@ -0,0 +1,36 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+)
+
+// UserCampaignActivity sums up the tasks of a user in one campaign.
+type UserCampaignActivity struct {
+	CampaignID  int
+	Volume      float64
+	SwapCount   int
+	OnboardedAt sql.NullTime
+}
+
+type UserProfile struct {
+	User      *User                  `json:"user"`
+	Streaks   []*Streak              `json:"streaks"`
+	Campaigns []*UserCampaignProfile `json:"campaigns"`
+}
+
+type UserCampaignProfile struct {
+	CampaignID     int            `json:"campaign_id"`
+	CampaignName   string         `json:"campaign_name"`
+	CampaignStatus CampaignStatus `json:"campaign_status"`
+	Onboarded      bool           `json:"onboarded"`
+	OnboardedAt    *time.Time     `json:"onboarded_at"`
+	LifetimeVolume float64        `json:"lifetime_volume"`
+	SwapCount      int            `json:"swap_count"`
+	Points         float64        `json:"points"`
+	// CurrentPeriodIndex is nil when the campaign is not running.
+	CurrentPeriodIndex  *int    `json:"current_period_index"`
+	CurrentPeriodVolume float64 `json:"current_period_volume"`
+	// Rank is the campaign wide rank by volume, 0 when the user is not ranked.
+	Rank int `json:"rank"`
+}
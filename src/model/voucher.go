@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Voucher is an EIP-712 signed statement that the account can claim amount
+// token base units until expiry. The nonce is used once per account so a
+// voucher can't be replayed.
+type Voucher struct {
+	ID        int       `json:"id"`
+	Account   string    `json:"account"`
+	Nonce     int64     `json:"nonce"`
+	Amount    string    `json:"amount"`
+	Expiry    time.Time `json:"expiry"`
+	Signature string    `json:"signature"`
+	CreatedAt time.Time `json:"created_at"`
+	// Domain is set on issue only, it isn't stored.
+	Domain *VoucherDomain `json:"-"`
+}
+
+// VoucherDomain is the EIP-712 domain the voucher is signed in, the contract
+// recovers Signer from the signature to accept it.
+type VoucherDomain struct {
+	Name              string `json:"name"`
+	Version           string `json:"version"`
+	ChainID           int64  `json:"chain_id"`
+	VerifyingContract string `json:"verifying_contract"`
+	Signer            string `json:"signer"`
+}
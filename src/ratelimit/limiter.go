@@ -0,0 +1,53 @@
+// Package ratelimit throttles clients with token buckets holding Limit tokens
+// and refilled at Limit tokens per Period, so a client can burst Limit
+// requests and then sustain Limit requests per Period.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+type Result struct {
+	Allowed bool
+	Limit   int
+	// Remaining is the number of whole tokens left in the bucket.
+	Remaining int
+	// ResetAfter is the time until the bucket is full again.
+	ResetAfter time.Duration
+	// RetryAfter is the time until the next token when the request was denied.
+	RetryAfter time.Duration
+}
+
+type Limiter interface {
+	// Allow takes a token from the bucket of the key.
+	Allow(ctx context.Context, key string) (*Result, error)
+	Limit() int
+	Period() time.Duration
+}
+
+// newResult describes the bucket once the request took its token or was denied.
+func newResult(allowed bool, tokens float64, limit int, period time.Duration) *Result {
+	perToken := period / time.Duration(limit)
+	result := &Result{
+		Allowed:    allowed,
+		Limit:      limit,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: time.Duration((float64(limit) - tokens) * float64(perToken)),
+	}
+
+	if !allowed {
+		result.RetryAfter = time.Duration((1 - tokens) * float64(perToken))
+	}
+
+	return result
+}
+
+// refill returns the tokens of a bucket after elapsed time.
+func refill(tokens float64, elapsed time.Duration, limit int, period time.Duration) float64 {
+	if elapsed > 0 {
+		tokens += elapsed.Seconds() * float64(limit) / period.Seconds()
+	}
+	return math.Min(tokens, float64(limit))
+}
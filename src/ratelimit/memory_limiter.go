@@ -0,0 +1,89 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// memoryLimiter keeps the buckets in the process, each replica limits on its own.
+type memoryLimiter struct {
+	limit   int
+	period  time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// NewMemoryLimiter returns a limiter dropping the buckets refilled since once
+// per period, in the background so requests never wait for a sweep.
+func NewMemoryLimiter(limit int, period time.Duration) Limiter {
+	l := &memoryLimiter{
+		limit:   limit,
+		period:  period,
+		now:     time.Now,
+		buckets: make(map[string]*bucket),
+	}
+
+	go l.sweepEvery(period)
+
+	return l
+}
+
+func (l *memoryLimiter) Allow(_ context.Context, key string) (*Result, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.limit), updatedAt: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = refill(b.tokens, now.Sub(b.updatedAt), l.limit, l.period)
+	b.updatedAt = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+
+	return newResult(allowed, b.tokens, l.limit, l.period), nil
+}
+
+// sweepEvery sweeps the buckets on every tick. The limiter lives as long as
+// the process, so does the ticker.
+func (l *memoryLimiter) sweepEvery(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		l.mu.Lock()
+		l.sweep(l.now())
+		l.mu.Unlock()
+	}
+}
+
+// sweep drops the buckets refilled by now, they are the same as new ones. The
+// caller holds the lock.
+func (l *memoryLimiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if refill(b.tokens, now.Sub(b.updatedAt), l.limit, l.period) >= float64(l.limit) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *memoryLimiter) Limit() int {
+	return l.limit
+}
+
+func (l *memoryLimiter) Period() time.Duration {
+	return l.period
+}
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(3, 3*time.Second).(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+
+	for i := 2; i >= 0; i-- {
+		result, err := limiter.Allow(context.Background(), "client")
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, i, result.Remaining)
+	}
+
+	result, _ := limiter.Allow(context.Background(), "client")
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+	assert.Equal(t, 3*time.Second, result.ResetAfter)
+
+	// Other clients have their own bucket.
+	result, _ = limiter.Allow(context.Background(), "other")
+	assert.True(t, result.Allowed)
+
+	// One token is back after a third of the period.
+	now = now.Add(time.Second)
+	result, _ = limiter.Allow(context.Background(), "client")
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	// The bucket never holds more than the limit.
+	now = now.Add(time.Hour)
+	result, _ = limiter.Allow(context.Background(), "client")
+	assert.Equal(t, 2, result.Remaining)
+	assert.Equal(t, time.Second, result.ResetAfter)
+}
+
+func TestMemoryLimiter_Sweep(t *testing.T) {
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	limiter := NewMemoryLimiter(2, time.Minute).(*memoryLimiter)
+	limiter.now = func() time.Time { return now }
+
+	limiter.Allow(context.Background(), "idle")
+	limiter.Allow(context.Background(), "busy")
+
+	now = now.Add(20 * time.Second)
+	limiter.Allow(context.Background(), "busy")
+	limiter.Allow(context.Background(), "busy")
+
+	now = now.Add(20 * time.Second)
+	limiter.sweep(now)
+
+	assert.NotContains(t, limiter.buckets, "idle")
+	assert.Contains(t, limiter.buckets, "busy")
+}
+
+func TestMemoryLimiter_SweepIdleBucketsEveryPeriod(t *testing.T) {
+	limiter := NewMemoryLimiter(2, 20*time.Millisecond).(*memoryLimiter)
+
+	limiter.Allow(context.Background(), "idle")
+
+	// requests never sweep, the ticker does
+	assert.Eventually(t, func() bool {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		_, ok := limiter.buckets["idle"]
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+}
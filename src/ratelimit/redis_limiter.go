@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+// tokenBucketScript refills and takes a token from the bucket hash at KEYS[1]
+// atomically. ARGV: limit, period in milliseconds, now in milliseconds.
+// Returns whether the token was taken and the tokens left, as a string to keep
+// the fraction.
+var tokenBucketScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local period = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local bucket = redis.call("HMGET", KEYS[1], "tokens", "updated_at")
+local tokens = tonumber(bucket[1]) or limit
+local updatedAt = tonumber(bucket[2]) or now
+
+if now > updatedAt then
+	tokens = math.min(limit, tokens + (now - updatedAt) * limit / period)
+end
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated_at", tostring(math.max(now, updatedAt)))
+redis.call("PEXPIRE", KEYS[1], period)
+return {allowed, tostring(tokens)}
+`)
+
+// redisLimiter shares the buckets between replicas. Buckets expire once
+// refilled, so idle clients cost nothing.
+type redisLimiter struct {
+	client    redis.Scripter
+	keyPrefix string
+	limit     int
+	period    time.Duration
+	now       func() time.Time
+}
+
+func NewRedisLimiter(client redis.Scripter, keyPrefix string, limit int, period time.Duration) Limiter {
+	return &redisLimiter{
+		client:    client,
+		keyPrefix: keyPrefix,
+		limit:     limit,
+		period:    period,
+		now:       time.Now,
+	}
+}
+
+func (l *redisLimiter) Allow(ctx context.Context, key string) (*Result, error) {
+	values, err := tokenBucketScript.Run(ctx, l.client, []string{l.keyPrefix + key},
+		l.limit, l.period.Milliseconds(), l.now().UnixMilli()).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, _ := values[0].(int64)
+	tokensValue, _ := values[1].(string)
+	tokens, err := strconv.ParseFloat(tokensValue, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return newResult(allowed == 1, tokens, l.limit, l.period), nil
+}
+
+func (l *redisLimiter) Limit() int {
+	return l.limit
+}
+
+func (l *redisLimiter) Period() time.Duration {
+	return l.period
+}
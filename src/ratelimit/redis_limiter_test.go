@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"testing"
+	"time"
+)
+
+// The Redis of the job queue in the development config, REDIS_ADDR overrides it.
+func newTestRedisClient(t *testing.T) *redis.Client {
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6377"
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	if err := client.Ping(context.Background()).Err(); err != nil {
+		t.Skipf("Redis is not reachable at %s: %v", addr, err)
+	}
+
+	t.Cleanup(func() {
+		client.Del(context.Background(), "test:ratelimit:client")
+		client.Close()
+	})
+
+	return client
+}
+
+func TestRedisLimiter_Allow(t *testing.T) {
+	client := newTestRedisClient(t)
+	now := time.Now()
+	limiter := NewRedisLimiter(client, "test:ratelimit:", 3, 3*time.Second).(*redisLimiter)
+	limiter.now = func() time.Time { return now }
+
+	for i := 2; i >= 0; i-- {
+		result, err := limiter.Allow(context.Background(), "client")
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, i, result.Remaining)
+	}
+
+	result, err := limiter.Allow(context.Background(), "client")
+	assert.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, time.Second, result.RetryAfter)
+
+	now = now.Add(time.Second)
+	result, _ = limiter.Allow(context.Background(), "client")
+	assert.True(t, result.Allowed)
+
+	ttl := client.PTTL(context.Background(), "test:ratelimit:client").Val()
+	assert.True(t, ttl > 0 && ttl <= 3*time.Second)
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const apiKeysTableName = "api_keys"
+
+type APIKeyRepository interface {
+	CreateAPIKey(apiKey *model.APIKey) (*model.APIKey, error)
+	GetActiveAPIKeyByHash(keyHash string) (*model.APIKey, error)
+	SearchAPIKeys() ([]*model.APIKey, error)
+	RevokeAPIKey(id int, revokedAt time.Time) error
+}
+
+type apiKeyRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewAPIKeyRepository() APIKeyRepository {
+	return &apiKeyRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *apiKeyRepositoryImpl) CreateAPIKey(apiKey *model.APIKey) (*model.APIKey, error) {
+	scopes := make([]string, 0, len(apiKey.Scopes))
+	for _, scope := range apiKey.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(apiKeysTableName).
+		Columns("name", "prefix", "key_hash", "role", "scopes", "created_at").
+		Values(apiKey.Name, apiKey.Prefix, apiKey.KeyHash, apiKey.Role, pq.Array(scopes), apiKey.CreatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.dbInstance.QueryRow(sqlCommand, args...).Scan(&apiKey.ID); err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
+
+// GetActiveAPIKeyByHash returns the key of the hash unless it was revoked.
+func (r *apiKeyRepositoryImpl) GetActiveAPIKeyByHash(keyHash string) (*model.APIKey, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("id, name, prefix, key_hash, role, scopes, created_at, revoked_at").
+		From(apiKeysTableName).
+		Where(squirrel.Eq{"key_hash": keyHash, "revoked_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := scanAPIKey(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.APIKeyNotFoundError
+	}
+
+	return apiKey, err
+}
+
+func (r *apiKeyRepositoryImpl) SearchAPIKeys() ([]*model.APIKey, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("id, name, prefix, key_hash, role, scopes, created_at, revoked_at").
+		From(apiKeysTableName).
+		OrderBy("id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var apiKeys []*model.APIKey
+	for rows.Next() {
+		apiKey, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, apiKey)
+	}
+
+	return apiKeys, rows.Err()
+}
+
+func (r *apiKeyRepositoryImpl) RevokeAPIKey(id int, revokedAt time.Time) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(apiKeysTableName).
+		Set("revoked_at", revokedAt.UTC()).
+		Where(squirrel.Eq{"id": id, "revoked_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	result, err := r.dbInstance.Exec(sqlCommand, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return exception.APIKeyNotFoundError
+	}
+
+	return nil
+}
+
+func scanAPIKey(row rowScanner) (*model.APIKey, error) {
+	var apiKey model.APIKey
+	var scopes pq.StringArray
+	var revokedAt sql.NullTime
+
+	err := row.Scan(&apiKey.ID, &apiKey.Name, &apiKey.Prefix, &apiKey.KeyHash, &apiKey.Role, &scopes, &apiKey.CreatedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scope := range scopes {
+		apiKey.Scopes = append(apiKey.Scopes, model.APIKeyScope(scope))
+	}
+
+	apiKey.CreatedAt = apiKey.CreatedAt.In(time.UTC)
+	if revokedAt.Valid {
+		revokedAt.Time = revokedAt.Time.In(time.UTC)
+		apiKey.RevokedAt = &revokedAt.Time
+	}
+
+	return &apiKey, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestAPIKeyRepositoryImpl(t *testing.T) {
+	setUpAPIKeyRepo := func(t *testing.T) *apiKeyRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM api_keys")
+		})
+
+		return &apiKeyRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	newAPIKey := func(hash string) *model.APIKey {
+		return &model.APIKey{
+			Name:      "ops",
+			Prefix:    "ta_1234",
+			KeyHash:   hash,
+			Role:      model.RoleOperator,
+			Scopes:    []model.APIKeyScope{model.APIKeyScopeCampaigns, model.APIKeyScopeSettlements},
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+
+	t.Run("GetActiveAPIKeyByHash", func(t *testing.T) {
+		apiKeyRepo := setUpAPIKeyRepo(t)
+
+		created, err := apiKeyRepo.CreateAPIKey(newAPIKey("hash"))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+
+		apiKey, err := apiKeyRepo.GetActiveAPIKeyByHash("hash")
+		assert.NoError(t, err)
+		assert.Equal(t, created.ID, apiKey.ID)
+		assert.Equal(t, []model.APIKeyScope{model.APIKeyScopeCampaigns, model.APIKeyScopeSettlements}, apiKey.Scopes)
+
+		_, err = apiKeyRepo.GetActiveAPIKeyByHash("unknown")
+		assert.ErrorIs(t, err, exception.APIKeyNotFoundError)
+	})
+
+	t.Run("RevokeAPIKey", func(t *testing.T) {
+		apiKeyRepo := setUpAPIKeyRepo(t)
+
+		created, _ := apiKeyRepo.CreateAPIKey(newAPIKey("hash"))
+
+		assert.NoError(t, apiKeyRepo.RevokeAPIKey(created.ID, time.Now()))
+		assert.ErrorIs(t, apiKeyRepo.RevokeAPIKey(created.ID, time.Now()), exception.APIKeyNotFoundError)
+
+		_, err := apiKeyRepo.GetActiveAPIKeyByHash("hash")
+		assert.ErrorIs(t, err, exception.APIKeyNotFoundError)
+
+		apiKeys, err := apiKeyRepo.SearchAPIKeys()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(apiKeys))
+		assert.NotNil(t, apiKeys[0].RevokedAt)
+	})
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/Masterminds/squirrel"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+const auditLogsTableName = "audit_logs"
+
+type AuditLogRepository interface {
+	CreateAuditLog(auditLog *model.AuditLog) (*model.AuditLog, error)
+}
+
+type auditLogRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewAuditLogRepository() AuditLogRepository {
+	return &auditLogRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *auditLogRepositoryImpl) CreateAuditLog(auditLog *model.AuditLog) (*model.AuditLog, error) {
+	if err := insertAuditLog(r.dbInstance, auditLog); err != nil {
+		return nil, err
+	}
+
+	return auditLog, nil
+}
+
+// insertAuditLog writes the audit log of a change, in the transaction of the
+// change so one is never saved without the other.
+func insertAuditLog(runner queryRower, auditLog *model.AuditLog) error {
+	detail, err := json.Marshal(auditLog.Detail)
+	if err != nil {
+		return err
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(auditLogsTableName).
+		Columns("operator", "action", "target", "detail", "created_at").
+		Values(auditLog.Operator, auditLog.Action, auditLog.Target, detail, auditLog.CreatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	return runner.QueryRow(sqlCommand, args...).Scan(&auditLog.ID)
+}
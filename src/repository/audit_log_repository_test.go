@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+func TestAuditLogRepositoryImpl(t *testing.T) {
+	setUpAuditLogRepo := func(t *testing.T) *auditLogRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM audit_logs")
+		})
+
+		return &auditLogRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	t.Run("CreateAuditLog", func(t *testing.T) {
+		auditLogRepo := setUpAuditLogRepo(t)
+
+		auditLog, err := auditLogRepo.CreateAuditLog(model.NewAuditLog("ops", model.AuditActionExecuteSettlement, "campaign:1:period:0", map[string]any{
+			"payouts": 2,
+		}))
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, auditLog.ID)
+	})
+}
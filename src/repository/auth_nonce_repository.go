@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+)
+
+const authNoncesTableName = "auth_nonces"
+
+type AuthNonceRepository interface {
+	CreateNonce(nonce string, expiresAt time.Time) error
+	ConsumeNonce(nonce string, now time.Time) error
+}
+
+type authNonceRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewAuthNonceRepository() AuthNonceRepository {
+	return &authNonceRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// CreateNonce stores a login nonce, and purges the expired ones on the way.
+func (r *authNonceRepositoryImpl) CreateNonce(nonce string, expiresAt time.Time) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(authNoncesTableName).
+		Where(squirrel.LtOrEq{"expires_at": time.Now().UTC()}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.dbInstance.Exec(sqlCommand, args...); err != nil {
+		return err
+	}
+
+	sqlCommand, args, err = psql.Insert(authNoncesTableName).
+		Columns("nonce", "expires_at").
+		Values(nonce, expiresAt.UTC()).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
+// ConsumeNonce deletes the nonce so it can be used once only. It fails with
+// InvalidNonceError when the nonce is unknown, already used or expired.
+func (r *authNonceRepositoryImpl) ConsumeNonce(nonce string, now time.Time) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(authNoncesTableName).
+		Where(squirrel.Eq{"nonce": nonce}).
+		Where(squirrel.Gt{"expires_at": now.UTC()}).
+		Suffix("RETURNING nonce").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&nonce)
+	if errors.Is(err, sql.ErrNoRows) {
+		return exception.InvalidNonceError
+	}
+
+	return err
+}
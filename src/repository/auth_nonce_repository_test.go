@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+)
+
+func TestAuthNonceRepositoryImpl(t *testing.T) {
+	setUpAuthNonceRepo := func(t *testing.T) *authNonceRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM auth_nonces")
+		})
+
+		return &authNonceRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	t.Run("ConsumeNonce once", func(t *testing.T) {
+		authNonceRepo := setUpAuthNonceRepo(t)
+		now := time.Now().UTC()
+
+		assert.NoError(t, authNonceRepo.CreateNonce("abcdefgh12345678", now.Add(time.Minute)))
+
+		assert.NoError(t, authNonceRepo.ConsumeNonce("abcdefgh12345678", now))
+		assert.ErrorIs(t, authNonceRepo.ConsumeNonce("abcdefgh12345678", now), exception.InvalidNonceError)
+	})
+
+	t.Run("ConsumeNonce expired", func(t *testing.T) {
+		authNonceRepo := setUpAuthNonceRepo(t)
+		now := time.Now().UTC()
+
+		assert.NoError(t, authNonceRepo.CreateNonce("abcdefgh12345678", now.Add(time.Minute)))
+
+		assert.ErrorIs(t, authNonceRepo.ConsumeNonce("abcdefgh12345678", now.Add(2*time.Minute)), exception.InvalidNonceError)
+	})
+
+	t.Run("ConsumeNonce unknown", func(t *testing.T) {
+		authNonceRepo := setUpAuthNonceRepo(t)
+
+		assert.ErrorIs(t, authNonceRepo.ConsumeNonce("unknown1", time.Now()), exception.InvalidNonceError)
+	})
+}
@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const campaignsTableName = "campaigns"
+
+const campaignColumns = "id, name, pools, start_time, period_type, period_cron, timezone, periods, budget_per_period, budget_decay, budget_schedule, onboarding_amount, onboarding_reward, status, created_at, updated_at"
+
+type SearchCampaignsCondition struct {
+	Statuses []model.CampaignStatus
+}
+
+type CampaignRepository interface {
+	CreateCampaign(campaign *model.Campaign) (*model.Campaign, error)
+	GetCampaignByID(campaignID int) (*model.Campaign, error)
+	SearchCampaigns(condition *SearchCampaignsCondition) ([]*model.Campaign, error)
+	UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error)
+}
+
+type campaignRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewCampaignRepository() CampaignRepository {
+	return &campaignRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *campaignRepositoryImpl) CreateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(campaignsTableName).
+		Columns("name", "pools", "start_time", "period_type", "period_cron", "timezone", "periods", "budget_per_period", "budget_decay", "budget_schedule", "onboarding_amount", "onboarding_reward", "status", "created_at", "updated_at").
+		Values(campaign.Name, pq.Array(campaign.Pools), campaign.StartTime.UTC(), campaign.PeriodType, campaign.PeriodCron, campaign.Timezone, campaign.Periods, campaign.BudgetPerPeriod, campaign.BudgetDecay, pq.Array(budgetSchedule(campaign)), campaign.OnboardingAmount, campaign.OnboardingReward, campaign.Status, campaign.CreatedAt.UTC(), campaign.UpdatedAt.UTC()).
+		Suffix("RETURNING " + campaignColumns).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return scanCampaign(r.dbInstance.QueryRow(sqlCommand, args...))
+}
+
+func (r *campaignRepositoryImpl) GetCampaignByID(campaignID int) (*model.Campaign, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select(campaignColumns).
+		From(campaignsTableName).
+		Where(squirrel.Eq{"id": campaignID}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	campaign, err := scanCampaign(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.CampaignNotFoundError
+	}
+
+	return campaign, err
+}
+
+func (r *campaignRepositoryImpl) SearchCampaigns(condition *SearchCampaignsCondition) ([]*model.Campaign, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	query := psql.Select(campaignColumns).From(campaignsTableName)
+
+	if len(condition.Statuses) > 0 {
+		query = query.Where(squirrel.Eq{"status": condition.Statuses})
+	}
+
+	sqlCommand, args, err := query.OrderBy("id DESC").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var campaigns []*model.Campaign
+	for rows.Next() {
+		campaign, err := scanCampaign(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, rows.Err()
+}
+
+func (r *campaignRepositoryImpl) UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	campaign.UpdatedAt = time.Now().UTC()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(campaignsTableName).
+		Set("name", campaign.Name).
+		Set("pools", pq.Array(campaign.Pools)).
+		Set("start_time", campaign.StartTime.UTC()).
+		Set("period_type", campaign.PeriodType).
+		Set("period_cron", campaign.PeriodCron).
+		Set("timezone", campaign.Timezone).
+		Set("periods", campaign.Periods).
+		Set("budget_per_period", campaign.BudgetPerPeriod).
+		Set("budget_decay", campaign.BudgetDecay).
+		Set("budget_schedule", pq.Array(budgetSchedule(campaign))).
+		Set("onboarding_amount", campaign.OnboardingAmount).
+		Set("onboarding_reward", campaign.OnboardingReward).
+		Set("status", campaign.Status).
+		Set("updated_at", campaign.UpdatedAt).
+		Where(squirrel.Eq{"id": campaign.ID}).
+		Suffix("RETURNING " + campaignColumns).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	updatedCampaign, err := scanCampaign(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.CampaignNotFoundError
+	}
+
+	return updatedCampaign, err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCampaign(row rowScanner) (*model.Campaign, error) {
+	var campaign model.Campaign
+	var pools pq.StringArray
+	var budgets pq.Float64Array
+
+	err := row.Scan(&campaign.ID, &campaign.Name, &pools, &campaign.StartTime, &campaign.PeriodType, &campaign.PeriodCron,
+		&campaign.Timezone, &campaign.Periods, &campaign.BudgetPerPeriod, &campaign.BudgetDecay, &budgets,
+		&campaign.OnboardingAmount, &campaign.OnboardingReward, &campaign.Status, &campaign.CreatedAt, &campaign.UpdatedAt)
+
+	if err != nil {
+		return nil, err
+	}
+
+	campaign.Pools = pools
+	if len(budgets) > 0 {
+		campaign.BudgetSchedule = budgets
+	}
+	campaign.StartTime = campaign.StartTime.In(time.UTC)
+	campaign.CreatedAt = campaign.CreatedAt.In(time.UTC)
+	campaign.UpdatedAt = campaign.UpdatedAt.In(time.UTC)
+	campaign.ResolveLocation()
+
+	return &campaign, nil
+}
+
+// budgetSchedule never returns nil, the column is NOT NULL and pq encodes a nil
+// slice as NULL.
+func budgetSchedule(campaign *model.Campaign) []float64 {
+	if campaign.BudgetSchedule == nil {
+		return []float64{}
+	}
+	return campaign.BudgetSchedule
+}
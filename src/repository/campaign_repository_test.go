@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestCampaignRepositoryImpl(t *testing.T) {
+	setUpCampaignRepo := func(t *testing.T) *campaignRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM campaigns")
+		})
+
+		return &campaignRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	pools := []string{"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"}
+
+	t.Run("CreateCampaign", func(t *testing.T) {
+		campaignRepo := setUpCampaignRepo(t)
+
+		campaign, err := campaignRepo.CreateCampaign(model.NewCampaign("test_campaign", pools, startTime, model.PeriodTypeWeekly, 4))
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, campaign.ID)
+		assert.Equal(t, "test_campaign", campaign.Name)
+		assert.Equal(t, pools, campaign.Pools)
+		assert.Equal(t, startTime, campaign.StartTime)
+		assert.Equal(t, model.PeriodTypeWeekly, campaign.PeriodType)
+		assert.Equal(t, model.DefaultTimezone, campaign.Timezone)
+		assert.Nil(t, campaign.BudgetSchedule)
+		assert.Equal(t, 4, campaign.Periods)
+		assert.Equal(t, model.DefaultBudgetPerPeriod, campaign.BudgetPerPeriod)
+		assert.Equal(t, model.CampaignStatusActive, campaign.Status)
+	})
+
+	t.Run("GetCampaignByID", func(t *testing.T) {
+		campaignRepo := setUpCampaignRepo(t)
+
+		campaign, _ := campaignRepo.CreateCampaign(model.NewCampaign("test_campaign", pools, startTime, model.PeriodTypeWeekly, 4))
+
+		foundCampaign, err := campaignRepo.GetCampaignByID(campaign.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, campaign.ID, foundCampaign.ID)
+		assert.Equal(t, campaign.Pools, foundCampaign.Pools)
+	})
+
+	t.Run("GetCampaignByID, Invalid ID", func(t *testing.T) {
+		campaignRepo := setUpCampaignRepo(t)
+
+		_, err := campaignRepo.GetCampaignByID(-1)
+		assert.ErrorIs(t, err, exception.CampaignNotFoundError)
+	})
+
+	t.Run("UpdateCampaign", func(t *testing.T) {
+		campaignRepo := setUpCampaignRepo(t)
+
+		campaign, _ := campaignRepo.CreateCampaign(model.NewCampaign("test_campaign", pools, startTime, model.PeriodTypeWeekly, 4))
+		campaign.Status = model.CampaignStatusPaused
+		campaign.BudgetPerPeriod = 500
+
+		updatedCampaign, err := campaignRepo.UpdateCampaign(campaign)
+		assert.NoError(t, err)
+		assert.Equal(t, model.CampaignStatusPaused, updatedCampaign.Status)
+		assert.Equal(t, 500.0, updatedCampaign.BudgetPerPeriod)
+	})
+
+	t.Run("SearchCampaigns By Status", func(t *testing.T) {
+		campaignRepo := setUpCampaignRepo(t)
+
+		active, _ := campaignRepo.CreateCampaign(model.NewCampaign("active", pools, startTime, model.PeriodTypeWeekly, 4))
+		archived := model.NewCampaign("archived", pools, startTime, model.PeriodTypeWeekly, 4)
+		archived.Status = model.CampaignStatusArchived
+		_, _ = campaignRepo.CreateCampaign(archived)
+
+		campaigns, err := campaignRepo.SearchCampaigns(&SearchCampaignsCondition{
+			Statuses: []model.CampaignStatus{model.CampaignStatusActive, model.CampaignStatusPaused},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(campaigns))
+		assert.Equal(t, active.ID, campaigns[0].ID)
+
+		campaigns, err = campaignRepo.SearchCampaigns(&SearchCampaignsCondition{})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(campaigns))
+	})
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const (
+	merkleDistributionsTableName = "merkle_distributions"
+	merkleClaimsTableName        = "merkle_claims"
+	// claimsInsertBatchSize keeps the number of parameters of an insert far below the Postgres limit.
+	claimsInsertBatchSize = 1000
+)
+
+type ClaimRepository interface {
+	CreateDistribution(distribution *model.MerkleDistribution, claims []*model.Claim) (*model.MerkleDistribution, error)
+	// SearchLatestClaims returns the claim of the address in the latest
+	// distribution, if it's part of it.
+	SearchLatestClaims(address string) ([]*model.ClaimOfCampaign, error)
+}
+
+type claimRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewClaimRepository() ClaimRepository {
+	return &claimRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// CreateDistribution stores a distribution and its claims in one transaction.
+func (r *claimRepositoryImpl) CreateDistribution(distribution *model.MerkleDistribution, claims []*model.Claim) (*model.MerkleDistribution, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(merkleDistributionsTableName).
+		Columns("campaign_id", "period_index", "root", "token_decimals", "total_amount", "created_at").
+		Values(distribution.CampaignID, distribution.PeriodIndex, distribution.Root, distribution.TokenDecimals, distribution.TotalAmount, distribution.CreatedAt.UTC()).
+		Suffix("ON CONFLICT (campaign_id, period_index) DO NOTHING RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(sqlCommand, args...).Scan(&distribution.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.DistributionAlreadyExistsError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for start := 0; start < len(claims); start += claimsInsertBatchSize {
+		end := min(start+claimsInsertBatchSize, len(claims))
+
+		query := psql.Insert(merkleClaimsTableName).Columns("distribution_id", "address", "amount", "proof")
+		for _, claim := range claims[start:end] {
+			claim.DistributionID = distribution.ID
+			query = query.Values(distribution.ID, claim.Address, claim.Amount, pq.Array(claim.Proof))
+		}
+
+		sqlCommand, args, err := query.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.Exec(sqlCommand, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return distribution, nil
+}
+
+func (r *claimRepositoryImpl) SearchLatestClaims(address string) ([]*model.ClaimOfCampaign, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("d.id, d.campaign_id, d.period_index, d.root, d.token_decimals, d.total_amount::TEXT, d.created_at",
+			"c.address, c.amount::TEXT, c.proof").
+		From(merkleClaimsTableName + " c").
+		Join(merkleDistributionsTableName + " d ON d.id = c.distribution_id").
+		Where(squirrel.Eq{"c.address": address}).
+		Where("d.id = (SELECT MAX(id) FROM " + merkleDistributionsTableName + ")").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var claims []*model.ClaimOfCampaign
+	for rows.Next() {
+		distribution := &model.MerkleDistribution{}
+		claim := &model.Claim{}
+		err := rows.Scan(&distribution.ID, &distribution.CampaignID, &distribution.PeriodIndex, &distribution.Root,
+			&distribution.TokenDecimals, &distribution.TotalAmount, &distribution.CreatedAt,
+			&claim.Address, &claim.Amount, (*pq.StringArray)(&claim.Proof))
+		if err != nil {
+			return nil, err
+		}
+
+		distribution.CreatedAt = distribution.CreatedAt.In(time.UTC)
+		claim.DistributionID = distribution.ID
+		claims = append(claims, &model.ClaimOfCampaign{Distribution: distribution, Claim: claim})
+	}
+
+	return claims, rows.Err()
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestClaimRepositoryImpl(t *testing.T) {
+	setUpClaimRepo := func(t *testing.T) *claimRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM merkle_distributions")
+		})
+
+		return &claimRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	newDistribution := func(campaignID int, periodIndex int, root string) *model.MerkleDistribution {
+		return &model.MerkleDistribution{
+			CampaignID:    campaignID,
+			PeriodIndex:   periodIndex,
+			Root:          root,
+			TokenDecimals: 18,
+			TotalAmount:   "3000000000000000000000",
+			CreatedAt:     time.Now().UTC(),
+		}
+	}
+
+	t.Run("CreateDistribution", func(t *testing.T) {
+		claimRepo := setUpClaimRepo(t)
+
+		claims := []*model.Claim{
+			{Address: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", Amount: "1000000000000000000000", Proof: []string{"0x01"}},
+			{Address: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", Amount: "2000000000000000000000", Proof: []string{"0x02"}},
+		}
+
+		distribution, err := claimRepo.CreateDistribution(newDistribution(1, 0, "0xroot"), claims)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, distribution.ID)
+		assert.Equal(t, distribution.ID, claims[1].DistributionID)
+
+		_, err = claimRepo.CreateDistribution(newDistribution(1, 0, "0xother"), nil)
+		assert.ErrorIs(t, err, exception.DistributionAlreadyExistsError)
+	})
+
+	t.Run("SearchLatestClaims", func(t *testing.T) {
+		claimRepo := setUpClaimRepo(t)
+		address := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+		for _, distribution := range []*model.MerkleDistribution{
+			newDistribution(1, 0, "0xfirst"),
+			newDistribution(1, 1, "0xsecond"),
+			newDistribution(2, 0, "0xother_campaign"),
+		} {
+			_, err := claimRepo.CreateDistribution(distribution, []*model.Claim{
+				{Address: address, Amount: "1000000000000000000000", Proof: []string{"0x01", "0x02"}},
+			})
+			assert.NoError(t, err)
+		}
+
+		claims, err := claimRepo.SearchLatestClaims(address)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(claims))
+		assert.Equal(t, "0xother_campaign", claims[0].Distribution.Root)
+		assert.Equal(t, 2, claims[0].Distribution.CampaignID)
+		assert.Equal(t, "1000000000000000000000", claims[0].Claim.Amount)
+		assert.Equal(t, []string{"0x01", "0x02"}, claims[0].Claim.Proof)
+
+		claims, err = claimRepo.SearchLatestClaims("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+		assert.NoError(t, err)
+		assert.Empty(t, claims)
+	})
+}
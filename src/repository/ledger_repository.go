@@ -0,0 +1,452 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const (
+	ledgerTransactionsTableName = "ledger_transactions"
+	ledgerEntriesTableName      = "ledger_entries"
+	balanceMismatchesTableName  = "balance_mismatches"
+)
+
+// balanceTolerance absorbs the rounding of summing DOUBLE PRECISION amounts.
+const balanceTolerance = 1e-6
+
+// balanceMismatchesQuery compares the cached balance of every user with the
+// sum of their ledger account. It's a single statement, so it sees a ledger
+// transaction and the cached balances it moved together or not at all.
+var balanceMismatchesQuery = fmt.Sprintf(`
+SELECT u.id, u.points, COALESCE(l.points, 0)
+FROM %s u
+LEFT JOIN (
+	SELECT account, SUM(amount) AS points FROM %s WHERE account LIKE 'user:%%' GROUP BY account
+) l ON l.account = 'user:' || u.id
+WHERE ABS(u.points - COALESCE(l.points, 0)) >= $1
+ORDER BY u.id`, usersTableName, ledgerEntriesTableName)
+
+type LedgerRepository interface {
+	// RefreshBalanceMismatches replaces the flagged users with the ones whose
+	// balance differs from the ledger now. Users flagged before keep their
+	// detection time.
+	RefreshBalanceMismatches(now time.Time) ([]*model.BalanceMismatch, error)
+	SearchBalanceMismatches() ([]*model.BalanceMismatch, error)
+	SearchUsersWithBalance() ([]string, error)
+	// GetPointLots returns the credits of the user, oldest first, and the sum
+	// of their debits.
+	GetPointLots(userID string) ([]*model.PointLot, float64, error)
+	// ExpirePoints debits what expire returns from the lots of the user, with
+	// the balance of the user locked so no other debit spends the same lots.
+	ExpirePoints(userID string, now time.Time, expire func(lots []*model.PointLot, debited float64) float64) (float64, error)
+	// ClaimPoints moves the balance of the user to the claims account and
+	// returns the cumulative points the user has claimed.
+	ClaimPoints(userID string, now time.Time) (float64, error)
+	// SumClaimedPoints returns the cumulative points claimed by every user
+	// who claimed any.
+	SumClaimedPoints() (map[string]float64, error)
+}
+
+type ledgerRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewLedgerRepository() LedgerRepository {
+	return &ledgerRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// sqlRunner is a *sql.DB or a *sql.Tx.
+type sqlRunner interface {
+	queryRower
+	Exec(query string, args ...any) (sql.Result, error)
+}
+
+// postLedgerTransaction appends the transaction to the ledger and moves the
+// cached balance of the users it credits or debits. It must run in the
+// database transaction of whatever the points are moved for. A debit can't
+// take a balance below zero. It returns the balances of the users afterward.
+func postLedgerTransaction(runner sqlRunner, transaction *model.LedgerTransaction) (map[string]float64, error) {
+	if !transaction.IsBalanced() {
+		return nil, exception.UnbalancedLedgerTransactionError
+	}
+
+	balances := make(map[string]float64)
+	for _, entry := range transaction.Entries {
+		userID := entry.Account.UserID()
+		if userID == "" {
+			continue
+		}
+
+		balance, err := updateCachedBalance(runner, userID, entry.Amount)
+		if err != nil {
+			return nil, err
+		}
+		balances[userID] = balance
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(ledgerTransactionsTableName).
+		Columns("kind", "created_at").
+		Values(transaction.Kind, transaction.CreatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := runner.QueryRow(sqlCommand, args...).Scan(&transaction.ID); err != nil {
+		return nil, err
+	}
+
+	query := psql.Insert(ledgerEntriesTableName).Columns("transaction_id", "account", "amount", "created_at")
+	for _, entry := range transaction.Entries {
+		entry.TransactionID = transaction.ID
+		query = query.Values(entry.TransactionID, entry.Account, entry.Amount, entry.CreatedAt.UTC())
+	}
+
+	sqlCommand, args, err = query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := runner.Exec(sqlCommand, args...); err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// updateCachedBalance adds amount to users.points, the projection of the
+// ledger account of the user. The row stays locked until the transaction
+// ends, so concurrent debits can't both spend the same points.
+func updateCachedBalance(runner queryRower, userID string, amount float64) (float64, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(usersTableName).
+		Set("points", squirrel.Expr("points + ?", amount)).
+		Where(squirrel.Eq{"id": userID}).
+		Where(squirrel.Expr("points + ? >= 0", amount)).
+		Suffix("RETURNING points").
+		ToSql()
+
+	if err != nil {
+		return 0, err
+	}
+
+	var balance float64
+	err = runner.QueryRow(sqlCommand, args...).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		if err := runner.QueryRow("SELECT EXISTS (SELECT 1 FROM "+usersTableName+" WHERE id = $1)", userID).Scan(&exists); err != nil {
+			return 0, err
+		}
+
+		if !exists {
+			return 0, exception.UserNotFoundError
+		}
+		return 0, exception.InsufficientPointsError
+	}
+
+	return balance, err
+}
+
+func (r *ledgerRepositoryImpl) RefreshBalanceMismatches(now time.Time) ([]*model.BalanceMismatch, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(balanceMismatchesQuery, balanceTolerance)
+	if err != nil {
+		return nil, err
+	}
+
+	var mismatches []*model.BalanceMismatch
+	for rows.Next() {
+		mismatch := &model.BalanceMismatch{DetectedAt: now.UTC()}
+		if err := rows.Scan(&mismatch.UserID, &mismatch.CachedPoints, &mismatch.LedgerPoints); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		mismatches = append(mismatches, mismatch)
+	}
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]string, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		userIDs = append(userIDs, mismatch.UserID)
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(balanceMismatchesTableName).
+		Where(squirrel.NotEq{"user_id": userIDs}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(sqlCommand, args...); err != nil {
+		return nil, err
+	}
+
+	for _, mismatch := range mismatches {
+		sqlCommand, args, err := psql.Insert(balanceMismatchesTableName).
+			Columns("user_id", "cached_points", "ledger_points", "detected_at").
+			Values(mismatch.UserID, mismatch.CachedPoints, mismatch.LedgerPoints, mismatch.DetectedAt).
+			Suffix("ON CONFLICT (user_id) DO UPDATE SET cached_points = EXCLUDED.cached_points, ledger_points = EXCLUDED.ledger_points").
+			Suffix("RETURNING detected_at").
+			ToSql()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err := tx.QueryRow(sqlCommand, args...).Scan(&mismatch.DetectedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return mismatches, nil
+}
+
+func (r *ledgerRepositoryImpl) SearchBalanceMismatches() ([]*model.BalanceMismatch, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("user_id", "cached_points", "ledger_points", "detected_at").
+		From(balanceMismatchesTableName).
+		OrderBy("detected_at", "user_id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var mismatches []*model.BalanceMismatch
+	for rows.Next() {
+		var mismatch model.BalanceMismatch
+		if err := rows.Scan(&mismatch.UserID, &mismatch.CachedPoints, &mismatch.LedgerPoints, &mismatch.DetectedAt); err != nil {
+			return nil, err
+		}
+		mismatches = append(mismatches, &mismatch)
+	}
+
+	return mismatches, rows.Err()
+}
+
+func (r *ledgerRepositoryImpl) SearchUsersWithBalance() ([]string, error) {
+	rows, err := r.dbInstance.Query("SELECT id FROM " + usersTableName + " WHERE points > 0 ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
+func (r *ledgerRepositoryImpl) GetPointLots(userID string) ([]*model.PointLot, float64, error) {
+	return getPointLots(r.dbInstance, userID)
+}
+
+func (r *ledgerRepositoryImpl) ExpirePoints(userID string, now time.Time, expire func(lots []*model.PointLot, debited float64) float64) (float64, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var balance float64
+	err = tx.QueryRow("SELECT points FROM "+usersTableName+" WHERE id = $1 FOR UPDATE", userID).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, exception.UserNotFoundError
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	lots, debited, err := getPointLots(tx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	// the cached balance caps the rounding of summing the lots
+	points := min(expire(lots, debited), balance)
+	if points < balanceTolerance {
+		return 0, nil
+	}
+
+	transaction := model.NewUserLedgerTransaction(model.LedgerTransactionKindExpiry, userID, model.LedgerAccountExpiry, -points, now)
+	if _, err := postLedgerTransaction(tx, transaction); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return points, nil
+}
+
+func (r *ledgerRepositoryImpl) ClaimPoints(userID string, now time.Time) (float64, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	var balance float64
+	err = tx.QueryRow("SELECT points FROM "+usersTableName+" WHERE id = $1 FOR UPDATE", userID).Scan(&balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, exception.UserNotFoundError
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if balance >= balanceTolerance {
+		transaction := model.NewUserLedgerTransaction(model.LedgerTransactionKindClaim, userID, model.LedgerAccountClaims, -balance, now)
+		if _, err := postLedgerTransaction(tx, transaction); err != nil {
+			return 0, err
+		}
+	}
+
+	claimed, err := sumClaimedPoints(tx, squirrel.Eq{"e.account": model.UserLedgerAccount(userID)})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return claimed[userID], nil
+}
+
+func (r *ledgerRepositoryImpl) SumClaimedPoints() (map[string]float64, error) {
+	return sumClaimedPoints(r.dbInstance, squirrel.Like{"e.account": model.UserLedgerAccount("%")})
+}
+
+// sumClaimedPoints sums the claim debits of the user accounts matching where.
+func sumClaimedPoints(runner queryer, where squirrel.Sqlizer) (map[string]float64, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("e.account", "-SUM(e.amount)").
+		From(ledgerEntriesTableName + " e").
+		Join(ledgerTransactionsTableName + " t ON t.id = e.transaction_id").
+		Where(squirrel.Eq{"t.kind": model.LedgerTransactionKindClaim}).
+		Where(where).
+		GroupBy("e.account").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := runner.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	claimed := make(map[string]float64)
+	for rows.Next() {
+		var account model.LedgerAccount
+		var points float64
+		if err := rows.Scan(&account, &points); err != nil {
+			return nil, err
+		}
+		claimed[account.UserID()] = points
+	}
+
+	return claimed, rows.Err()
+}
+
+// queryer is a *sql.DB or a *sql.Tx.
+type queryer interface {
+	queryRower
+	Query(query string, args ...any) (*sql.Rows, error)
+}
+
+// getPointLots loads the credits of the user with the campaign of their
+// reward record, 0 for credits without campaign such as refunds.
+func getPointLots(runner queryer, userID string) ([]*model.PointLot, float64, error) {
+	account := model.UserLedgerAccount(userID)
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("e.transaction_id", "COALESCE(r.campaign_id, 0)", "e.amount", "e.created_at").
+		From(ledgerEntriesTableName + " e").
+		LeftJoin(rewardRecordTableName + " r ON r.ledger_transaction_id = e.transaction_id").
+		Where(squirrel.Eq{"e.account": account}).
+		Where(squirrel.Gt{"e.amount": 0}).
+		OrderBy("e.id").
+		ToSql()
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := runner.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var lots []*model.PointLot
+	for rows.Next() {
+		var lot model.PointLot
+		if err := rows.Scan(&lot.TransactionID, &lot.CampaignID, &lot.Points, &lot.EarnedAt); err != nil {
+			return nil, 0, err
+		}
+		lot.Remaining = lot.Points
+		lots = append(lots, &lot)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	var debited float64
+	err = runner.QueryRow("SELECT COALESCE(-SUM(amount), 0) FROM "+ledgerEntriesTableName+" WHERE account = $1 AND amount < 0", account).
+		Scan(&debited)
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lots, debited, nil
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func createLedgerUsers(dbInstance *sql.DB, userIDs ...string) {
+	userRepo := &userRepositoryImpl{dbInstance: dbInstance}
+	for _, userID := range userIDs {
+		_, _ = userRepo.CreateUser(userID)
+	}
+}
+
+// cleanUpLedger truncates the ledger, deleting from it is rejected.
+func cleanUpLedger(dbInstance *sql.DB) {
+	dbInstance.Exec("DELETE FROM reward_records")
+	dbInstance.Exec("DELETE FROM redemptions")
+	dbInstance.Exec("TRUNCATE ledger_entries, ledger_transactions CASCADE")
+	dbInstance.Exec("DELETE FROM balance_mismatches")
+	dbInstance.Exec("DELETE FROM audit_logs")
+	dbInstance.Exec("DELETE FROM users")
+}
+
+var setUpLedgerRepo = func(t *testing.T) *ledgerRepositoryImpl {
+	dbInstance := database.GetDBInstance()
+	createLedgerUsers(dbInstance, "test_user_id", "other_user_id")
+
+	t.Cleanup(func() {
+		cleanUpLedger(dbInstance)
+	})
+
+	return &ledgerRepositoryImpl{
+		dbInstance: dbInstance,
+	}
+}
+
+func TestPostLedgerTransaction(t *testing.T) {
+	repo := setUpLedgerRepo(t)
+
+	t.Run("Post", func(t *testing.T) {
+		transaction := model.NewUserLedgerTransaction(model.LedgerTransactionKindTaskReward, "test_user_id",
+			model.LedgerAccountRewards, 100, time.Now().UTC())
+
+		balances, err := postLedgerTransaction(repo.dbInstance, transaction)
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"test_user_id": 100}, balances)
+		assert.NotEmpty(t, transaction.ID)
+	})
+
+	t.Run("Unbalanced", func(t *testing.T) {
+		transaction := &model.LedgerTransaction{
+			Kind: model.LedgerTransactionKindTaskReward,
+			Entries: []*model.LedgerEntry{
+				{Account: model.UserLedgerAccount("test_user_id"), Amount: 100},
+				{Account: model.LedgerAccountRewards, Amount: -90},
+			},
+		}
+
+		_, err := postLedgerTransaction(repo.dbInstance, transaction)
+		assert.ErrorIs(t, err, exception.UnbalancedLedgerTransactionError)
+	})
+
+	t.Run("Append Only", func(t *testing.T) {
+		_, err := repo.dbInstance.Exec("UPDATE ledger_entries SET amount = 0")
+		assert.Error(t, err)
+
+		_, err = repo.dbInstance.Exec("DELETE FROM ledger_entries")
+		assert.Error(t, err)
+	})
+}
+
+func TestLedgerRepositoryImpl_RefreshBalanceMismatches(t *testing.T) {
+	repo := setUpLedgerRepo(t)
+	rewardRecordRepo := &rewardRecordRepositoryImpl{dbInstance: repo.dbInstance}
+
+	for _, userID := range []string{"test_user_id", "other_user_id"} {
+		_, err := rewardRecordRepo.CreateRewardRecord(&model.RewardRecord{UserID: userID, Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+	}
+
+	detectedAt := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+
+	t.Run("Consistent", func(t *testing.T) {
+		mismatches, err := repo.RefreshBalanceMismatches(detectedAt)
+		assert.NoError(t, err)
+		assert.Empty(t, mismatches)
+	})
+
+	t.Run("Drift", func(t *testing.T) {
+		_, _ = repo.dbInstance.Exec("UPDATE users SET points = 150 WHERE id = 'test_user_id'")
+
+		mismatches, err := repo.RefreshBalanceMismatches(detectedAt)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(mismatches))
+		assert.Equal(t, "test_user_id", mismatches[0].UserID)
+		assert.Equal(t, 150.0, mismatches[0].CachedPoints)
+		assert.Equal(t, 100.0, mismatches[0].LedgerPoints)
+
+		// a mismatch still there keeps the time it was first detected
+		mismatches, err = repo.RefreshBalanceMismatches(time.Now().UTC())
+		assert.NoError(t, err)
+		assert.True(t, detectedAt.Equal(mismatches[0].DetectedAt))
+
+		stored, err := repo.SearchBalanceMismatches()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(stored))
+	})
+
+	t.Run("Resolved", func(t *testing.T) {
+		_, _ = repo.dbInstance.Exec("UPDATE users SET points = 100 WHERE id = 'test_user_id'")
+
+		mismatches, err := repo.RefreshBalanceMismatches(time.Now().UTC())
+		assert.NoError(t, err)
+		assert.Empty(t, mismatches)
+
+		stored, err := repo.SearchBalanceMismatches()
+		assert.NoError(t, err)
+		assert.Empty(t, stored)
+	})
+}
+
+func TestLedgerRepositoryImpl_ExpirePoints(t *testing.T) {
+	repo := setUpLedgerRepo(t)
+	rewardRecordRepo := &rewardRecordRepositoryImpl{dbInstance: repo.dbInstance}
+	userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+
+	for _, points := range []float64{100, 50} {
+		_, err := rewardRecordRepo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", CampaignID: 1, Points: points, TaskID: 1, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+	}
+	_, _ = rewardRecordRepo.CreateAdjustment(&model.RewardRecord{UserID: "test_user_id", Points: -30, Reason: "clawback", CreatedAt: time.Now().UTC()}, nil)
+
+	t.Run("GetPointLots", func(t *testing.T) {
+		lots, debited, err := repo.GetPointLots("test_user_id")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(lots))
+		assert.Equal(t, 100.0, lots[0].Points)
+		assert.Equal(t, 1, lots[0].CampaignID)
+		assert.Equal(t, 30.0, debited)
+	})
+
+	t.Run("ExpirePoints", func(t *testing.T) {
+		points, err := repo.ExpirePoints("test_user_id", time.Now().UTC(), func(lots []*model.PointLot, debited float64) float64 {
+			model.ConsumeLots(lots, debited)
+			return lots[0].Remaining
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 70.0, points)
+
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 50.0, user.Points)
+
+		_, debited, _ := repo.GetPointLots("test_user_id")
+		assert.Equal(t, 100.0, debited)
+	})
+
+	t.Run("Nothing to Expire", func(t *testing.T) {
+		points, err := repo.ExpirePoints("test_user_id", time.Now().UTC(), func(lots []*model.PointLot, debited float64) float64 {
+			return 0
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, points)
+	})
+
+	t.Run("SearchUsersWithBalance", func(t *testing.T) {
+		userIDs, err := repo.SearchUsersWithBalance()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test_user_id"}, userIDs)
+	})
+}
+
+func TestLedgerRepositoryImpl_ClaimPoints(t *testing.T) {
+	repo := setUpLedgerRepo(t)
+	rewardRecordRepo := &rewardRecordRepositoryImpl{dbInstance: repo.dbInstance}
+	redemptionRepo := &redemptionRepositoryImpl{dbInstance: repo.dbInstance}
+	userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+
+	_, err := rewardRecordRepo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", CampaignID: 1, Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+	assert.NoError(t, err)
+
+	t.Run("Claim Balance", func(t *testing.T) {
+		claimed, err := repo.ClaimPoints("test_user_id", time.Now().UTC())
+		assert.NoError(t, err)
+		assert.Equal(t, 100.0, claimed)
+
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 0.0, user.Points)
+	})
+
+	t.Run("Claimed Points Can't Be Redeemed", func(t *testing.T) {
+		_, err := redemptionRepo.CreateRedemption(&model.Redemption{UserID: "test_user_id", ItemID: "item", Points: 50, Status: model.RedemptionStatusPending, CreatedAt: time.Now().UTC()})
+		assert.ErrorIs(t, err, exception.InsufficientPointsError)
+	})
+
+	t.Run("Claims Are Cumulative", func(t *testing.T) {
+		_, err := rewardRecordRepo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", CampaignID: 1, Points: 20, TaskID: 2, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+
+		claimed, err := repo.ClaimPoints("test_user_id", time.Now().UTC())
+		assert.NoError(t, err)
+		assert.Equal(t, 120.0, claimed)
+
+		claimed, err = repo.ClaimPoints("test_user_id", time.Now().UTC())
+		assert.NoError(t, err)
+		assert.Equal(t, 120.0, claimed)
+	})
+
+	t.Run("SumClaimedPoints", func(t *testing.T) {
+		claimed, err := repo.SumClaimedPoints()
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]float64{"test_user_id": 120}, claimed)
+	})
+
+	t.Run("Unknown User", func(t *testing.T) {
+		_, err := repo.ClaimPoints("unknown_user_id", time.Now().UTC())
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+}
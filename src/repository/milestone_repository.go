@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+)
+
+const userMilestonesTableName = "user_milestones"
+
+type MilestoneRepository interface {
+	// ClaimMilestone records that the user achieved the milestone, it tells
+	// false when the user already had.
+	ClaimMilestone(userID string, milestone string, at time.Time) (bool, error)
+	ReleaseMilestone(userID string, milestone string) error
+	GetAchievedMilestones(userID string) ([]string, error)
+}
+
+type milestoneRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewMilestoneRepository() MilestoneRepository {
+	return &milestoneRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *milestoneRepositoryImpl) ClaimMilestone(userID string, milestone string, at time.Time) (bool, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(userMilestonesTableName).
+		Columns("user_id", "milestone", "created_at").
+		Values(userID, milestone, at.UTC()).
+		Suffix("ON CONFLICT (user_id, milestone) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.dbInstance.Exec(sqlCommand, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func (r *milestoneRepositoryImpl) ReleaseMilestone(userID string, milestone string) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(userMilestonesTableName).
+		Where(squirrel.Eq{"user_id": userID, "milestone": milestone}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
+func (r *milestoneRepositoryImpl) GetAchievedMilestones(userID string) ([]string, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("milestone").
+		From(userMilestonesTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("created_at", "milestone").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var milestones []string
+	for rows.Next() {
+		var milestone string
+		if err := rows.Scan(&milestone); err != nil {
+			return nil, err
+		}
+		milestones = append(milestones, milestone)
+	}
+
+	return milestones, rows.Err()
+}
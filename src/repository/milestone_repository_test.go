@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+)
+
+func TestMilestoneRepositoryImpl(t *testing.T) {
+	setUpMilestoneRepo := func(t *testing.T) *milestoneRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM user_milestones")
+		})
+
+		return &milestoneRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	now := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("ClaimMilestone", func(t *testing.T) {
+		milestoneRepo := setUpMilestoneRepo(t)
+
+		claimed, err := milestoneRepo.ClaimMilestone("test_user", "volume:10000", now)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = milestoneRepo.ClaimMilestone("test_user", "volume:10000", now.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+
+		claimed, err = milestoneRepo.ClaimMilestone("other_user", "volume:10000", now)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		assert.NoError(t, milestoneRepo.ReleaseMilestone("test_user", "volume:10000"))
+		claimed, err = milestoneRepo.ClaimMilestone("test_user", "volume:10000", now.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+
+	t.Run("GetAchievedMilestones", func(t *testing.T) {
+		milestoneRepo := setUpMilestoneRepo(t)
+
+		_, _ = milestoneRepo.ClaimMilestone("test_user", "volume:10000", now)
+		_, _ = milestoneRepo.ClaimMilestone("test_user", "on_boarding:1", now.Add(-time.Hour))
+		_, _ = milestoneRepo.ClaimMilestone("other_user", "volume:100000", now)
+
+		milestones, err := milestoneRepo.GetAchievedMilestones("test_user")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"on_boarding:1", "volume:10000"}, milestones)
+	})
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const multipliersTableName = "multipliers"
+
+type SearchMultipliersCondition struct {
+	// CampaignID also matches the multipliers of every campaign.
+	CampaignID int
+	// From and To keep the multipliers active at some point of [From, To).
+	From time.Time
+	To   time.Time
+}
+
+type MultiplierRepository interface {
+	CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error)
+	SearchMultipliers(condition *SearchMultipliersCondition) ([]*model.Multiplier, error)
+	DeleteMultiplier(id int) error
+}
+
+type multiplierRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewMultiplierRepository() MultiplierRepository {
+	return &multiplierRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *multiplierRepositoryImpl) CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error) {
+	var endTime sql.NullTime
+	if multiplier.EndTime != nil {
+		endTime = sql.NullTime{Time: multiplier.EndTime.UTC(), Valid: true}
+	}
+
+	userIDs := multiplier.UserIDs
+	if userIDs == nil {
+		userIDs = []string{}
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(multipliersTableName).
+		Columns("name", "factor", "campaign_id", "user_ids", "start_time", "end_time", "created_at").
+		Values(multiplier.Name, multiplier.Factor,
+			sql.NullInt64{Int64: int64(multiplier.CampaignID), Valid: multiplier.CampaignID != 0},
+			pq.Array(userIDs), multiplier.StartTime.UTC(), endTime, multiplier.CreatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.dbInstance.QueryRow(sqlCommand, args...).Scan(&multiplier.ID); err != nil {
+		return nil, err
+	}
+
+	return multiplier, nil
+}
+
+// SearchMultipliers returns the matching multipliers by start time.
+func (r *multiplierRepositoryImpl) SearchMultipliers(condition *SearchMultipliersCondition) ([]*model.Multiplier, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	query := psql.
+		Select("id, name, factor, COALESCE(campaign_id, 0), user_ids, start_time, end_time, created_at").
+		From(multipliersTableName).
+		OrderBy("start_time", "id")
+
+	if condition.CampaignID != 0 {
+		query = query.Where(squirrel.Or{squirrel.Eq{"campaign_id": nil}, squirrel.Eq{"campaign_id": condition.CampaignID}})
+	}
+
+	if !condition.To.IsZero() {
+		query = query.Where(squirrel.Lt{"start_time": condition.To.UTC()})
+	}
+
+	if !condition.From.IsZero() {
+		query = query.Where(squirrel.Or{squirrel.Eq{"end_time": nil}, squirrel.Gt{"end_time": condition.From.UTC()}})
+	}
+
+	sqlCommand, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var multipliers []*model.Multiplier
+	for rows.Next() {
+		multiplier, err := scanMultiplier(rows)
+		if err != nil {
+			return nil, err
+		}
+		multipliers = append(multipliers, multiplier)
+	}
+
+	return multipliers, rows.Err()
+}
+
+func (r *multiplierRepositoryImpl) DeleteMultiplier(id int) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(multipliersTableName).
+		Where(squirrel.Eq{"id": id}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	result, err := r.dbInstance.Exec(sqlCommand, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return exception.MultiplierNotFoundError
+	}
+
+	return nil
+}
+
+func scanMultiplier(row rowScanner) (*model.Multiplier, error) {
+	var multiplier model.Multiplier
+	var userIDs pq.StringArray
+	var endTime sql.NullTime
+
+	err := row.Scan(&multiplier.ID, &multiplier.Name, &multiplier.Factor, &multiplier.CampaignID, &userIDs,
+		&multiplier.StartTime, &endTime, &multiplier.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	multiplier.UserIDs = userIDs
+	multiplier.StartTime = multiplier.StartTime.In(time.UTC)
+	multiplier.CreatedAt = multiplier.CreatedAt.In(time.UTC)
+	if endTime.Valid {
+		endTime.Time = endTime.Time.In(time.UTC)
+		multiplier.EndTime = &endTime.Time
+	}
+
+	return &multiplier, nil
+}
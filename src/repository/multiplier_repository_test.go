@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestMultiplierRepositoryImpl(t *testing.T) {
+	setUpMultiplierRepo := func(t *testing.T) *multiplierRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM multipliers")
+		})
+
+		return &multiplierRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	startTime := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(48 * time.Hour)
+
+	t.Run("CreateMultiplier", func(t *testing.T) {
+		multiplierRepo := setUpMultiplierRepo(t)
+
+		created, err := multiplierRepo.CreateMultiplier(&model.Multiplier{
+			Name:      "weekend",
+			Factor:    2,
+			UserIDs:   []string{"0xabc"},
+			StartTime: startTime,
+			EndTime:   &endTime,
+			CreatedAt: startTime,
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, created.ID)
+
+		multipliers, err := multiplierRepo.SearchMultipliers(&SearchMultipliersCondition{})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(multipliers))
+		assert.Equal(t, "weekend", multipliers[0].Name)
+		assert.Equal(t, []string{"0xabc"}, multipliers[0].UserIDs)
+		assert.Equal(t, endTime, *multipliers[0].EndTime)
+	})
+
+	t.Run("SearchMultipliers", func(t *testing.T) {
+		multiplierRepo := setUpMultiplierRepo(t)
+
+		weekend, _ := multiplierRepo.CreateMultiplier(&model.Multiplier{Name: "weekend", Factor: 2, StartTime: startTime, EndTime: &endTime})
+		campaign, _ := multiplierRepo.CreateMultiplier(&model.Multiplier{Name: "campaign", Factor: 1.5, CampaignID: 1, StartTime: startTime.AddDate(0, -1, 0)})
+		_, _ = multiplierRepo.CreateMultiplier(&model.Multiplier{Name: "other", Factor: 3, CampaignID: 2, StartTime: startTime})
+		_, _ = multiplierRepo.CreateMultiplier(&model.Multiplier{Name: "later", Factor: 3, StartTime: endTime.Add(time.Hour)})
+
+		multipliers, err := multiplierRepo.SearchMultipliers(&SearchMultipliersCondition{CampaignID: 1, From: startTime, To: endTime})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(multipliers))
+		assert.Equal(t, campaign.ID, multipliers[0].ID)
+		assert.Equal(t, weekend.ID, multipliers[1].ID)
+
+		multipliers, _ = multiplierRepo.SearchMultipliers(&SearchMultipliersCondition{CampaignID: 1, From: endTime, To: endTime.Add(time.Hour)})
+		assert.Equal(t, 1, len(multipliers))
+		assert.Equal(t, campaign.ID, multipliers[0].ID)
+	})
+
+	t.Run("DeleteMultiplier", func(t *testing.T) {
+		multiplierRepo := setUpMultiplierRepo(t)
+
+		created, _ := multiplierRepo.CreateMultiplier(&model.Multiplier{Name: "weekend", Factor: 2, StartTime: startTime})
+
+		assert.NoError(t, multiplierRepo.DeleteMultiplier(created.ID))
+		assert.ErrorIs(t, multiplierRepo.DeleteMultiplier(created.ID), exception.MultiplierNotFoundError)
+	})
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// SortByCreatedAt sorts by the primary key, ids grow with the creation time.
+const SortByCreatedAt = "created_at"
+
+// Page is the keyset pagination of a search. A nil page returns every row.
+type Page struct {
+	// Cursor is the next cursor returned with the previous page, empty for the first page.
+	Cursor string
+	// Limit is the maximum number of rows, 0 means no limit.
+	Limit  uint64
+	SortBy string
+	Order  SortOrder
+}
+
+// cursor is the sort key of the last row of a page, the sort is part of it so
+// a cursor can't be replayed against another sort.
+type cursor struct {
+	SortBy string    `json:"s"`
+	Order  SortOrder `json:"o"`
+	Value  float64   `json:"v,omitempty"`
+	ID     int       `json:"id"`
+}
+
+func (p *Page) sortBy() string {
+	if p.SortBy == "" {
+		return SortByCreatedAt
+	}
+	return p.SortBy
+}
+
+func (p *Page) order() SortOrder {
+	if p.Order == "" {
+		return SortOrderDesc
+	}
+	return p.Order
+}
+
+// full reports whether a page of count rows may be followed by another one.
+func (p *Page) full(count int) bool {
+	return p != nil && p.Limit > 0 && uint64(count) == p.Limit
+}
+
+// next encodes the cursor of the last row of a full page, empty when there is no next page.
+func (p *Page) next(count int, id int, value float64) string {
+	if !p.full(count) {
+		return ""
+	}
+
+	if p.sortBy() == SortByCreatedAt {
+		value = 0
+	}
+
+	payload, _ := json.Marshal(&cursor{SortBy: p.sortBy(), Order: p.order(), Value: value, ID: id})
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func (p *Page) decodeCursor() (*cursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", exception.InvalidPageError)
+	}
+
+	var c cursor
+	if err := json.Unmarshal(payload, &c); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", exception.InvalidPageError)
+	}
+
+	if c.SortBy != p.sortBy() || c.Order != p.order() {
+		return nil, fmt.Errorf("%w: cursor does not match the sort", exception.InvalidPageError)
+	}
+
+	return &c, nil
+}
+
+// paginate orders the query by the sort column, ties broken by id, and keeps
+// the rows after the cursor. sortColumns maps the accepted sort options to
+// their column.
+func paginate(query squirrel.SelectBuilder, page *Page, sortColumns map[string]string) (squirrel.SelectBuilder, error) {
+	if page == nil {
+		return query.OrderBy("id DESC"), nil
+	}
+
+	column, ok := sortColumns[page.sortBy()]
+	if !ok {
+		return query, fmt.Errorf("%w: unsupported sort %q", exception.InvalidPageError, page.sortBy())
+	}
+
+	order := page.order()
+	if order != SortOrderAsc && order != SortOrderDesc {
+		return query, fmt.Errorf("%w: unsupported order %q", exception.InvalidPageError, order)
+	}
+
+	comparator, direction := "<", "DESC"
+	if order == SortOrderAsc {
+		comparator, direction = ">", "ASC"
+	}
+
+	if page.Cursor != "" {
+		c, err := page.decodeCursor()
+		if err != nil {
+			return query, err
+		}
+
+		if column == "id" {
+			query = query.Where(fmt.Sprintf("id %s ?", comparator), c.ID)
+		} else {
+			query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparator), c.Value, c.ID)
+		}
+	}
+
+	if column == "id" {
+		query = query.OrderBy("id " + direction)
+	} else {
+		query = query.OrderBy(column+" "+direction, "id "+direction)
+	}
+
+	if page.Limit > 0 {
+		query = query.Limit(page.Limit)
+	}
+
+	return query, nil
+}
+
+var taskSortColumns = map[string]string{
+	SortByCreatedAt: "id",
+	"swap_amount":   "swap_amount",
+}
+
+// NextTasksCursor returns the cursor of the page following tasks, empty on the last page.
+func NextTasksCursor(tasks []*model.Task, page *Page) string {
+	if len(tasks) == 0 {
+		return ""
+	}
+
+	last := tasks[len(tasks)-1]
+	return page.next(len(tasks), last.ID, last.SwapAmount)
+}
+
+var rewardRecordSortColumns = map[string]string{
+	SortByCreatedAt: "id",
+	"points":        "points",
+}
+
+// NextRewardRecordsCursor returns the cursor of the page following records, empty on the last page.
+func NextRewardRecordsCursor(records []*model.RewardRecord, page *Page) string {
+	if len(records) == 0 {
+		return ""
+	}
+
+	last := records[len(records)-1]
+	return page.next(len(records), last.ID, last.Points)
+}
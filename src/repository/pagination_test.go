@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestPaginate(t *testing.T) {
+	query := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).Select("id").From(tasksTableName)
+
+	t.Run("Without Page", func(t *testing.T) {
+		paginated, err := paginate(query, nil, taskSortColumns)
+		assert.NoError(t, err)
+
+		sqlCommand, _, _ := paginated.ToSql()
+		assert.Equal(t, "SELECT id FROM tasks ORDER BY id DESC", sqlCommand)
+	})
+
+	t.Run("Follow Cursor Of Previous Page", func(t *testing.T) {
+		page := &Page{Limit: 2, SortBy: "swap_amount", Order: SortOrderAsc}
+		tasks := []*model.Task{{ID: 3, SwapAmount: 10}, {ID: 1, SwapAmount: 25.5}}
+
+		page.Cursor = NextTasksCursor(tasks, page)
+		assert.NotEmpty(t, page.Cursor)
+
+		paginated, err := paginate(query, page, taskSortColumns)
+		assert.NoError(t, err)
+
+		sqlCommand, args, _ := paginated.ToSql()
+		assert.Equal(t, "SELECT id FROM tasks WHERE (swap_amount, id) > ($1, $2) ORDER BY swap_amount ASC, id ASC LIMIT 2", sqlCommand)
+		assert.Equal(t, []interface{}{25.5, 1}, args)
+	})
+
+	t.Run("No Cursor After Last Page", func(t *testing.T) {
+		page := &Page{Limit: 3}
+		assert.Empty(t, NextTasksCursor([]*model.Task{{ID: 3}, {ID: 1}}, page))
+		assert.Empty(t, NextTasksCursor(nil, page))
+	})
+
+	t.Run("Reject Cursor Of Another Sort", func(t *testing.T) {
+		page := &Page{Limit: 1}
+		cursor := NextRewardRecordsCursor([]*model.RewardRecord{{ID: 5}}, page)
+
+		_, err := paginate(query, &Page{Cursor: cursor, Limit: 1, SortBy: "points"}, rewardRecordSortColumns)
+		assert.ErrorIs(t, err, exception.InvalidPageError)
+	})
+
+	t.Run("Reject Invalid Page", func(t *testing.T) {
+		for _, page := range []*Page{
+			{SortBy: "user_id"},
+			{Order: "random"},
+			{Cursor: "not a cursor"},
+		} {
+			_, err := paginate(query, page, taskSortColumns)
+			assert.ErrorIs(t, err, exception.InvalidPageError)
+		}
+	})
+}
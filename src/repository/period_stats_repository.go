@@ -0,0 +1,285 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+const (
+	userPeriodStatsTableName  = "user_period_stats"
+	periodStatsSwapsTableName = "period_stats_swaps"
+)
+
+// refreshPeriodStatsCommand rebuilds the stats of a period from the tasks and
+// their reward records, which stay the source of truth. The weight is kept, it
+// only backs the projections of the running period.
+const refreshPeriodStatsCommand = `
+INSERT INTO user_period_stats (campaign_id, period_index, user_id, volume, swap_count, onboarded, points, updated_at)
+SELECT $1, $2, t.user_id,
+       COALESCE(SUM(t.swap_amount) FILTER (WHERE t.type = $5), 0),
+       COUNT(*) FILTER (WHERE t.type = $5),
+       EXISTS (SELECT 1 FROM tasks o WHERE o.campaign_id = $1 AND o.user_id = t.user_id AND o.type = $6),
+       COALESCE(SUM(r.points), 0),
+       $7
+FROM tasks t
+LEFT JOIN reward_records r ON r.task_id = t.id
+WHERE t.campaign_id = $1 AND t.created_at >= $3 AND t.created_at < $4
+GROUP BY t.user_id
+ON CONFLICT (campaign_id, period_index, user_id) DO UPDATE SET
+    volume = EXCLUDED.volume,
+    swap_count = EXCLUDED.swap_count,
+    onboarded = EXCLUDED.onboarded,
+    points = EXCLUDED.points,
+    updated_at = EXCLUDED.updated_at`
+
+type LeaderboardCondition struct {
+	CampaignID int
+	// PeriodIndex limits the ranking to one period, nil ranks the whole campaign.
+	PeriodIndex *int
+	SortBy      model.LeaderboardSortBy
+	Offset      int
+	Limit       int
+}
+
+type PeriodStatsRepository interface {
+	// AddSwap adds the swap to the stats of its campaign. A swap already added
+	// to the campaign is skipped, so a retried job doesn't count it twice.
+	AddSwap(swapID string, stats *model.UserPeriodStats) error
+	RefreshPeriodStats(campaignID int, periodIndex int, from time.Time, to time.Time) error
+	GetUserPeriodStats(campaignID int, periodIndex int, userID string) (*model.UserPeriodStats, error)
+	GetPeriodTotals(campaignID int, periodIndex int) (*model.PeriodTotals, error)
+	SearchLeaderboard(condition *LeaderboardCondition) ([]*model.LeaderboardEntry, int, error)
+	GetLeaderboardEntry(condition *LeaderboardCondition, userID string) (*model.LeaderboardEntry, error)
+}
+
+type periodStatsRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewPeriodStatsRepository() PeriodStatsRepository {
+	return &periodStatsRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// AddSwap adds the volume, weight and swap count of stats to the stored row. A
+// user never loses the onboarded flag once it is set for the period.
+func (r *periodStatsRepositoryImpl) AddSwap(swapID string, stats *model.UserPeriodStats) error {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(periodStatsSwapsTableName).
+		Columns("swap_id", "campaign_id", "created_at").
+		Values(swapID, stats.CampaignID, stats.UpdatedAt.UTC()).
+		Suffix("ON CONFLICT (swap_id, campaign_id) DO NOTHING RETURNING swap_id").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	var addedSwapID string
+	err = tx.QueryRow(sqlCommand, args...).Scan(&addedSwapID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	sqlCommand, args, err = psql.Insert(userPeriodStatsTableName).
+		Columns("campaign_id", "period_index", "user_id", "volume", "swap_count", "onboarded", "weight", "updated_at").
+		Values(stats.CampaignID, stats.PeriodIndex, stats.UserID, stats.Volume, stats.SwapCount, stats.Onboarded, stats.Weight,
+			stats.UpdatedAt.UTC()).
+		Suffix("ON CONFLICT (campaign_id, period_index, user_id) DO UPDATE SET " +
+			"volume = user_period_stats.volume + EXCLUDED.volume, " +
+			"weight = user_period_stats.weight + EXCLUDED.weight, " +
+			"swap_count = user_period_stats.swap_count + EXCLUDED.swap_count, " +
+			"onboarded = user_period_stats.onboarded OR EXCLUDED.onboarded, " +
+			"updated_at = EXCLUDED.updated_at").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(sqlCommand, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *periodStatsRepositoryImpl) RefreshPeriodStats(campaignID int, periodIndex int, from time.Time, to time.Time) error {
+	_, err := r.dbInstance.Exec(refreshPeriodStatsCommand, campaignID, periodIndex, from.UTC(), to.UTC(),
+		model.TaskTypeSharedPool, model.TaskTypeOnboarding, time.Now().UTC())
+	return err
+}
+
+// GetUserPeriodStats returns nil without error when the user did not swap in
+// the period.
+func (r *periodStatsRepositoryImpl) GetUserPeriodStats(campaignID int, periodIndex int, userID string) (*model.UserPeriodStats, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("campaign_id, period_index, user_id, volume, swap_count, onboarded, weight, points, updated_at").
+		From(userPeriodStatsTableName).
+		Where(squirrel.Eq{"campaign_id": campaignID, "period_index": periodIndex, "user_id": userID}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var stats model.UserPeriodStats
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&stats.CampaignID, &stats.PeriodIndex, &stats.UserID,
+		&stats.Volume, &stats.SwapCount, &stats.Onboarded, &stats.Weight, &stats.Points, &stats.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	stats.UpdatedAt = stats.UpdatedAt.In(time.UTC)
+	return &stats, nil
+}
+
+func (r *periodStatsRepositoryImpl) GetPeriodTotals(campaignID int, periodIndex int) (*model.PeriodTotals, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("COALESCE(SUM(volume), 0), COALESCE(SUM(volume) FILTER (WHERE onboarded), 0), " +
+			"COALESCE(SUM(weight) FILTER (WHERE onboarded), 0), COUNT(*)").
+		From(userPeriodStatsTableName).
+		Where(squirrel.Eq{"campaign_id": campaignID, "period_index": periodIndex}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var totals model.PeriodTotals
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&totals.Volume, &totals.EligibleVolume, &totals.EligibleWeight, &totals.Participants)
+	if err != nil {
+		return nil, err
+	}
+
+	return &totals, nil
+}
+
+func (r *periodStatsRepositoryImpl) SearchLeaderboard(condition *LeaderboardCondition) ([]*model.LeaderboardEntry, int, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("COUNT(*)").FromSelect(leaderboardTotalsQuery(condition), "totals").ToSql()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var total int
+	if err := r.dbInstance.QueryRow(sqlCommand, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	query, err := rankedLeaderboardQuery(condition)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	sqlCommand, args, err = query.
+		OrderBy("rank", "ranked.user_id").
+		Limit(uint64(condition.Limit)).
+		Offset(uint64(condition.Offset)).
+		ToSql()
+
+	if err != nil {
+		return nil, 0, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	entries := make([]*model.LeaderboardEntry, 0, condition.Limit)
+	for rows.Next() {
+		var entry model.LeaderboardEntry
+		if err := rows.Scan(&entry.UserID, &entry.Volume, &entry.Points, &entry.SwapCount, &entry.Rank, &entry.Tier); err != nil {
+			return nil, 0, err
+		}
+
+		entries = append(entries, &entry)
+	}
+
+	return entries, total, rows.Err()
+}
+
+// GetLeaderboardEntry returns nil without error when the user is not ranked.
+func (r *periodStatsRepositoryImpl) GetLeaderboardEntry(condition *LeaderboardCondition, userID string) (*model.LeaderboardEntry, error) {
+	query, err := rankedLeaderboardQuery(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlCommand, args, err := query.Where(squirrel.Eq{"ranked.user_id": userID}).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var entry model.LeaderboardEntry
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&entry.UserID, &entry.Volume, &entry.Points, &entry.SwapCount, &entry.Rank,
+		&entry.Tier)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// leaderboardTotalsQuery returns one row per user of the campaign or period.
+// Nested queries keep the default placeholders, the outer query numbers them.
+func leaderboardTotalsQuery(condition *LeaderboardCondition) squirrel.SelectBuilder {
+	if condition.PeriodIndex != nil {
+		return squirrel.Select("user_id", "volume", "points", "swap_count").
+			From(userPeriodStatsTableName).
+			Where(squirrel.Eq{"campaign_id": condition.CampaignID, "period_index": *condition.PeriodIndex})
+	}
+
+	return squirrel.Select("user_id", "SUM(volume) AS volume", "SUM(points) AS points", "SUM(swap_count) AS swap_count").
+		From(userPeriodStatsTableName).
+		Where(squirrel.Eq{"campaign_id": condition.CampaignID}).
+		GroupBy("user_id")
+}
+
+func rankedLeaderboardQuery(condition *LeaderboardCondition) (squirrel.SelectBuilder, error) {
+	var orderBy string
+	switch condition.SortBy {
+	case model.LeaderboardSortByVolume:
+		orderBy = "volume DESC"
+	case model.LeaderboardSortByPoints:
+		orderBy = "points DESC, volume DESC"
+	default:
+		return squirrel.SelectBuilder{}, fmt.Errorf("unsupported leaderboard sort %s", condition.SortBy)
+	}
+
+	ranked := squirrel.Select("user_id", "volume", "points", "swap_count", "RANK() OVER (ORDER BY "+orderBy+") AS rank").
+		FromSelect(leaderboardTotalsQuery(condition), "totals")
+
+	return squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar).
+		Select("ranked.user_id", "volume", "points", "swap_count", "rank", "COALESCE(users.tier, '')").
+		FromSelect(ranked, "ranked").
+		LeftJoin(usersTableName + " ON users.id = ranked.user_id"), nil
+}
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+func TestPeriodStatsRepositoryImpl(t *testing.T) {
+	setUpPeriodStatsRepo := func(t *testing.T) *periodStatsRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM user_period_stats")
+			dbInstance.Exec("DELETE FROM period_stats_swaps")
+		})
+
+		return &periodStatsRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	newSwap := func(userID string, volume float64, onboarded bool) *model.UserPeriodStats {
+		return &model.UserPeriodStats{
+			CampaignID:  1,
+			PeriodIndex: 0,
+			UserID:      userID,
+			Volume:      volume,
+			SwapCount:   1,
+			Onboarded:   onboarded,
+			Weight:      volume * 2,
+			UpdatedAt:   time.Now().UTC(),
+		}
+	}
+
+	t.Run("AddSwap Accumulates", func(t *testing.T) {
+		periodStatsRepo := setUpPeriodStatsRepo(t)
+
+		assert.NoError(t, periodStatsRepo.AddSwap("swap_1", newSwap("test_user_1", 500, false)))
+		assert.NoError(t, periodStatsRepo.AddSwap("swap_2", newSwap("test_user_1", 1500, true)))
+		assert.NoError(t, periodStatsRepo.AddSwap("swap_3", newSwap("test_user_1", 100, false)))
+		// a retried swap counts once
+		assert.NoError(t, periodStatsRepo.AddSwap("swap_3", newSwap("test_user_1", 100, false)))
+
+		stats, err := periodStatsRepo.GetUserPeriodStats(1, 0, "test_user_1")
+		assert.NoError(t, err)
+		assert.Equal(t, 2100.0, stats.Volume)
+		assert.Equal(t, 4200.0, stats.Weight)
+		assert.Equal(t, 3, stats.SwapCount)
+		assert.True(t, stats.Onboarded)
+	})
+
+	t.Run("GetUserPeriodStats, No Swap", func(t *testing.T) {
+		periodStatsRepo := setUpPeriodStatsRepo(t)
+
+		stats, err := periodStatsRepo.GetUserPeriodStats(1, 0, "test_user_1")
+		assert.NoError(t, err)
+		assert.Nil(t, stats)
+	})
+
+	t.Run("GetPeriodTotals", func(t *testing.T) {
+		periodStatsRepo := setUpPeriodStatsRepo(t)
+
+		_ = periodStatsRepo.AddSwap("swap_1", newSwap("test_user_1", 2000, true))
+		_ = periodStatsRepo.AddSwap("swap_2", newSwap("test_user_2", 300, false))
+
+		totals, err := periodStatsRepo.GetPeriodTotals(1, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, 2300.0, totals.Volume)
+		assert.Equal(t, 2000.0, totals.EligibleVolume)
+		assert.Equal(t, 4000.0, totals.EligibleWeight)
+		assert.Equal(t, 2, totals.Participants)
+	})
+
+	t.Run("RefreshPeriodStats From Tasks And Rewards", func(t *testing.T) {
+		periodStatsRepo := setUpPeriodStatsRepo(t)
+		t.Cleanup(func() {
+			periodStatsRepo.dbInstance.Exec("DELETE FROM tasks")
+			periodStatsRepo.dbInstance.Exec("DELETE FROM reward_records")
+		})
+
+		from := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+		to := from.Add(time.Hour * 24 * 7)
+
+		insertTask := func(userID string, taskType model.TaskType, amount float64, createdAt time.Time, points float64) {
+			var taskID int
+			_ = periodStatsRepo.dbInstance.QueryRow(
+				"INSERT INTO tasks (campaign_id, status, type, user_id, created_at, swap_amount) VALUES (1, 'done', $1, $2, $3, $4) RETURNING id",
+				taskType, userID, createdAt, amount).Scan(&taskID)
+			if points > 0 {
+				_, _ = periodStatsRepo.dbInstance.Exec(
+					"INSERT INTO reward_records (campaign_id, user_id, points, task_id, created_at) VALUES (1, $1, $2, $3, $4)",
+					userID, points, taskID, to)
+			}
+		}
+
+		insertTask("test_user_1", model.TaskTypeOnboarding, 1500, from.Add(time.Hour), 100)
+		insertTask("test_user_1", model.TaskTypeSharedPool, 1500, from.Add(time.Hour), 7500)
+		insertTask("test_user_2", model.TaskTypeSharedPool, 500, from.Add(time.Hour*2), 0)
+		insertTask("test_user_2", model.TaskTypeSharedPool, 500, to.Add(time.Hour), 0)
+
+		_ = periodStatsRepo.AddSwap("swap_1", newSwap("test_user_2", 9999, false))
+
+		err := periodStatsRepo.RefreshPeriodStats(1, 0, from, to)
+		assert.NoError(t, err)
+
+		stats, _ := periodStatsRepo.GetUserPeriodStats(1, 0, "test_user_1")
+		assert.Equal(t, 1500.0, stats.Volume)
+		assert.Equal(t, 1, stats.SwapCount)
+		assert.Equal(t, 7600.0, stats.Points)
+		assert.True(t, stats.Onboarded)
+
+		stats, _ = periodStatsRepo.GetUserPeriodStats(1, 0, "test_user_2")
+		assert.Equal(t, 500.0, stats.Volume)
+		assert.False(t, stats.Onboarded)
+	})
+
+	t.Run("Leaderboard", func(t *testing.T) {
+		periodStatsRepo := setUpPeriodStatsRepo(t)
+
+		addStats := func(periodIndex int, userID string, volume float64, points float64) {
+			_, _ = periodStatsRepo.dbInstance.Exec(
+				"INSERT INTO user_period_stats (campaign_id, period_index, user_id, volume, swap_count, points, updated_at) VALUES (1, $1, $2, $3, 1, $4, NOW())",
+				periodIndex, userID, volume, points)
+		}
+
+		addStats(0, "test_user_1", 3000, 100)
+		addStats(0, "test_user_2", 1000, 900)
+		addStats(1, "test_user_2", 5000, 200)
+		addStats(1, "test_user_3", 100, 0)
+
+		_, _ = periodStatsRepo.dbInstance.Exec("INSERT INTO users (id, points, tier) VALUES ('test_user_2', 0, 'gold')")
+		t.Cleanup(func() {
+			periodStatsRepo.dbInstance.Exec("DELETE FROM users")
+		})
+
+		periodIndex := 0
+		entries, total, err := periodStatsRepo.SearchLeaderboard(&LeaderboardCondition{
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+			SortBy:      model.LeaderboardSortByVolume,
+			Limit:       10,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+		assert.Equal(t, "test_user_1", entries[0].UserID)
+
+		condition := &LeaderboardCondition{
+			CampaignID: 1,
+			SortBy:     model.LeaderboardSortByPoints,
+			Offset:     1,
+			Limit:      1,
+		}
+		entries, total, err = periodStatsRepo.SearchLeaderboard(condition)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, total)
+		assert.Equal(t, 1, len(entries))
+		assert.Equal(t, "test_user_1", entries[0].UserID)
+		assert.Equal(t, 2, entries[0].Rank)
+		assert.Equal(t, "", entries[0].Tier)
+
+		entry, err := periodStatsRepo.GetLeaderboardEntry(condition, "test_user_2")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, entry.Rank)
+		assert.Equal(t, 6000.0, entry.Volume)
+		assert.Equal(t, 2, entry.SwapCount)
+		assert.Equal(t, "gold", entry.Tier)
+
+		entry, err = periodStatsRepo.GetLeaderboardEntry(condition, "unknown_user")
+		assert.NoError(t, err)
+		assert.Nil(t, entry)
+	})
+}
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const redemptionsTableName = "redemptions"
+
+const redemptionColumns = "id, user_id, item_id, points, status, ledger_transaction_id, COALESCE(refund_ledger_transaction_id, 0), " +
+	"COALESCE(reason, ''), COALESCE(operator, ''), created_at, updated_at"
+
+type SearchRedemptionsCondition struct {
+	UserID   string
+	Statuses []model.RedemptionStatus
+}
+
+type RedemptionRepository interface {
+	CreateRedemption(redemption *model.Redemption) (*model.Redemption, error)
+	GetRedemption(id int) (*model.Redemption, error)
+	SearchRedemptions(condition *SearchRedemptionsCondition) ([]*model.Redemption, error)
+	// FulfillRedemption and CancelRedemption write the audit log audit builds
+	// from the closed redemption in the same transaction.
+	FulfillRedemption(id int, operator string, now time.Time, audit func(redemption *model.Redemption) *model.AuditLog) (*model.Redemption, error)
+	CancelRedemption(id int, reason string, operator string, now time.Time, audit func(redemption *model.Redemption) *model.AuditLog) (*model.Redemption, error)
+}
+
+type redemptionRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewRedemptionRepository() RedemptionRepository {
+	return &redemptionRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// CreateRedemption spends the points of the redemption and stores it pending,
+// in one transaction. The balance of the user stays locked while it's
+// debited, so concurrent redemptions can't spend more than the user has.
+func (r *redemptionRepositoryImpl) CreateRedemption(redemption *model.Redemption) (*model.Redemption, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	transaction := model.NewUserLedgerTransaction(model.LedgerTransactionKindRedemption, redemption.UserID,
+		model.LedgerAccountRedemptions, -redemption.Points, redemption.CreatedAt)
+
+	if _, err := postLedgerTransaction(tx, transaction); err != nil {
+		return nil, err
+	}
+
+	redemption.Status = model.RedemptionStatusPending
+	redemption.LedgerTransactionID = transaction.ID
+	redemption.UpdatedAt = redemption.CreatedAt
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(redemptionsTableName).
+		Columns("user_id", "item_id", "points", "status", "ledger_transaction_id", "created_at", "updated_at").
+		Values(redemption.UserID, redemption.ItemID, redemption.Points, redemption.Status, redemption.LedgerTransactionID,
+			redemption.CreatedAt.UTC(), redemption.UpdatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.QueryRow(sqlCommand, args...).Scan(&redemption.ID); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return redemption, nil
+}
+
+func (r *redemptionRepositoryImpl) GetRedemption(id int) (*model.Redemption, error) {
+	row := r.dbInstance.QueryRow("SELECT "+redemptionColumns+" FROM "+redemptionsTableName+" WHERE id = $1", id)
+	return scanRedemption(row)
+}
+
+// SearchRedemptions returns the matching redemptions, latest first.
+func (r *redemptionRepositoryImpl) SearchRedemptions(condition *SearchRedemptionsCondition) ([]*model.Redemption, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	query := psql.Select(redemptionColumns).From(redemptionsTableName).OrderBy("id DESC")
+
+	if condition.UserID != "" {
+		query = query.Where(squirrel.Eq{"user_id": condition.UserID})
+	}
+
+	if len(condition.Statuses) > 0 {
+		query = query.Where(squirrel.Eq{"status": condition.Statuses})
+	}
+
+	sqlCommand, args, err := query.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var redemptions []*model.Redemption
+	for rows.Next() {
+		redemption, err := scanRedemption(rows)
+		if err != nil {
+			return nil, err
+		}
+		redemptions = append(redemptions, redemption)
+	}
+
+	return redemptions, rows.Err()
+}
+
+func (r *redemptionRepositoryImpl) FulfillRedemption(id int, operator string, now time.Time, audit func(redemption *model.Redemption) *model.AuditLog) (*model.Redemption, error) {
+	return r.closeRedemption(id, audit, func(tx *sql.Tx, redemption *model.Redemption) error {
+		redemption.Status = model.RedemptionStatusFulfilled
+		redemption.Operator = operator
+		redemption.UpdatedAt = now.UTC()
+		return nil
+	})
+}
+
+// CancelRedemption refunds the points of a pending redemption.
+func (r *redemptionRepositoryImpl) CancelRedemption(id int, reason string, operator string, now time.Time, audit func(redemption *model.Redemption) *model.AuditLog) (*model.Redemption, error) {
+	return r.closeRedemption(id, audit, func(tx *sql.Tx, redemption *model.Redemption) error {
+		refund := model.NewUserLedgerTransaction(model.LedgerTransactionKindRedemptionRefund, redemption.UserID,
+			model.LedgerAccountRedemptions, redemption.Points, now.UTC())
+
+		if _, err := postLedgerTransaction(tx, refund); err != nil {
+			return err
+		}
+
+		redemption.Status = model.RedemptionStatusCancelled
+		redemption.RefundLedgerTransactionID = refund.ID
+		redemption.Reason = reason
+		redemption.Operator = operator
+		redemption.UpdatedAt = now.UTC()
+		return nil
+	})
+}
+
+// closeRedemption locks a pending redemption, lets transition move it to its final
+// status and saves it with its audit log, in one transaction. The lock makes
+// sure a redemption is fulfilled or cancelled once.
+func (r *redemptionRepositoryImpl) closeRedemption(id int, audit func(redemption *model.Redemption) *model.AuditLog, transition func(tx *sql.Tx, redemption *model.Redemption) error) (*model.Redemption, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	redemption, err := scanRedemption(tx.QueryRow("SELECT "+redemptionColumns+" FROM "+redemptionsTableName+" WHERE id = $1 FOR UPDATE", id))
+	if err != nil {
+		return nil, err
+	}
+
+	if redemption.Status != model.RedemptionStatusPending {
+		return nil, exception.RedemptionNotPendingError
+	}
+
+	if err := transition(tx, redemption); err != nil {
+		return nil, err
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(redemptionsTableName).
+		Set("status", redemption.Status).
+		Set("refund_ledger_transaction_id", sql.NullInt64{Int64: int64(redemption.RefundLedgerTransactionID), Valid: redemption.RefundLedgerTransactionID != 0}).
+		Set("reason", sql.NullString{String: redemption.Reason, Valid: redemption.Reason != ""}).
+		Set("operator", sql.NullString{String: redemption.Operator, Valid: redemption.Operator != ""}).
+		Set("updated_at", redemption.UpdatedAt).
+		Where(squirrel.Eq{"id": redemption.ID}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(sqlCommand, args...); err != nil {
+		return nil, err
+	}
+
+	if audit != nil {
+		if err := insertAuditLog(tx, audit(redemption)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return redemption, nil
+}
+
+func scanRedemption(row rowScanner) (*model.Redemption, error) {
+	var redemption model.Redemption
+	err := row.Scan(&redemption.ID, &redemption.UserID, &redemption.ItemID, &redemption.Points, &redemption.Status,
+		&redemption.LedgerTransactionID, &redemption.RefundLedgerTransactionID, &redemption.Reason, &redemption.Operator,
+		&redemption.CreatedAt, &redemption.UpdatedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.RedemptionNotFoundError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &redemption, nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+var setUpRedemptionRepo = func(t *testing.T) *redemptionRepositoryImpl {
+	dbInstance := database.GetDBInstance()
+	createLedgerUsers(dbInstance, "test_user_id")
+
+	t.Cleanup(func() {
+		cleanUpLedger(dbInstance)
+	})
+
+	rewardRecordRepo := &rewardRecordRepositoryImpl{dbInstance: dbInstance}
+	_, _ = rewardRecordRepo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+
+	return &redemptionRepositoryImpl{
+		dbInstance: dbInstance,
+	}
+}
+
+func TestRedemptionRepositoryImpl_CreateRedemption(t *testing.T) {
+	t.Run("CreateRedemption", func(t *testing.T) {
+		repo := setUpRedemptionRepo(t)
+		userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+
+		redemption, err := repo.CreateRedemption(&model.Redemption{UserID: "test_user_id", ItemID: "mug", Points: 60, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, redemption.ID)
+		assert.NotEmpty(t, redemption.LedgerTransactionID)
+		assert.Equal(t, model.RedemptionStatusPending, redemption.Status)
+
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 40.0, user.Points)
+
+		found, err := repo.GetRedemption(redemption.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, "mug", found.ItemID)
+	})
+
+	t.Run("Concurrent Redemptions Never Overspend", func(t *testing.T) {
+		repo := setUpRedemptionRepo(t)
+		userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 5)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := repo.CreateRedemption(&model.Redemption{UserID: "test_user_id", ItemID: "mug", Points: 30, CreatedAt: time.Now().UTC()})
+				errs <- err
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		succeeded := 0
+		for err := range errs {
+			if err == nil {
+				succeeded++
+			} else {
+				assert.ErrorIs(t, err, exception.InsufficientPointsError)
+			}
+		}
+
+		assert.Equal(t, 3, succeeded)
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 10.0, user.Points)
+	})
+}
+
+func TestRedemptionRepositoryImpl_CloseRedemption(t *testing.T) {
+	t.Run("CancelRedemption", func(t *testing.T) {
+		repo := setUpRedemptionRepo(t)
+		userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+
+		redemption, _ := repo.CreateRedemption(&model.Redemption{UserID: "test_user_id", ItemID: "mug", Points: 60, CreatedAt: time.Now().UTC()})
+
+		var auditLog *model.AuditLog
+		cancelled, err := repo.CancelRedemption(redemption.ID, "out of stock", "ops", time.Now().UTC(), func(redemption *model.Redemption) *model.AuditLog {
+			auditLog = model.NewAuditLog("ops", model.AuditActionCancelRedemption, "redemption", nil)
+			return auditLog
+		})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, auditLog.ID)
+		assert.Equal(t, model.RedemptionStatusCancelled, cancelled.Status)
+		assert.NotEmpty(t, cancelled.RefundLedgerTransactionID)
+
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 100.0, user.Points)
+
+		_, err = repo.CancelRedemption(redemption.ID, "out of stock", "ops", time.Now().UTC(), nil)
+		assert.ErrorIs(t, err, exception.RedemptionNotPendingError)
+	})
+
+	t.Run("FulfillRedemption", func(t *testing.T) {
+		repo := setUpRedemptionRepo(t)
+
+		redemption, _ := repo.CreateRedemption(&model.Redemption{UserID: "test_user_id", ItemID: "mug", Points: 60, CreatedAt: time.Now().UTC()})
+
+		fulfilled, err := repo.FulfillRedemption(redemption.ID, "ops", time.Now().UTC(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, model.RedemptionStatusFulfilled, fulfilled.Status)
+
+		_, err = repo.CancelRedemption(redemption.ID, "too late", "ops", time.Now().UTC(), nil)
+		assert.ErrorIs(t, err, exception.RedemptionNotPendingError)
+
+		redemptions, err := repo.SearchRedemptions(&SearchRedemptionsCondition{
+			UserID:   "test_user_id",
+			Statuses: []model.RedemptionStatus{model.RedemptionStatusFulfilled},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(redemptions))
+	})
+
+	t.Run("Unknown Redemption", func(t *testing.T) {
+		repo := setUpRedemptionRepo(t)
+
+		_, err := repo.FulfillRedemption(-1, "ops", time.Now().UTC(), nil)
+		assert.ErrorIs(t, err, exception.RedemptionNotFoundError)
+	})
+}
@@ -0,0 +1,300 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const (
+	referralCodesTableName   = "referral_codes"
+	referralsTableName       = "referrals"
+	referralRewardsTableName = "referral_rewards"
+	referralColumns          = "referee_id, referrer_id, code, created_at, onboarded_at"
+)
+
+type ReferralRepository interface {
+	// CreateCode fails with exception.ReferralCodeAlreadyExistsError when the
+	// code is taken or the user already has one.
+	CreateCode(code *model.ReferralCode) (*model.ReferralCode, error)
+	GetCode(code string) (*model.ReferralCode, error)
+	GetCodeOfUser(userID string) (*model.ReferralCode, error)
+	// CreateReferral fails with exception.ReferralAlreadyLinkedError when the
+	// referee is already linked to a code.
+	CreateReferral(referral *model.Referral) (*model.Referral, error)
+	// GetReferral returns the referral of the referee, nil when it wasn't
+	// referred.
+	GetReferral(refereeID string) (*model.Referral, error)
+	// SearchReferrals returns the referees of the referrer, latest first.
+	SearchReferrals(referrerID string) ([]*model.Referral, error)
+	// MarkOnboarded records the first onboarding of the referee and returns its
+	// referral, nil when it wasn't referred or already onboarded.
+	MarkOnboarded(refereeID string, at time.Time) (*model.Referral, error)
+	UnmarkOnboarded(refereeID string) error
+	// GetReferrers returns the referrer of each onboarded referee.
+	GetReferrers(refereeIDs []string) (map[string]string, error)
+	// ClaimReward records that the referrer is paid its share of the task of
+	// a referee, it tells false when it already was.
+	ClaimReward(taskID int, referrerID string, at time.Time) (bool, error)
+	ReleaseReward(taskID int) error
+}
+
+type referralRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewReferralRepository() ReferralRepository {
+	return &referralRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *referralRepositoryImpl) CreateCode(code *model.ReferralCode) (*model.ReferralCode, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(referralCodesTableName).
+		Columns("code", "user_id", "created_at").
+		Values(code.Code, code.UserID, code.CreatedAt.UTC()).
+		Suffix("ON CONFLICT DO NOTHING RETURNING code").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&code.Code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.ReferralCodeAlreadyExistsError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return code, nil
+}
+
+func (r *referralRepositoryImpl) GetCode(code string) (*model.ReferralCode, error) {
+	return r.getCode(squirrel.Eq{"code": code})
+}
+
+func (r *referralRepositoryImpl) GetCodeOfUser(userID string) (*model.ReferralCode, error) {
+	return r.getCode(squirrel.Eq{"user_id": userID})
+}
+
+func (r *referralRepositoryImpl) getCode(where squirrel.Eq) (*model.ReferralCode, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("code, user_id, created_at").
+		From(referralCodesTableName).
+		Where(where).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var code model.ReferralCode
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&code.Code, &code.UserID, &code.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.ReferralCodeNotFoundError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	code.CreatedAt = code.CreatedAt.In(time.UTC)
+	return &code, nil
+}
+
+func (r *referralRepositoryImpl) CreateReferral(referral *model.Referral) (*model.Referral, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(referralsTableName).
+		Columns("referee_id", "referrer_id", "code", "created_at").
+		Values(referral.RefereeID, referral.ReferrerID, referral.Code, referral.CreatedAt.UTC()).
+		Suffix("ON CONFLICT (referee_id) DO NOTHING RETURNING " + referralColumns).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := scanReferral(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.ReferralAlreadyLinkedError
+	}
+
+	return created, err
+}
+
+func (r *referralRepositoryImpl) GetReferral(refereeID string) (*model.Referral, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select(referralColumns).
+		From(referralsTableName).
+		Where(squirrel.Eq{"referee_id": refereeID}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	referral, err := scanReferral(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return referral, err
+}
+
+func (r *referralRepositoryImpl) SearchReferrals(referrerID string) ([]*model.Referral, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select(referralColumns).
+		From(referralsTableName).
+		Where(squirrel.Eq{"referrer_id": referrerID}).
+		OrderBy("created_at DESC", "referee_id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var referrals []*model.Referral
+	for rows.Next() {
+		referral, err := scanReferral(rows)
+		if err != nil {
+			return nil, err
+		}
+		referrals = append(referrals, referral)
+	}
+
+	return referrals, rows.Err()
+}
+
+func (r *referralRepositoryImpl) MarkOnboarded(refereeID string, at time.Time) (*model.Referral, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(referralsTableName).
+		Set("onboarded_at", at.UTC()).
+		Where(squirrel.Eq{"referee_id": refereeID, "onboarded_at": nil}).
+		Suffix("RETURNING " + referralColumns).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	referral, err := scanReferral(r.dbInstance.QueryRow(sqlCommand, args...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return referral, err
+}
+
+func (r *referralRepositoryImpl) UnmarkOnboarded(refereeID string) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(referralsTableName).
+		Set("onboarded_at", nil).
+		Where(squirrel.Eq{"referee_id": refereeID}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
+func (r *referralRepositoryImpl) GetReferrers(refereeIDs []string) (map[string]string, error) {
+	referrers := make(map[string]string)
+	if len(refereeIDs) == 0 {
+		return referrers, nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("referee_id, referrer_id").
+		From(referralsTableName).
+		Where(squirrel.Eq{"referee_id": refereeIDs}).
+		Where(squirrel.NotEq{"onboarded_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var refereeID, referrerID string
+		if err := rows.Scan(&refereeID, &referrerID); err != nil {
+			return nil, err
+		}
+		referrers[refereeID] = referrerID
+	}
+
+	return referrers, rows.Err()
+}
+
+func scanReferral(row rowScanner) (*model.Referral, error) {
+	var referral model.Referral
+	var onboardedAt sql.NullTime
+	if err := row.Scan(&referral.RefereeID, &referral.ReferrerID, &referral.Code, &referral.CreatedAt, &onboardedAt); err != nil {
+		return nil, err
+	}
+
+	referral.CreatedAt = referral.CreatedAt.In(time.UTC)
+	if onboardedAt.Valid {
+		onboardedAt := onboardedAt.Time.In(time.UTC)
+		referral.OnboardedAt = &onboardedAt
+	}
+
+	return &referral, nil
+}
+
+func (r *referralRepositoryImpl) ClaimReward(taskID int, referrerID string, at time.Time) (bool, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(referralRewardsTableName).
+		Columns("task_id", "referrer_id", "created_at").
+		Values(taskID, referrerID, at.UTC()).
+		Suffix("ON CONFLICT (task_id) DO NOTHING").
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	result, err := r.dbInstance.Exec(sqlCommand, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	return affected > 0, err
+}
+
+func (r *referralRepositoryImpl) ReleaseReward(taskID int) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(referralRewardsTableName).
+		Where(squirrel.Eq{"task_id": taskID}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
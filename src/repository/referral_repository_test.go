@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestReferralRepositoryImpl(t *testing.T) {
+	setUpReferralRepo := func(t *testing.T) *referralRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM referral_rewards")
+			dbInstance.Exec("DELETE FROM referrals")
+			dbInstance.Exec("DELETE FROM referral_codes")
+		})
+
+		return &referralRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	now := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+
+	t.Run("CreateCode", func(t *testing.T) {
+		referralRepo := setUpReferralRepo(t)
+
+		created, err := referralRepo.CreateCode(&model.ReferralCode{Code: "ALICE", UserID: "0xabc", CreatedAt: now})
+		assert.NoError(t, err)
+		assert.Equal(t, "ALICE", created.Code)
+
+		code, err := referralRepo.GetCodeOfUser("0xabc")
+		assert.NoError(t, err)
+		assert.Equal(t, "ALICE", code.Code)
+		assert.Equal(t, now, code.CreatedAt)
+
+		_, err = referralRepo.CreateCode(&model.ReferralCode{Code: "ALICE", UserID: "0xdef", CreatedAt: now})
+		assert.ErrorIs(t, err, exception.ReferralCodeAlreadyExistsError)
+
+		_, err = referralRepo.CreateCode(&model.ReferralCode{Code: "OTHER", UserID: "0xabc", CreatedAt: now})
+		assert.ErrorIs(t, err, exception.ReferralCodeAlreadyExistsError)
+
+		_, err = referralRepo.GetCode("UNKNOWN")
+		assert.ErrorIs(t, err, exception.ReferralCodeNotFoundError)
+	})
+
+	t.Run("CreateReferral", func(t *testing.T) {
+		referralRepo := setUpReferralRepo(t)
+		_, _ = referralRepo.CreateCode(&model.ReferralCode{Code: "ALICE", UserID: "0xabc", CreatedAt: now})
+
+		created, err := referralRepo.CreateReferral(&model.Referral{RefereeID: "0xdef", ReferrerID: "0xabc", Code: "ALICE", CreatedAt: now})
+		assert.NoError(t, err)
+		assert.Nil(t, created.OnboardedAt)
+
+		_, err = referralRepo.CreateReferral(&model.Referral{RefereeID: "0xdef", ReferrerID: "0xabc", Code: "ALICE", CreatedAt: now})
+		assert.ErrorIs(t, err, exception.ReferralAlreadyLinkedError)
+
+		referral, err := referralRepo.GetReferral("0xdef")
+		assert.NoError(t, err)
+		assert.Equal(t, "0xabc", referral.ReferrerID)
+
+		referral, err = referralRepo.GetReferral("0x123")
+		assert.NoError(t, err)
+		assert.Nil(t, referral)
+
+		referrals, err := referralRepo.SearchReferrals("0xabc")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(referrals))
+	})
+
+	t.Run("MarkOnboarded", func(t *testing.T) {
+		referralRepo := setUpReferralRepo(t)
+		_, _ = referralRepo.CreateCode(&model.ReferralCode{Code: "ALICE", UserID: "0xabc", CreatedAt: now})
+		_, _ = referralRepo.CreateReferral(&model.Referral{RefereeID: "0xdef", ReferrerID: "0xabc", Code: "ALICE", CreatedAt: now})
+		_, _ = referralRepo.CreateReferral(&model.Referral{RefereeID: "0x123", ReferrerID: "0xabc", Code: "ALICE", CreatedAt: now})
+
+		referrers, err := referralRepo.GetReferrers([]string{"0xdef", "0x123"})
+		assert.NoError(t, err)
+		assert.Empty(t, referrers)
+
+		referral, err := referralRepo.MarkOnboarded("0xdef", now.Add(time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(time.Hour), *referral.OnboardedAt)
+
+		referral, err = referralRepo.MarkOnboarded("0xdef", now.Add(2*time.Hour))
+		assert.NoError(t, err)
+		assert.Nil(t, referral)
+
+		referrers, err = referralRepo.GetReferrers([]string{"0xdef", "0x123"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"0xdef": "0xabc"}, referrers)
+
+		assert.NoError(t, referralRepo.UnmarkOnboarded("0xdef"))
+		referral, err = referralRepo.MarkOnboarded("0xdef", now.Add(3*time.Hour))
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(3*time.Hour), *referral.OnboardedAt)
+	})
+
+	t.Run("ClaimReward", func(t *testing.T) {
+		referralRepo := setUpReferralRepo(t)
+
+		claimed, err := referralRepo.ClaimReward(1, "0xabc", now)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+
+		claimed, err = referralRepo.ClaimReward(1, "0xabc", now)
+		assert.NoError(t, err)
+		assert.False(t, claimed)
+
+		assert.NoError(t, referralRepo.ReleaseReward(1))
+		claimed, err = referralRepo.ClaimReward(1, "0xabc", now)
+		assert.NoError(t, err)
+		assert.True(t, claimed)
+	})
+}
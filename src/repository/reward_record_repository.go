@@ -1,25 +1,41 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/Masterminds/squirrel"
 	"time"
 	"trading-ace/src/database"
+	"trading-ace/src/exception"
 	"trading-ace/src/model"
 )
 
 const rewardRecordTableName = "reward_records"
 
 type RewardRecordSearchCondition struct {
-	StartTime time.Time
-	Duration  time.Duration
-	UserID    string
-	TaskID    int
+	StartTime  time.Time
+	Duration   time.Duration
+	UserID     string
+	TaskID     int
+	TaskIDs    []int
+	CampaignID int
+	Types      []model.RewardRecordType
+	// Page sorts by created_at or points.
+	Page *Page
 }
 
 type RewardRecordRepository interface {
 	CreateRewardRecord(rewardRecord *model.RewardRecord) (*model.RewardRecord, error)
+	// CreateAdjustment writes the audit log audit builds from the saved
+	// adjustment in the same transaction.
+	CreateAdjustment(adjustment *model.RewardRecord, audit func(adjustment *model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error)
 	SearchRewardRecords(condition *RewardRecordSearchCondition) ([]*model.RewardRecord, error)
+	StreamRewardRecords(ctx context.Context, condition *RewardRecordSearchCondition, fn func(record *model.RewardRecord) error) error
+	GetRewardRecordsByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error)
+	SumPointsByCampaign(userID string) (map[int]float64, error)
 }
 
 type rewardRecordRepositoryImpl struct {
@@ -33,43 +49,218 @@ func NewRewardRecordRepository() RewardRecordRepository {
 	}
 }
 
+// CreateRewardRecord credits the points of a task reward to the user.
 func (r *rewardRecordRepositoryImpl) CreateRewardRecord(rewardRecord *model.RewardRecord) (*model.RewardRecord, error) {
+	rewardRecord.Type = model.RewardRecordTypeTaskReward
+	return r.createRewardRecord(rewardRecord, model.LedgerAccountRewards, nil)
+}
+
+// CreateAdjustment credits or debits the points of a manual adjustment. A
+// debit can't take the balance below zero.
+func (r *rewardRecordRepositoryImpl) CreateAdjustment(adjustment *model.RewardRecord, audit func(adjustment *model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error) {
+	adjustment.Type = model.RewardRecordTypeAdjustment
+	return r.createRewardRecord(adjustment, model.LedgerAccountAdjustments, audit)
+}
+
+// createRewardRecord posts the points of the record to the ledger against the
+// counterpart account and records it, in one transaction. The balances of the
+// record are the ones the ledger transaction left, so they can't drift from it.
+// The audit log of the record, if any, is written in the same transaction.
+func (r *rewardRecordRepositoryImpl) createRewardRecord(record *model.RewardRecord, counterpart model.LedgerAccount, audit func(record *model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	transaction := model.NewUserLedgerTransaction(model.LedgerTransactionKind(record.Type), record.UserID, counterpart,
+		record.Points, record.CreatedAt)
+
+	balances, err := postLedgerTransaction(tx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	record.LedgerTransactionID = transaction.ID
+	record.UpdatedPoints = balances[record.UserID]
+	record.OriginPoints = record.UpdatedPoints - record.Points
+	if err := insertRewardRecord(tx, record); err != nil {
+		return nil, err
+	}
+
+	if audit != nil {
+		if err := insertAuditLog(tx, audit(record)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// queryRower is a *sql.DB or a *sql.Tx.
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+func insertRewardRecord(runner queryRower, rewardRecord *model.RewardRecord) error {
+	var multipliers []byte
+	if len(rewardRecord.Multipliers) > 0 {
+		var err error
+		if multipliers, err = json.Marshal(rewardRecord.Multipliers); err != nil {
+			return err
+		}
+	}
+
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 	sqlCommand, args, err := psql.Insert(rewardRecordTableName).
-		Columns("user_id", "points", "task_id", "created_at", "original_points", "updated_points").
-		Values(rewardRecord.UserID, rewardRecord.Points, rewardRecord.TaskID, rewardRecord.CreatedAt.UTC(), rewardRecord.OriginPoints, rewardRecord.UpdatedPoints).
-		Suffix("RETURNING id").ToSql()
+		Columns("user_id", "campaign_id", "entry_type", "points", "task_id", "created_at", "original_points", "updated_points", "reason", "operator",
+			"ledger_transaction_id", "multipliers").
+		Values(rewardRecord.UserID, rewardRecord.CampaignID, rewardRecord.Type, rewardRecord.Points,
+			sql.NullInt64{Int64: int64(rewardRecord.TaskID), Valid: rewardRecord.TaskID != 0}, rewardRecord.CreatedAt.UTC(),
+			rewardRecord.OriginPoints, rewardRecord.UpdatedPoints,
+			sql.NullString{String: rewardRecord.Reason, Valid: rewardRecord.Reason != ""},
+			sql.NullString{String: rewardRecord.Operator, Valid: rewardRecord.Operator != ""},
+			sql.NullInt64{Int64: int64(rewardRecord.LedgerTransactionID), Valid: rewardRecord.LedgerTransactionID != 0},
+			sql.NullString{String: string(multipliers), Valid: multipliers != nil}).
+		Suffix("ON CONFLICT (task_id) DO NOTHING RETURNING id").ToSql()
 
-	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&rewardRecord.ID)
+	if err != nil {
+		return err
+	}
+
+	// a task is rewarded once, records of no task never conflict
+	err = runner.QueryRow(sqlCommand, args...).Scan(&rewardRecord.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%w: %d", exception.TaskAlreadyRewardedError, rewardRecord.TaskID)
+	}
+
+	return err
+}
+
+func (r *rewardRecordRepositoryImpl) SearchRewardRecords(condition *RewardRecordSearchCondition) ([]*model.RewardRecord, error) {
+	var records []*model.RewardRecord
+	err := r.StreamRewardRecords(context.Background(), condition, func(record *model.RewardRecord) error {
+		records = append(records, record)
+		return nil
+	})
 
 	if err != nil {
 		return nil, err
 	}
 
-	return rewardRecord, nil
+	return records, nil
 }
 
-func (r *rewardRecordRepositoryImpl) SearchRewardRecords(condition *RewardRecordSearchCondition) ([]*model.RewardRecord, error) {
+// StreamRewardRecords calls fn with every matching record as rows are read,
+// without loading the whole result in memory. An error of fn stops the iteration.
+func (r *rewardRecordRepositoryImpl) StreamRewardRecords(ctx context.Context, condition *RewardRecordSearchCondition, fn func(record *model.RewardRecord) error) error {
 	condition.StartTime = condition.StartTime.In(time.UTC)
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 	query := psql.
-		Select("id, user_id, points, task_id, created_at, original_points, updated_points").
+		Select("id, user_id, campaign_id, entry_type, points, COALESCE(task_id, 0), created_at, original_points, updated_points",
+			"COALESCE(reason, ''), COALESCE(operator, ''), COALESCE(ledger_transaction_id, 0), multipliers").
 		From(rewardRecordTableName)
 
 	if condition.UserID != "" {
 		query = query.Where(squirrel.Eq{"user_id": condition.UserID})
 	}
 
+	if condition.CampaignID != 0 {
+		query = query.Where(squirrel.Eq{"campaign_id": condition.CampaignID})
+	}
+
 	if condition.TaskID != 0 {
 		query = query.Where(squirrel.Eq{"task_id": condition.TaskID})
 	}
 
+	if len(condition.TaskIDs) > 0 {
+		query = query.Where(squirrel.Eq{"task_id": condition.TaskIDs})
+	}
+
+	if len(condition.Types) > 0 {
+		query = query.Where(squirrel.Eq{"entry_type": condition.Types})
+	}
+
 	if !condition.StartTime.IsZero() && condition.Duration != 0 {
 		query = query.Where(squirrel.Gt{"created_at": condition.StartTime})
 		query = query.Where(squirrel.Lt{"created_at": condition.StartTime.Add(condition.Duration)})
 	}
 
-	sqlCommand, args, err := query.OrderBy("id DESC").ToSql()
+	query, err := paginate(query, condition.Page, rewardRecordSortColumns)
+	if err != nil {
+		return err
+	}
+
+	sqlCommand, args, err := query.ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.dbInstance.QueryContext(ctx, sqlCommand, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var record model.RewardRecord
+		var multipliers []byte
+		err := rows.Scan(&record.ID, &record.UserID, &record.CampaignID, &record.Type, &record.Points, &record.TaskID, &record.CreatedAt,
+			&record.OriginPoints, &record.UpdatedPoints, &record.Reason, &record.Operator,
+			&record.LedgerTransactionID, &multipliers)
+		if err != nil {
+			return err
+		}
+
+		if multipliers != nil {
+			if err := json.Unmarshal(multipliers, &record.Multipliers); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(&record); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// GetRewardRecordsByTaskIDs loads the reward records of several tasks in one
+// query, keyed by task ID. Tasks without reward are missing from the map.
+func (r *rewardRecordRepositoryImpl) GetRewardRecordsByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error) {
+	recordsByTaskID := make(map[int]*model.RewardRecord, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return recordsByTaskID, nil
+	}
+
+	records, err := r.SearchRewardRecords(&RewardRecordSearchCondition{TaskIDs: taskIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range records {
+		if _, ok := recordsByTaskID[record.TaskID]; !ok {
+			recordsByTaskID[record.TaskID] = record
+		}
+	}
+
+	return recordsByTaskID, nil
+}
+
+func (r *rewardRecordRepositoryImpl) SumPointsByCampaign(userID string) (map[int]float64, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("campaign_id, SUM(points)").
+		From(rewardRecordTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		GroupBy("campaign_id").
+		ToSql()
 
 	if err != nil {
 		return nil, err
@@ -79,17 +270,18 @@ func (r *rewardRecordRepositoryImpl) SearchRewardRecords(condition *RewardRecord
 	if err != nil {
 		return nil, err
 	}
+	defer rows.Close()
 
-	var records []*model.RewardRecord
+	points := make(map[int]float64)
 	for rows.Next() {
-		var record model.RewardRecord
-		err := rows.Scan(&record.ID, &record.UserID, &record.Points, &record.TaskID, &record.CreatedAt, &record.OriginPoints, &record.UpdatedPoints)
-		if err != nil {
+		var campaignID int
+		var sum float64
+		if err := rows.Scan(&campaignID, &sum); err != nil {
 			return nil, err
 		}
 
-		records = append(records, &record)
+		points[campaignID] = sum
 	}
 
-	return records, nil
+	return points, rows.Err()
 }
@@ -5,14 +5,16 @@ import (
 	"testing"
 	"time"
 	"trading-ace/src/database"
+	"trading-ace/src/exception"
 	"trading-ace/src/model"
 )
 
 var setUpRewardRecordRepo = func(t *testing.T) *rewardRecordRepositoryImpl {
 	dbInstance := database.GetDBInstance()
+	createLedgerUsers(dbInstance, "test_user_id", "other_user_id")
 
 	t.Cleanup(func() {
-		dbInstance.Exec("DELETE FROM reward_records")
+		cleanUpLedger(dbInstance)
 	})
 
 	return &rewardRecordRepositoryImpl{
@@ -41,7 +43,41 @@ func TestRewardRecordRepositoryImpl_CreateRewardRecord(t *testing.T) {
 		assert.Equal(t, 0.0, record.OriginPoints)
 		assert.Equal(t, 100.0, record.UpdatedPoints)
 		assert.Equal(t, 1, record.TaskID)
+		assert.Equal(t, model.RewardRecordTypeTaskReward, record.Type)
 		assert.NotEmpty(t, record.ID)
+		assert.NotEmpty(t, record.LedgerTransactionID)
+	})
+
+	t.Run("CreateRewardRecordOfUnknownUser", func(t *testing.T) {
+		repo := setUpRewardRecordRepo(t)
+		_, err := repo.CreateRewardRecord(&model.RewardRecord{UserID: "unknown", Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+
+	t.Run("CreateRewardRecordOfRewardedTask", func(t *testing.T) {
+		repo := setUpRewardRecordRepo(t)
+		_, err := repo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+
+		_, err = repo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+		assert.ErrorIs(t, err, exception.TaskAlreadyRewardedError)
+
+		records, err := repo.SearchRewardRecords(&RewardRecordSearchCondition{UserID: "test_user_id"})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, 100.0, records[0].UpdatedPoints)
+	})
+
+	t.Run("CreateRewardRecordWithMultipliers", func(t *testing.T) {
+		repo := setUpRewardRecordRepo(t)
+		multipliers := []*model.AppliedMultiplier{{ID: 1, Name: "weekend", Factor: 2}}
+		record, err := repo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", Points: 200, TaskID: 1,
+			Multipliers: multipliers, CreatedAt: time.Now().UTC()})
+		assert.NoError(t, err)
+
+		records, err := repo.SearchRewardRecords(&RewardRecordSearchCondition{TaskID: record.TaskID})
+		assert.NoError(t, err)
+		assert.Equal(t, multipliers, records[0].Multipliers)
 	})
 }
 
@@ -160,3 +196,136 @@ func TestNewRewardRecordRepositoryImpl_SearchRewardRecords(t *testing.T) {
 		assert.Empty(t, records)
 	})
 }
+
+func TestRewardRecordRepositoryImpl_SumPointsByCampaign(t *testing.T) {
+	repo := setUpRewardRecordRepo(t)
+
+	for _, record := range []*model.RewardRecord{
+		{UserID: "test_user_id", CampaignID: 1, Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()},
+		{UserID: "test_user_id", CampaignID: 1, Points: 50, TaskID: 2, CreatedAt: time.Now().UTC()},
+		{UserID: "test_user_id", CampaignID: 2, Points: 10, TaskID: 3, CreatedAt: time.Now().UTC()},
+		{UserID: "other_user_id", CampaignID: 1, Points: 999, TaskID: 4, CreatedAt: time.Now().UTC()},
+	} {
+		_, _ = repo.CreateRewardRecord(record)
+	}
+
+	points, err := repo.SumPointsByCampaign("test_user_id")
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]float64{1: 150, 2: 10}, points)
+}
+
+func TestRewardRecordRepositoryImpl_GetRewardRecordsByTaskIDs(t *testing.T) {
+	repo := setUpRewardRecordRepo(t)
+
+	for _, record := range []*model.RewardRecord{
+		{UserID: "test_user_id", CampaignID: 1, Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()},
+		{UserID: "test_user_id", CampaignID: 1, Points: 50, TaskID: 2, CreatedAt: time.Now().UTC()},
+		{UserID: "other_user_id", CampaignID: 1, Points: 999, TaskID: 4, CreatedAt: time.Now().UTC()},
+	} {
+		_, _ = repo.CreateRewardRecord(record)
+	}
+
+	records, err := repo.GetRewardRecordsByTaskIDs([]int{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(records))
+	assert.Equal(t, 100.0, records[1].Points)
+	assert.Equal(t, 50.0, records[2].Points)
+	assert.Nil(t, records[3])
+
+	records, err = repo.GetRewardRecordsByTaskIDs(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+// BenchmarkRewardRecordRepositoryImpl_TaskRewards compares loading the reward
+// of a page of tasks one query per task with the batch query.
+func BenchmarkRewardRecordRepositoryImpl_TaskRewards(b *testing.B) {
+	dbInstance := database.GetDBInstance()
+	createLedgerUsers(dbInstance, "test_user_id")
+	b.Cleanup(func() {
+		cleanUpLedger(dbInstance)
+	})
+
+	repo := &rewardRecordRepositoryImpl{dbInstance: dbInstance}
+
+	const pageSize = 50
+	taskIDs := make([]int, 0, pageSize)
+	for taskID := 1; taskID <= pageSize; taskID++ {
+		_, err := repo.CreateRewardRecord(&model.RewardRecord{
+			UserID:     "test_user_id",
+			CampaignID: 1,
+			Points:     float64(taskID),
+			TaskID:     taskID,
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			b.Fatalf("CreateRewardRecord() error = %v", err)
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	b.Run("Per Task", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, taskID := range taskIDs {
+				if _, err := repo.SearchRewardRecords(&RewardRecordSearchCondition{TaskID: taskID}); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("Batch", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := repo.GetRewardRecordsByTaskIDs(taskIDs); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestRewardRecordRepositoryImpl_CreateAdjustment(t *testing.T) {
+	repo := setUpRewardRecordRepo(t)
+	userRepo := &userRepositoryImpl{dbInstance: repo.dbInstance}
+	_, _ = repo.CreateRewardRecord(&model.RewardRecord{UserID: "test_user_id", Points: 100, TaskID: 1, CreatedAt: time.Now().UTC()})
+
+	t.Run("Debit", func(t *testing.T) {
+		var auditLog *model.AuditLog
+		adjustment, err := repo.CreateAdjustment(&model.RewardRecord{
+			UserID:    "test_user_id",
+			Points:    -40,
+			Reason:    "double credit",
+			Operator:  "ops",
+			CreatedAt: time.Now().UTC(),
+		}, func(record *model.RewardRecord) *model.AuditLog {
+			auditLog = model.NewAuditLog("ops", model.AuditActionAdjustPoints, "user:test_user_id", map[string]any{"reward_record_id": record.ID})
+			return auditLog
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 100.0, adjustment.OriginPoints)
+		assert.Equal(t, 60.0, adjustment.UpdatedPoints)
+		assert.NotEmpty(t, auditLog.ID)
+
+		records, err := repo.SearchRewardRecords(&RewardRecordSearchCondition{
+			UserID: "test_user_id",
+			Types:  []model.RewardRecordType{model.RewardRecordTypeAdjustment},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(records))
+		assert.Equal(t, 0, records[0].TaskID)
+		assert.Equal(t, "double credit", records[0].Reason)
+		assert.Equal(t, "ops", records[0].Operator)
+	})
+
+	t.Run("Debit More Than Balance", func(t *testing.T) {
+		_, err := repo.CreateAdjustment(&model.RewardRecord{UserID: "test_user_id", Points: -1000, Reason: "wash trading", CreatedAt: time.Now().UTC()}, nil)
+		assert.ErrorIs(t, err, exception.InsufficientPointsError)
+
+		user, _ := userRepo.GetUser("test_user_id")
+		assert.Equal(t, 60.0, user.Points)
+	})
+
+	t.Run("Unknown User", func(t *testing.T) {
+		_, err := repo.CreateAdjustment(&model.RewardRecord{UserID: "unknown", Points: 10, Reason: "goodwill", CreatedAt: time.Now().UTC()}, nil)
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+}
@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const (
+	settlementsTableName       = "settlements"
+	settlementPayoutsTableName = "settlement_payouts"
+)
+
+type SearchSettlementsCondition struct {
+	CampaignID int
+	// PendingDistribution keeps the completed settlements whose claims are
+	// not distributed yet.
+	PendingDistribution bool
+}
+
+type SettlementRepository interface {
+	// CreateSettlement writes the audit log audit builds from the saved
+	// settlement, if any, in the same transaction.
+	CreateSettlement(settlement *model.Settlement, payouts []*model.SharedPoolPayout, audit func(settlement *model.Settlement) *model.AuditLog) (*model.Settlement, error)
+	GetSettlementPayouts(settlementID int) ([]*model.SharedPoolPayout, error)
+	CompleteSettlement(settlementID int, completedAt time.Time) error
+	DistributeSettlement(settlementID int, distributedAt time.Time) error
+	SearchSettlements(condition *SearchSettlementsCondition) ([]*model.Settlement, error)
+}
+
+type settlementRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewSettlementRepository() SettlementRepository {
+	return &settlementRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// CreateSettlement records the settlement of a period together with the
+// payouts it computed, in one transaction, before anything is paid. The
+// settlement is pending until CompleteSettlement.
+func (r *settlementRepositoryImpl) CreateSettlement(settlement *model.Settlement, payouts []*model.SharedPoolPayout, audit func(settlement *model.Settlement) *model.AuditLog) (*model.Settlement, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(settlementsTableName).
+		Columns("campaign_id", "period_index", "start_time", "end_time", "settled_at").
+		Values(settlement.CampaignID, settlement.PeriodIndex, settlement.StartTime.UTC(), settlement.EndTime.UTC(), settlement.SettledAt.UTC()).
+		Suffix("ON CONFLICT (campaign_id, period_index) DO NOTHING RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(sqlCommand, args...).Scan(&settlement.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.SettlementAlreadyExistsError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(payouts) > 0 {
+		query := psql.Insert(settlementPayoutsTableName).
+			Columns("settlement_id", "task_id", "user_id", "swap_amount", "weight", "multipliers", "share", "points")
+
+		for _, payout := range payouts {
+			var multipliers []byte
+			if len(payout.Multipliers) > 0 {
+				if multipliers, err = json.Marshal(payout.Multipliers); err != nil {
+					return nil, err
+				}
+			}
+
+			query = query.Values(settlement.ID, payout.TaskID, payout.UserID, payout.SwapAmount, payout.Weight,
+				sql.NullString{String: string(multipliers), Valid: multipliers != nil}, payout.Share, payout.Points)
+		}
+
+		sqlCommand, args, err = query.ToSql()
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.Exec(sqlCommand, args...); err != nil {
+			return nil, err
+		}
+	}
+
+	if audit != nil {
+		if err := insertAuditLog(tx, audit(settlement)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+// GetSettlementPayouts returns the payouts stored with the settlement, by task.
+func (r *settlementRepositoryImpl) GetSettlementPayouts(settlementID int) ([]*model.SharedPoolPayout, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("task_id, user_id, swap_amount, weight, multipliers, share, points").
+		From(settlementPayoutsTableName).
+		Where(squirrel.Eq{"settlement_id": settlementID}).
+		OrderBy("task_id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	payouts := make([]*model.SharedPoolPayout, 0)
+	for rows.Next() {
+		var payout model.SharedPoolPayout
+		var multipliers []byte
+		err := rows.Scan(&payout.TaskID, &payout.UserID, &payout.SwapAmount, &payout.Weight, &multipliers, &payout.Share, &payout.Points)
+		if err != nil {
+			return nil, err
+		}
+
+		if multipliers != nil {
+			if err := json.Unmarshal(multipliers, &payout.Multipliers); err != nil {
+				return nil, err
+			}
+		}
+
+		payouts = append(payouts, &payout)
+	}
+
+	return payouts, rows.Err()
+}
+
+// CompleteSettlement marks the settlement paid, it has no effect on a
+// completed settlement.
+func (r *settlementRepositoryImpl) CompleteSettlement(settlementID int, completedAt time.Time) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(settlementsTableName).
+		Set("completed_at", completedAt.UTC()).
+		Where(squirrel.Eq{"id": settlementID, "completed_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
+// DistributeSettlement marks the claims of the settlement distributed, it has
+// no effect on a distributed settlement.
+func (r *settlementRepositoryImpl) DistributeSettlement(settlementID int, distributedAt time.Time) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(settlementsTableName).
+		Set("distributed_at", distributedAt.UTC()).
+		Where(squirrel.Eq{"id": settlementID, "distributed_at": nil}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
+func (r *settlementRepositoryImpl) SearchSettlements(condition *SearchSettlementsCondition) ([]*model.Settlement, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	query := psql.Select("id, campaign_id, period_index, start_time, end_time, settled_at, completed_at, distributed_at").From(settlementsTableName)
+
+	if condition.CampaignID != 0 {
+		query = query.Where(squirrel.Eq{"campaign_id": condition.CampaignID})
+	}
+
+	if condition.PendingDistribution {
+		query = query.Where(squirrel.NotEq{"completed_at": nil}).Where(squirrel.Eq{"distributed_at": nil})
+	}
+
+	sqlCommand, args, err := query.OrderBy("campaign_id", "period_index").ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var settlements []*model.Settlement
+	for rows.Next() {
+		var settlement model.Settlement
+		var completedAt, distributedAt sql.NullTime
+		err := rows.Scan(&settlement.ID, &settlement.CampaignID, &settlement.PeriodIndex, &settlement.StartTime, &settlement.EndTime, &settlement.SettledAt,
+			&completedAt, &distributedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if completedAt.Valid {
+			completed := completedAt.Time.In(time.UTC)
+			settlement.CompletedAt = &completed
+		}
+
+		if distributedAt.Valid {
+			distributed := distributedAt.Time.In(time.UTC)
+			settlement.DistributedAt = &distributed
+		}
+
+		settlement.StartTime = settlement.StartTime.In(time.UTC)
+		settlement.EndTime = settlement.EndTime.In(time.UTC)
+		settlement.SettledAt = settlement.SettledAt.In(time.UTC)
+
+		settlements = append(settlements, &settlement)
+	}
+
+	return settlements, rows.Err()
+}
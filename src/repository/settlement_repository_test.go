@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+func TestSettlementRepositoryImpl(t *testing.T) {
+	setUpSettlementRepo := func(t *testing.T) *settlementRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM settlement_payouts")
+			dbInstance.Exec("DELETE FROM settlements")
+		})
+
+		return &settlementRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	newSettlement := func(campaignID int, periodIndex int) *model.Settlement {
+		return &model.Settlement{
+			CampaignID:  campaignID,
+			PeriodIndex: periodIndex,
+			StartTime:   startTime.Add(time.Hour * 24 * 7 * time.Duration(periodIndex)),
+			EndTime:     startTime.Add(time.Hour * 24 * 7 * time.Duration(periodIndex+1)),
+			SettledAt:   time.Now().UTC(),
+		}
+	}
+
+	t.Run("CreateSettlement", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		settlement, err := settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, settlement.ID)
+	})
+
+	t.Run("CreateSettlement, Duplicated Period", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		_, _ = settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+		settlement, err := settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+
+		assert.ErrorIs(t, err, exception.SettlementAlreadyExistsError)
+		assert.Nil(t, settlement)
+	})
+
+	t.Run("SearchSettlements By Campaign", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		_, _ = settlementRepo.CreateSettlement(newSettlement(1, 1), nil, nil)
+		_, _ = settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+		_, _ = settlementRepo.CreateSettlement(newSettlement(2, 0), nil, nil)
+
+		settlements, err := settlementRepo.SearchSettlements(&SearchSettlementsCondition{CampaignID: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(settlements))
+		assert.Equal(t, 0, settlements[0].PeriodIndex)
+		assert.Equal(t, 1, settlements[1].PeriodIndex)
+		assert.Equal(t, startTime, settlements[0].StartTime)
+		assert.Nil(t, settlements[0].CompletedAt)
+	})
+
+	t.Run("GetSettlementPayouts", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		multipliers := []*model.AppliedMultiplier{{ID: 1, Name: "weekend", Factor: 2}}
+		settlement, err := settlementRepo.CreateSettlement(newSettlement(1, 0), []*model.SharedPoolPayout{
+			{TaskID: 2, UserID: "user2", SwapAmount: 100, Weight: 100, Share: 0.25, Points: 2500},
+			{TaskID: 1, UserID: "user1", SwapAmount: 150, Weight: 300, Multipliers: multipliers, Share: 0.75, Points: 7500},
+		}, nil)
+		assert.NoError(t, err)
+
+		payouts, err := settlementRepo.GetSettlementPayouts(settlement.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(payouts))
+		assert.Equal(t, 1, payouts[0].TaskID)
+		assert.Equal(t, multipliers, payouts[0].Multipliers)
+		assert.Equal(t, 7500.0, payouts[0].Points)
+		assert.Nil(t, payouts[1].Multipliers)
+	})
+
+	t.Run("CompleteSettlement", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		settlement, _ := settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+		completedAt := time.Now().UTC().Truncate(time.Second)
+		assert.NoError(t, settlementRepo.CompleteSettlement(settlement.ID, completedAt))
+		assert.NoError(t, settlementRepo.CompleteSettlement(settlement.ID, completedAt.Add(time.Hour)))
+
+		settlements, err := settlementRepo.SearchSettlements(&SearchSettlementsCondition{CampaignID: 1})
+		assert.NoError(t, err)
+		assert.True(t, settlements[0].IsCompleted())
+		assert.Equal(t, completedAt, *settlements[0].CompletedAt)
+	})
+
+	t.Run("DistributeSettlement", func(t *testing.T) {
+		settlementRepo := setUpSettlementRepo(t)
+
+		completed, _ := settlementRepo.CreateSettlement(newSettlement(1, 0), nil, nil)
+		_, _ = settlementRepo.CreateSettlement(newSettlement(1, 1), nil, nil)
+		assert.NoError(t, settlementRepo.CompleteSettlement(completed.ID, time.Now().UTC()))
+
+		pending, err := settlementRepo.SearchSettlements(&SearchSettlementsCondition{PendingDistribution: true})
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(pending))
+		assert.Equal(t, completed.ID, pending[0].ID)
+		assert.False(t, pending[0].IsDistributed())
+
+		assert.NoError(t, settlementRepo.DistributeSettlement(completed.ID, time.Now().UTC()))
+
+		pending, err = settlementRepo.SearchSettlements(&SearchSettlementsCondition{PendingDistribution: true})
+		assert.NoError(t, err)
+		assert.Empty(t, pending)
+	})
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+const userStreaksTableName = "user_streaks"
+
+type StreakRepository interface {
+	// AdvanceStreak records a swap of the user in the period starting at
+	// start, continuing the streak when the last period was previous and
+	// starting a new one otherwise. It returns nil, changing nothing, when the
+	// user already swapped in the period.
+	AdvanceStreak(userID string, period model.PeriodType, start time.Time, previous time.Time) (*model.Streak, error)
+	GetStreaks(userID string) ([]*model.Streak, error)
+}
+
+type streakRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewStreakRepository() StreakRepository {
+	return &streakRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// advanceStreakQuery upserts the streak in a single statement, so concurrent
+// swaps of the user advance it once per period.
+const advanceStreakQuery = `
+INSERT INTO user_streaks (user_id, period, current, best, last_period)
+VALUES ($1, $2, 1, 1, $3)
+ON CONFLICT (user_id, period) DO UPDATE SET
+    current     = CASE WHEN user_streaks.last_period = $4 THEN user_streaks.current + 1 ELSE 1 END,
+    best        = GREATEST(user_streaks.best, CASE WHEN user_streaks.last_period = $4 THEN user_streaks.current + 1 ELSE 1 END),
+    last_period = EXCLUDED.last_period
+WHERE user_streaks.last_period < EXCLUDED.last_period
+RETURNING user_id, period, current, best, last_period`
+
+func (r *streakRepositoryImpl) AdvanceStreak(userID string, period model.PeriodType, start time.Time, previous time.Time) (*model.Streak, error) {
+	row := r.dbInstance.QueryRow(advanceStreakQuery, userID, period, start.UTC().Format(time.DateOnly), previous.UTC().Format(time.DateOnly))
+
+	streak, err := scanStreak(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return streak, err
+}
+
+func (r *streakRepositoryImpl) GetStreaks(userID string) ([]*model.Streak, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("user_id, period, current, best, last_period").
+		From(userStreaksTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("period").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var streaks []*model.Streak
+	for rows.Next() {
+		streak, err := scanStreak(rows)
+		if err != nil {
+			return nil, err
+		}
+		streaks = append(streaks, streak)
+	}
+
+	return streaks, rows.Err()
+}
+
+func scanStreak(row rowScanner) (*model.Streak, error) {
+	var streak model.Streak
+	if err := row.Scan(&streak.UserID, &streak.Period, &streak.Current, &streak.Best, &streak.LastPeriod); err != nil {
+		return nil, err
+	}
+
+	lastPeriod := streak.LastPeriod
+	streak.LastPeriod = time.Date(lastPeriod.Year(), lastPeriod.Month(), lastPeriod.Day(), 0, 0, 0, 0, time.UTC)
+	return &streak, nil
+}
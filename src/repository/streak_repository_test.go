@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+func TestStreakRepositoryImpl(t *testing.T) {
+	setUpStreakRepo := func(t *testing.T) *streakRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM user_streaks")
+		})
+
+		return &streakRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	day := time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("AdvanceStreak", func(t *testing.T) {
+		streakRepo := setUpStreakRepo(t)
+
+		streak, err := streakRepo.AdvanceStreak("test_user", model.PeriodTypeDaily, day, day.AddDate(0, 0, -1))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, streak.Current)
+		assert.Equal(t, day, streak.LastPeriod)
+
+		streak, err = streakRepo.AdvanceStreak("test_user", model.PeriodTypeDaily, day, day.AddDate(0, 0, -1))
+		assert.NoError(t, err)
+		assert.Nil(t, streak)
+
+		streak, err = streakRepo.AdvanceStreak("test_user", model.PeriodTypeDaily, day.AddDate(0, 0, 1), day)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, streak.Current)
+		assert.Equal(t, 2, streak.Best)
+
+		// a missed day starts a new streak and keeps the best one
+		streak, err = streakRepo.AdvanceStreak("test_user", model.PeriodTypeDaily, day.AddDate(0, 0, 3), day.AddDate(0, 0, 2))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, streak.Current)
+		assert.Equal(t, 2, streak.Best)
+	})
+
+	t.Run("GetStreaks", func(t *testing.T) {
+		streakRepo := setUpStreakRepo(t)
+
+		_, _ = streakRepo.AdvanceStreak("test_user", model.PeriodTypeWeekly, day.AddDate(0, 0, -1), day.AddDate(0, 0, -8))
+		_, _ = streakRepo.AdvanceStreak("test_user", model.PeriodTypeDaily, day, day.AddDate(0, 0, -1))
+		_, _ = streakRepo.AdvanceStreak("other_user", model.PeriodTypeDaily, day, day.AddDate(0, 0, -1))
+
+		streaks, err := streakRepo.GetStreaks("test_user")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(streaks))
+		assert.Equal(t, model.PeriodTypeDaily, streaks[0].Period)
+		assert.Equal(t, model.PeriodTypeWeekly, streaks[1].Period)
+	})
+}
@@ -1,7 +1,9 @@
 package repository
 
 import (
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/Masterminds/squirrel"
 	"time"
@@ -12,18 +14,32 @@ import (
 const tasksTableName = "tasks"
 
 type SearchTasksCondition struct {
-	UserID    string
-	Type      model.TaskType
-	Status    model.TaskStatus
+	UserID     string
+	CampaignID int
+	Type       model.TaskType
+	Status     model.TaskStatus
+	// UserIDs, Types and Statuses match any of their values.
+	UserIDs   []string
+	Types     []model.TaskType
+	Statuses  []model.TaskStatus
 	StartTime time.Time
 	EndTime   time.Time
+	// Page sorts by created_at or swap_amount.
+	Page *Page
 }
 
 type TaskRepository interface {
 	CreateTask(task *model.Task) (*model.Task, error)
+	// CreateSwapTask creates the task of a swap for its campaign, nil when the
+	// swap already created it, so a retried job doesn't create it twice.
+	CreateSwapTask(swapID string, task *model.Task) (*model.Task, error)
 	GetTaskByID(taskID int) (*model.Task, error)
 	SearchTasks(condition *SearchTasksCondition) ([]*model.Task, error)
+	StreamTasks(ctx context.Context, condition *SearchTasksCondition, fn func(task *model.Task) error) error
 	UpdateTask(task *model.Task) (*model.Task, error)
+	// DeletePendingTask deletes the task unless it is done.
+	DeletePendingTask(taskID int) error
+	SearchUserCampaignActivities(userID string) ([]*model.UserCampaignActivity, error)
 }
 
 type taskRepositoryImpl struct {
@@ -37,14 +53,34 @@ func NewTaskRepository() TaskRepository {
 }
 
 func (r *taskRepositoryImpl) SearchTasks(condition *SearchTasksCondition) ([]*model.Task, error) {
+	var tasks []*model.Task
+	err := r.StreamTasks(context.Background(), condition, func(task *model.Task) error {
+		tasks = append(tasks, task)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// StreamTasks calls fn with every matching task as rows are read, without
+// loading the whole result in memory. An error of fn stops the iteration.
+func (r *taskRepositoryImpl) StreamTasks(ctx context.Context, condition *SearchTasksCondition, fn func(task *model.Task) error) error {
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 
-	query := psql.Select("id, user_id, status, type, swap_amount, created_at, completed_at").From(tasksTableName)
+	query := psql.Select("id, campaign_id, user_id, status, type, swap_amount, created_at, completed_at").From(tasksTableName)
 
 	if condition.UserID != "" {
 		query = query.Where(squirrel.Eq{"user_id": condition.UserID})
 	}
 
+	if condition.CampaignID != 0 {
+		query = query.Where(squirrel.Eq{"campaign_id": condition.CampaignID})
+	}
+
 	if condition.Type != "" {
 		query = query.Where(squirrel.Eq{"type": condition.Type})
 	}
@@ -53,37 +89,54 @@ func (r *taskRepositoryImpl) SearchTasks(condition *SearchTasksCondition) ([]*mo
 		query = query.Where(squirrel.Eq{"status": condition.Status})
 	}
 
+	if len(condition.UserIDs) > 0 {
+		query = query.Where(squirrel.Eq{"user_id": condition.UserIDs})
+	}
+
+	if len(condition.Types) > 0 {
+		query = query.Where(squirrel.Eq{"type": condition.Types})
+	}
+
+	if len(condition.Statuses) > 0 {
+		query = query.Where(squirrel.Eq{"status": condition.Statuses})
+	}
+
 	if !condition.StartTime.IsZero() || !condition.EndTime.IsZero() {
 
 		if condition.StartTime.IsZero() || condition.EndTime.IsZero() {
-			return nil, fmt.Errorf("both start time and end time should be provided")
+			return fmt.Errorf("both start time and end time should be provided")
 		}
 
 		if condition.StartTime.After(condition.EndTime) {
-			return nil, fmt.Errorf("start time should be before end time")
+			return fmt.Errorf("start time should be before end time")
 		}
 
 		query = query.Where(squirrel.Gt{"created_at": condition.StartTime.UTC()})
 		query = query.Where(squirrel.Lt{"created_at": condition.EndTime.UTC()})
 	}
 
-	sqlCommand, args, err := query.OrderBy("id DESC").ToSql()
+	query, err := paginate(query, condition.Page, taskSortColumns)
+	if err != nil {
+		return err
+	}
+
+	sqlCommand, args, err := query.ToSql()
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	rows, err := r.dbInstance.QueryContext(ctx, sqlCommand, args...)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer rows.Close()
 
-	var tasks []*model.Task
 	for rows.Next() {
 		var task model.Task
-		err := rows.Scan(&task.ID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
+		err := rows.Scan(&task.ID, &task.CampaignID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		task.CreatedAt = task.CreatedAt.In(time.UTC)
@@ -91,16 +144,18 @@ func (r *taskRepositoryImpl) SearchTasks(condition *SearchTasksCondition) ([]*mo
 			task.CompletedAt.Time = task.CompletedAt.Time.In(time.UTC)
 		}
 
-		tasks = append(tasks, &task)
+		if err := fn(&task); err != nil {
+			return err
+		}
 	}
 
-	return tasks, nil
+	return rows.Err()
 }
 
 func (r *taskRepositoryImpl) GetTaskByID(taskID int) (*model.Task, error) {
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 	sqlCommand, args, err := psql.
-		Select("id, user_id, status, type, swap_amount, created_at, completed_at").
+		Select("id, campaign_id, user_id, status, type, swap_amount, created_at, completed_at").
 		From(tasksTableName).
 		Where(squirrel.Eq{"id": taskID}).
 		ToSql()
@@ -112,7 +167,7 @@ func (r *taskRepositoryImpl) GetTaskByID(taskID int) (*model.Task, error) {
 	row := r.dbInstance.QueryRow(sqlCommand, args...)
 
 	task := &model.Task{}
-	err = row.Scan(&task.ID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
+	err = row.Scan(&task.ID, &task.CampaignID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
 
 	if err != nil {
 		return nil, err
@@ -130,12 +185,12 @@ func (r *taskRepositoryImpl) CreateTask(task *model.Task) (*model.Task, error) {
 
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 	sqlCommand, args, err := psql.Insert(tasksTableName).
-		Columns("user_id", "status", "type", "swap_amount", "created_at", "completed_at").
-		Values(task.UserID, task.Status, task.Type, task.SwapAmount, task.CreatedAt, task.CompletedAt).
-		Suffix("RETURNING id, user_id, status, type, swap_amount, created_at, completed_at").
+		Columns("campaign_id", "user_id", "status", "type", "swap_amount", "created_at", "completed_at").
+		Values(task.CampaignID, task.UserID, task.Status, task.Type, task.SwapAmount, task.CreatedAt, task.CompletedAt).
+		Suffix("RETURNING id, campaign_id, user_id, status, type, swap_amount, created_at, completed_at").
 		ToSql()
 
-	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&task.ID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&task.ID, &task.CampaignID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
 
 	if err != nil {
 		return nil, err
@@ -144,6 +199,48 @@ func (r *taskRepositoryImpl) CreateTask(task *model.Task) (*model.Task, error) {
 	return task, nil
 }
 
+func (r *taskRepositoryImpl) CreateSwapTask(swapID string, task *model.Task) (*model.Task, error) {
+	task.CreatedAt = task.CreatedAt.UTC()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(tasksTableName).
+		Columns("swap_id", "campaign_id", "user_id", "status", "type", "swap_amount", "created_at", "completed_at").
+		Values(swapID, task.CampaignID, task.UserID, task.Status, task.Type, task.SwapAmount, task.CreatedAt, task.CompletedAt).
+		Suffix("ON CONFLICT (swap_id, campaign_id) WHERE swap_id IS NOT NULL DO NOTHING " +
+			"RETURNING id, campaign_id, user_id, status, type, swap_amount, created_at, completed_at").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&task.ID, &task.CampaignID, &task.UserID, &task.Status, &task.Type, &task.SwapAmount, &task.CreatedAt, &task.CompletedAt)
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (r *taskRepositoryImpl) DeletePendingTask(taskID int) error {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Delete(tasksTableName).
+		Where(squirrel.Eq{"id": taskID, "status": model.TaskStatusPending}).
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dbInstance.Exec(sqlCommand, args...)
+	return err
+}
+
 func (r *taskRepositoryImpl) UpdateTask(task *model.Task) (*model.Task, error) {
 	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
 	query := psql.Update(tasksTableName)
@@ -174,3 +271,48 @@ func (r *taskRepositoryImpl) UpdateTask(task *model.Task) (*model.Task, error) {
 
 	return task, nil
 }
+
+// SearchUserCampaignActivities sums the shared pool volume and swaps of the user
+// per campaign, along with the time the user was onboarded.
+func (r *taskRepositoryImpl) SearchUserCampaignActivities(userID string) ([]*model.UserCampaignActivity, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("campaign_id").
+		Column(squirrel.Expr("COALESCE(SUM(swap_amount) FILTER (WHERE type = ?), 0)", model.TaskTypeSharedPool)).
+		Column(squirrel.Expr("COUNT(*) FILTER (WHERE type = ?)", model.TaskTypeSharedPool)).
+		Column(squirrel.Expr("MIN(created_at) FILTER (WHERE type = ?)", model.TaskTypeOnboarding)).
+		From(tasksTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		// tasks of no campaign, e.g. streak milestones, are no campaign activity
+		Where(squirrel.NotEq{"campaign_id": 0}).
+		GroupBy("campaign_id").
+		OrderBy("campaign_id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var activities []*model.UserCampaignActivity
+	for rows.Next() {
+		var activity model.UserCampaignActivity
+		err := rows.Scan(&activity.CampaignID, &activity.Volume, &activity.SwapCount, &activity.OnboardedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		if activity.OnboardedAt.Valid {
+			activity.OnboardedAt.Time = activity.OnboardedAt.Time.In(time.UTC)
+		}
+
+		activities = append(activities, &activity)
+	}
+
+	return activities, rows.Err()
+}
@@ -25,7 +25,7 @@ func TestTaskRepositoryImpl(t *testing.T) {
 
 	t.Run("CreateTask", func(t *testing.T) {
 		taskRepo := setUpTaskRepo(t)
-		task := model.NewTask("test_user_id", model.TaskTypeOnboarding, 50)
+		task := model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 50)
 		createdTask, err := taskRepo.CreateTask(task)
 
 		if err != nil {
@@ -33,6 +33,7 @@ func TestTaskRepositoryImpl(t *testing.T) {
 		}
 
 		assert.NotEmpty(t, task.ID)
+		assert.Equal(t, 1, createdTask.CampaignID)
 		assert.Equal(t, "test_user_id", createdTask.UserID)
 		assert.Equal(t, model.TaskTypeOnboarding, createdTask.Type)
 		assert.Equal(t, model.TaskStatusPending, createdTask.Status)
@@ -41,9 +42,43 @@ func TestTaskRepositoryImpl(t *testing.T) {
 		assert.Equal(t, createdTask.CompletedAt, sql.NullTime{})
 	})
 
+	t.Run("CreateSwapTask Once Per Campaign", func(t *testing.T) {
+		taskRepo := setUpTaskRepo(t)
+
+		task, err := taskRepo.CreateSwapTask("test_swap", model.NewTask("test_user_id", 1, model.TaskTypeSharedPool, 50))
+		assert.NoError(t, err)
+		assert.NotEmpty(t, task.ID)
+
+		// a retried job doesn't create the task again
+		task, err = taskRepo.CreateSwapTask("test_swap", model.NewTask("test_user_id", 1, model.TaskTypeSharedPool, 50))
+		assert.NoError(t, err)
+		assert.Nil(t, task)
+
+		task, err = taskRepo.CreateSwapTask("test_swap", model.NewTask("test_user_id", 2, model.TaskTypeSharedPool, 50))
+		assert.NoError(t, err)
+		assert.NotNil(t, task)
+	})
+
+	t.Run("DeletePendingTask", func(t *testing.T) {
+		taskRepo := setUpTaskRepo(t)
+		pending, _ := taskRepo.CreateTask(model.NewTask("test_user_id", 1, model.TaskTypeVolumeMilestone, 0))
+		done := model.NewTask("test_user_id", 1, model.TaskTypeVolumeMilestone, 0)
+		done.Status = model.TaskStatusDone
+		done, _ = taskRepo.CreateTask(done)
+
+		assert.NoError(t, taskRepo.DeletePendingTask(pending.ID))
+		assert.NoError(t, taskRepo.DeletePendingTask(done.ID))
+
+		_, err := taskRepo.GetTaskByID(pending.ID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		// a done task is kept
+		_, err = taskRepo.GetTaskByID(done.ID)
+		assert.NoError(t, err)
+	})
+
 	t.Run("UpdateTask", func(t *testing.T) {
 		taskRepo := setUpTaskRepo(t)
-		task := model.NewTask("test_user_id", model.TaskTypeOnboarding, 50)
+		task := model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 50)
 		task, _ = taskRepo.CreateTask(task)
 
 		task.Status = model.TaskStatusDone
@@ -68,7 +103,7 @@ func TestTaskRepositoryImpl(t *testing.T) {
 
 	t.Run("UpdateTask, Invalid ID", func(t *testing.T) {
 		taskRepo := setUpTaskRepo(t)
-		task := model.NewTask("test_user_id", model.TaskTypeOnboarding, 50)
+		task := model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 50)
 		task.ID = 1
 
 		_, err := taskRepo.UpdateTask(task)
@@ -210,6 +245,21 @@ func TestTaskRepositoryImpl(t *testing.T) {
 			}
 		})
 
+		t.Run("Search By Any Of Types And Statuses", func(t *testing.T) {
+			tasks, err := taskRepo.SearchTasks(&SearchTasksCondition{
+				UserID:   "user_1",
+				Types:    []model.TaskType{model.TaskTypeOnboarding, model.TaskTypeSharedPool},
+				Statuses: []model.TaskStatus{model.TaskStatusPending},
+			})
+			assert.NoError(t, err)
+
+			expectedTaskIndexes := []int{6, 0}
+			assert.Equal(t, len(expectedTaskIndexes), len(tasks))
+			for i, task := range tasks {
+				assert.Equal(t, tasksToBeInsert[expectedTaskIndexes[i]].ID, task.ID)
+			}
+		})
+
 		t.Run("Search By Time Range", func(t *testing.T) {
 			tasks, err := taskRepo.SearchTasks(&SearchTasksCondition{
 				StartTime: time.Now().Add(-time.Hour*3 - time.Minute).UTC(),
@@ -224,6 +274,26 @@ func TestTaskRepositoryImpl(t *testing.T) {
 			}
 		})
 
+		t.Run("Paginate By Swap Amount", func(t *testing.T) {
+			page := &Page{Limit: 4, SortBy: "swap_amount"}
+
+			firstPage, err := taskRepo.SearchTasks(&SearchTasksCondition{Page: page})
+			assert.NoError(t, err)
+			assert.Equal(t, []float64{450, 400, 350, 300}, []float64{firstPage[0].SwapAmount, firstPage[1].SwapAmount, firstPage[2].SwapAmount, firstPage[3].SwapAmount})
+
+			page.Cursor = NextTasksCursor(firstPage, page)
+			secondPage, err := taskRepo.SearchTasks(&SearchTasksCondition{Page: page})
+			assert.NoError(t, err)
+			assert.Equal(t, 4, len(secondPage))
+			assert.Equal(t, 250.0, secondPage[0].SwapAmount)
+
+			page.Cursor = NextTasksCursor(secondPage, page)
+			lastPage, err := taskRepo.SearchTasks(&SearchTasksCondition{Page: page})
+			assert.NoError(t, err)
+			assert.Equal(t, 2, len(lastPage))
+			assert.Empty(t, NextTasksCursor(lastPage, page))
+		})
+
 		t.Run("InValid Search Range", func(t *testing.T) {
 			testConditions := map[string][]time.Time{
 				"Start Time > End Time": {time.Now().Add(time.Hour), time.Now()},
@@ -244,7 +314,7 @@ func TestTaskRepositoryImpl(t *testing.T) {
 
 	t.Run("GetTaskByID", func(t *testing.T) {
 		taskRepo := setUpTaskRepo(t)
-		task := model.NewTask("test_user_id", model.TaskTypeOnboarding, 50)
+		task := model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 50)
 
 		task, _ = taskRepo.CreateTask(task)
 
@@ -255,10 +325,30 @@ func TestTaskRepositoryImpl(t *testing.T) {
 
 	t.Run("GetTaskByID, Invalid ID", func(t *testing.T) {
 		taskRepo := setUpTaskRepo(t)
-		task := model.NewTask("test_user_id", model.TaskTypeOnboarding, 50)
+		task := model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 50)
 		task.ID = 1
 
 		_, err := taskRepo.GetTaskByID(task.ID)
 		assert.NotNil(t, err)
 	})
+
+	t.Run("SearchUserCampaignActivities", func(t *testing.T) {
+		taskRepo := setUpTaskRepo(t)
+
+		onboardingTask, _ := taskRepo.CreateTask(model.NewTask("test_user_id", 1, model.TaskTypeOnboarding, 1500))
+		_, _ = taskRepo.CreateTask(model.NewTask("test_user_id", 1, model.TaskTypeSharedPool, 1500))
+		_, _ = taskRepo.CreateTask(model.NewTask("test_user_id", 1, model.TaskTypeSharedPool, 200))
+		_, _ = taskRepo.CreateTask(model.NewTask("test_user_id", 2, model.TaskTypeSharedPool, 200))
+		_, _ = taskRepo.CreateTask(model.NewTask("other_user_id", 1, model.TaskTypeSharedPool, 999))
+		_, _ = taskRepo.CreateTask(model.NewTask("test_user_id", 0, model.TaskTypeStreak, 0))
+
+		activities, err := taskRepo.SearchUserCampaignActivities("test_user_id")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(activities))
+		assert.Equal(t, 1700.0, activities[0].Volume)
+		assert.Equal(t, 2, activities[0].SwapCount)
+		assert.True(t, activities[0].OnboardedAt.Time.Equal(onboardingTask.CreatedAt))
+		assert.Equal(t, 2, activities[1].CampaignID)
+		assert.False(t, activities[1].OnboardedAt.Valid)
+	})
 }
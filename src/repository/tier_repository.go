@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+const tierHistoryTableName = "tier_history"
+
+type TierRepository interface {
+	// ChangeTier moves the user to the tier of the change and records it in
+	// the tier history. It tells false, changing nothing, when the user is not
+	// in the from tier anymore.
+	ChangeTier(change *model.TierChange) (bool, error)
+	GetUserTiers(userIDs []string) (map[string]string, error)
+	SearchUserIDsByTiers(tiers []string) ([]string, error)
+	GetTierHistory(userID string) ([]*model.TierChange, error)
+}
+
+type tierRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewTierRepository() TierRepository {
+	return &tierRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *tierRepositoryImpl) ChangeTier(change *model.TierChange) (bool, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Update(usersTableName).
+		Set("tier", change.ToTier).
+		Where(squirrel.Eq{"id": change.UserID, "tier": change.FromTier}).
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.Exec(sqlCommand, args...)
+	if err != nil {
+		return false, err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil || affected == 0 {
+		return false, err
+	}
+
+	sqlCommand, args, err = psql.Insert(tierHistoryTableName).
+		Columns("user_id", "from_tier", "to_tier", "rolling_volume", "created_at").
+		Values(change.UserID, change.FromTier, change.ToTier, change.RollingVolume, change.CreatedAt.UTC()).
+		Suffix("RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return false, err
+	}
+
+	if err := tx.QueryRow(sqlCommand, args...).Scan(&change.ID); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+// GetUserTiers returns the tier of each known user, empty for a user without
+// tier.
+func (r *tierRepositoryImpl) GetUserTiers(userIDs []string) (map[string]string, error) {
+	tiers := make(map[string]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return tiers, nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("id, tier").
+		From(usersTableName).
+		Where(squirrel.Eq{"id": userIDs}).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userID, tier string
+		if err := rows.Scan(&userID, &tier); err != nil {
+			return nil, err
+		}
+		tiers[userID] = tier
+	}
+
+	return tiers, rows.Err()
+}
+
+func (r *tierRepositoryImpl) SearchUserIDsByTiers(tiers []string) ([]string, error) {
+	if len(tiers) == 0 {
+		return nil, nil
+	}
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("id").
+		From(usersTableName).
+		Where(squirrel.Eq{"tier": tiers}).
+		OrderBy("id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var userIDs []string
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	return userIDs, rows.Err()
+}
+
+// GetTierHistory returns the tier changes of the user, latest first.
+func (r *tierRepositoryImpl) GetTierHistory(userID string) ([]*model.TierChange, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Select("id, user_id, from_tier, to_tier, rolling_volume, created_at").
+		From(tierHistoryTableName).
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("created_at DESC", "id DESC").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var history []*model.TierChange
+	for rows.Next() {
+		var change model.TierChange
+		err := rows.Scan(&change.ID, &change.UserID, &change.FromTier, &change.ToTier, &change.RollingVolume, &change.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		change.CreatedAt = change.CreatedAt.In(time.UTC)
+		history = append(history, &change)
+	}
+
+	return history, rows.Err()
+}
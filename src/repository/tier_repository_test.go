@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+func TestTierRepositoryImpl(t *testing.T) {
+	setUpTierRepo := func(t *testing.T) *tierRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM tier_history")
+			dbInstance.Exec("DELETE FROM users")
+		})
+
+		userRepo := &userRepositoryImpl{dbInstance: dbInstance}
+		_, _ = userRepo.CreateUser("test_user_1")
+		_, _ = userRepo.CreateUser("test_user_2")
+
+		return &tierRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	t.Run("ChangeTier", func(t *testing.T) {
+		tierRepo := setUpTierRepo(t)
+		now := time.Now().UTC().Truncate(time.Microsecond)
+
+		changed, err := tierRepo.ChangeTier(&model.TierChange{UserID: "test_user_1", ToTier: "silver", RollingVolume: 20000, CreatedAt: now})
+		assert.NoError(t, err)
+		assert.True(t, changed)
+
+		// the user is not bronze anymore
+		changed, err = tierRepo.ChangeTier(&model.TierChange{UserID: "test_user_1", FromTier: "bronze", ToTier: "gold", CreatedAt: now})
+		assert.NoError(t, err)
+		assert.False(t, changed)
+
+		changed, err = tierRepo.ChangeTier(&model.TierChange{UserID: "test_user_1", FromTier: "silver", ToTier: "gold",
+			RollingVolume: 200000, CreatedAt: now.Add(time.Hour)})
+		assert.NoError(t, err)
+		assert.True(t, changed)
+
+		tiers, err := tierRepo.GetUserTiers([]string{"test_user_1", "test_user_2", "unknown"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]string{"test_user_1": "gold", "test_user_2": ""}, tiers)
+
+		history, err := tierRepo.GetTierHistory("test_user_1")
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(history))
+		assert.Equal(t, "gold", history[0].ToTier)
+		assert.Equal(t, "silver", history[0].FromTier)
+		assert.Equal(t, 200000.0, history[0].RollingVolume)
+		assert.Equal(t, "", history[1].FromTier)
+	})
+
+	t.Run("SearchUserIDsByTiers", func(t *testing.T) {
+		tierRepo := setUpTierRepo(t)
+
+		_, _ = tierRepo.ChangeTier(&model.TierChange{UserID: "test_user_2", ToTier: "gold", CreatedAt: time.Now()})
+
+		userIDs, err := tierRepo.SearchUserIDsByTiers([]string{"silver", "gold"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"test_user_2"}, userIDs)
+	})
+}
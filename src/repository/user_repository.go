@@ -12,7 +12,6 @@ import (
 type UserRepository interface {
 	CreateUser(id string) (*model.User, error)
 	GetUser(id string) (*model.User, error)
-	UpdateUser(user *model.User) (*model.User, error)
 }
 
 const usersTableName = "users"
@@ -47,11 +46,11 @@ func (u *userRepositoryImpl) CreateUser(id string) (*model.User, error) {
 }
 
 func (u *userRepositoryImpl) GetUser(id string) (*model.User, error) {
-	sqlCommand := fmt.Sprintf("SELECT id, points FROM %s WHERE id = $1", usersTableName)
+	sqlCommand := fmt.Sprintf("SELECT id, points, tier FROM %s WHERE id = $1", usersTableName)
 	row := u.dbInstance.QueryRow(sqlCommand, id)
 
 	var user model.User
-	err := row.Scan(&user.ID, &user.Points)
+	err := row.Scan(&user.ID, &user.Points, &user.Tier)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -62,24 +61,3 @@ func (u *userRepositoryImpl) GetUser(id string) (*model.User, error) {
 
 	return &user, nil
 }
-
-func (u *userRepositoryImpl) UpdateUser(user *model.User) (*model.User, error) {
-	sqlCommand := fmt.Sprintf("UPDATE %s SET points = $1 WHERE id = $2 RETURNING id, points", usersTableName)
-
-	stmt, err := u.dbInstance.Prepare(sqlCommand)
-	if err != nil {
-		return nil, err
-	}
-	defer stmt.Close()
-
-	err = stmt.QueryRow(user.Points, user.ID).Scan(&user.ID, &user.Points)
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, exception.UserNotFoundError
-		}
-		return nil, err
-	}
-
-	return user, nil
-}
@@ -6,7 +6,6 @@ import (
 	"testing"
 	"trading-ace/src/database"
 	"trading-ace/src/exception"
-	"trading-ace/src/model"
 )
 
 func TestUserRepositoryImpl(t *testing.T) {
@@ -71,38 +70,4 @@ func TestUserRepositoryImpl(t *testing.T) {
 		_, err = repo.GetUser("not_found_user_id")
 		assert.True(t, errors.Is(err, exception.UserNotFoundError))
 	})
-
-	t.Run("UpdateUser", func(t *testing.T) {
-		repo := setUpUserRepo(t)
-		user, err := repo.CreateUser("test_user_id")
-		if err != nil {
-			t.Errorf("CreateUser() exception = %v", err)
-		}
-
-		user.Points = 100
-		updatedUser, err := repo.UpdateUser(user)
-
-		if err != nil {
-			t.Errorf("UpdateUser() exception = %v", err)
-		}
-
-		assert.Equal(t, "test_user_id", updatedUser.ID)
-		assert.Equal(t, 100.0, updatedUser.Points)
-	})
-
-	t.Run("UpdateUserNotFound", func(t *testing.T) {
-		repo := setUpUserRepo(t)
-		_, err := repo.CreateUser("test_user_id")
-		if err != nil {
-			t.Errorf("CreateUser() exception = %v", err)
-		}
-
-		user := &model.User{
-			ID:     "not_found_user_id",
-			Points: 100,
-		}
-
-		_, err = repo.UpdateUser(user)
-		assert.True(t, errors.Is(err, exception.UserNotFoundError))
-	})
 }
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+)
+
+const (
+	userDailyVolumesTableName = "user_daily_volumes"
+	volumeSwapsTableName      = "volume_swaps"
+)
+
+// VolumeRepository keeps the volume each user swapped per UTC day, once per
+// swap whatever the number of campaigns it counts for.
+type VolumeRepository interface {
+	// AddSwap adds the volume of the swap to the day of at. A swap already
+	// added is skipped, so a retried job doesn't count it twice.
+	AddSwap(swapID string, userID string, at time.Time, volume float64) error
+	// GetVolume sums the volume of the user from the day of from, the lifetime
+	// volume for a zero from.
+	GetVolume(userID string, from time.Time) (float64, error)
+}
+
+type volumeRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewVolumeRepository() VolumeRepository {
+	return &volumeRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+func (r *volumeRepositoryImpl) AddSwap(swapID string, userID string, at time.Time, volume float64) error {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(volumeSwapsTableName).
+		Columns("swap_id", "user_id", "created_at").
+		Values(swapID, userID, at).
+		Suffix("ON CONFLICT (swap_id) DO NOTHING RETURNING swap_id").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	var addedSwapID string
+	err = tx.QueryRow(sqlCommand, args...).Scan(&addedSwapID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	sqlCommand, args, err = psql.Insert(userDailyVolumesTableName).
+		Columns("user_id", "day", "volume", "swap_count").
+		Values(userID, at.UTC().Format(time.DateOnly), volume, 1).
+		Suffix("ON CONFLICT (user_id, day) DO UPDATE SET " +
+			"volume = user_daily_volumes.volume + EXCLUDED.volume, " +
+			"swap_count = user_daily_volumes.swap_count + EXCLUDED.swap_count").
+		ToSql()
+
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.Exec(sqlCommand, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *volumeRepositoryImpl) GetVolume(userID string, from time.Time) (float64, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	query := psql.Select("COALESCE(SUM(volume), 0)").
+		From(userDailyVolumesTableName).
+		Where(squirrel.Eq{"user_id": userID})
+
+	if !from.IsZero() {
+		query = query.Where(squirrel.GtOrEq{"day": from.UTC().Format(time.DateOnly)})
+	}
+
+	sqlCommand, args, err := query.ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var volume float64
+	err = r.dbInstance.QueryRow(sqlCommand, args...).Scan(&volume)
+	return volume, err
+}
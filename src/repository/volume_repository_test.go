@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+)
+
+func TestVolumeRepositoryImpl(t *testing.T) {
+	setUpVolumeRepo := func(t *testing.T) *volumeRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM user_daily_volumes")
+			dbInstance.Exec("DELETE FROM volume_swaps")
+		})
+
+		return &volumeRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	t.Run("GetVolume", func(t *testing.T) {
+		volumeRepo := setUpVolumeRepo(t)
+
+		day := time.Date(2024, 9, 7, 23, 0, 0, 0, time.UTC)
+		assert.NoError(t, volumeRepo.AddSwap("swap_1", "test_user_id", day.AddDate(0, 0, -40), 100))
+		assert.NoError(t, volumeRepo.AddSwap("swap_2", "test_user_id", day, 200))
+		assert.NoError(t, volumeRepo.AddSwap("swap_3", "test_user_id", day.Add(30*time.Minute), 300))
+		assert.NoError(t, volumeRepo.AddSwap("swap_4", "other_user_id", day, 1000))
+
+		volume, err := volumeRepo.GetVolume("test_user_id", day.AddDate(0, 0, -30))
+		assert.NoError(t, err)
+		assert.Equal(t, 500.0, volume)
+
+		volume, err = volumeRepo.GetVolume("test_user_id", time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, 600.0, volume)
+
+		volume, err = volumeRepo.GetVolume("unknown", time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, volume)
+	})
+	t.Run("Swap Added Once", func(t *testing.T) {
+		volumeRepo := setUpVolumeRepo(t)
+
+		day := time.Date(2024, 9, 7, 12, 0, 0, 0, time.UTC)
+		assert.NoError(t, volumeRepo.AddSwap("swap_1", "test_user_id", day, 200))
+		assert.NoError(t, volumeRepo.AddSwap("swap_1", "test_user_id", day, 200))
+
+		volume, err := volumeRepo.GetVolume("test_user_id", time.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, 200.0, volume)
+	})
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/Masterminds/squirrel"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+const (
+	vouchersTableName      = "vouchers"
+	voucherNoncesTableName = "voucher_nonces"
+)
+
+type VoucherRepository interface {
+	CreateVoucher(account string, sign func(nonce int64) (*model.Voucher, error)) (*model.Voucher, error)
+	SearchVouchers(account string) ([]*model.Voucher, error)
+}
+
+type voucherRepositoryImpl struct {
+	dbInstance *sql.DB
+}
+
+func NewVoucherRepository() VoucherRepository {
+	return &voucherRepositoryImpl{
+		dbInstance: database.GetDBInstance(),
+	}
+}
+
+// CreateVoucher allocates the next nonce of the account, lets sign build the
+// voucher with it and stores the voucher, in one transaction. The nonce row
+// stays locked until commit so concurrent vouchers of an account get distinct
+// nonces, and the unique (account, nonce) constraint rejects any reuse.
+func (r *voucherRepositoryImpl) CreateVoucher(account string, sign func(nonce int64) (*model.Voucher, error)) (*model.Voucher, error) {
+	tx, err := r.dbInstance.Begin()
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.Insert(voucherNoncesTableName).
+		Columns("account", "next_nonce").
+		Values(account, 1).
+		Suffix("ON CONFLICT (account) DO UPDATE SET next_nonce = " + voucherNoncesTableName + ".next_nonce + 1 RETURNING next_nonce - 1").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var nonce int64
+	if err := tx.QueryRow(sqlCommand, args...).Scan(&nonce); err != nil {
+		return nil, err
+	}
+
+	voucher, err := sign(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlCommand, args, err = psql.Insert(vouchersTableName).
+		Columns("account", "nonce", "amount", "expiry", "signature", "created_at").
+		Values(voucher.Account, voucher.Nonce, voucher.Amount, voucher.Expiry.UTC(), voucher.Signature, voucher.CreatedAt.UTC()).
+		Suffix("ON CONFLICT (account, nonce) DO NOTHING RETURNING id").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRow(sqlCommand, args...).Scan(&voucher.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, exception.VoucherNonceAlreadyUsedError
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return voucher, nil
+}
+
+// SearchVouchers returns the vouchers issued to the account, latest first.
+func (r *voucherRepositoryImpl) SearchVouchers(account string) ([]*model.Voucher, error) {
+	psql := squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)
+	sqlCommand, args, err := psql.
+		Select("id, account, nonce, amount::TEXT, expiry, signature, created_at").
+		From(vouchersTableName).
+		Where(squirrel.Eq{"account": account}).
+		OrderBy("nonce DESC").
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.dbInstance.Query(sqlCommand, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var vouchers []*model.Voucher
+	for rows.Next() {
+		var voucher model.Voucher
+		err := rows.Scan(&voucher.ID, &voucher.Account, &voucher.Nonce, &voucher.Amount, &voucher.Expiry, &voucher.Signature, &voucher.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		voucher.Expiry = voucher.Expiry.In(time.UTC)
+		voucher.CreatedAt = voucher.CreatedAt.In(time.UTC)
+		vouchers = append(vouchers, &voucher)
+	}
+
+	return vouchers, rows.Err()
+}
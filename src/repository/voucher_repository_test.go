@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+	"trading-ace/src/database"
+	"trading-ace/src/model"
+)
+
+func TestVoucherRepositoryImpl(t *testing.T) {
+	setUpVoucherRepo := func(t *testing.T) *voucherRepositoryImpl {
+		dbInstance := database.GetDBInstance()
+
+		t.Cleanup(func() {
+			dbInstance.Exec("DELETE FROM vouchers")
+			dbInstance.Exec("DELETE FROM voucher_nonces")
+		})
+
+		return &voucherRepositoryImpl{
+			dbInstance: dbInstance,
+		}
+	}
+
+	account := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	sign := func(nonce int64) (*model.Voucher, error) {
+		return &model.Voucher{
+			Account:   account,
+			Nonce:     nonce,
+			Amount:    "1000000000000000000000",
+			Expiry:    time.Now().Add(time.Hour).UTC(),
+			Signature: "0xsignature",
+			CreatedAt: time.Now().UTC(),
+		}, nil
+	}
+
+	t.Run("CreateVoucher", func(t *testing.T) {
+		voucherRepo := setUpVoucherRepo(t)
+
+		first, err := voucherRepo.CreateVoucher(account, sign)
+		assert.NoError(t, err)
+		assert.NotEmpty(t, first.ID)
+		assert.Equal(t, int64(0), first.Nonce)
+
+		second, err := voucherRepo.CreateVoucher(account, sign)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), second.Nonce)
+	})
+
+	t.Run("CreateVoucher concurrently", func(t *testing.T) {
+		voucherRepo := setUpVoucherRepo(t)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := voucherRepo.CreateVoucher(account, sign)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		vouchers, err := voucherRepo.SearchVouchers(account)
+		assert.NoError(t, err)
+		assert.Equal(t, 10, len(vouchers))
+		assert.Equal(t, int64(9), vouchers[0].Nonce)
+		assert.Equal(t, "1000000000000000000000", vouchers[0].Amount)
+	})
+
+	t.Run("CreateVoucher with failed signature", func(t *testing.T) {
+		voucherRepo := setUpVoucherRepo(t)
+
+		_, err := voucherRepo.CreateVoucher(account, func(nonce int64) (*model.Voucher, error) {
+			return nil, assert.AnError
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+
+		voucher, err := voucherRepo.CreateVoucher(account, sign)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), voucher.Nonce)
+	})
+}
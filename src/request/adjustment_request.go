@@ -0,0 +1,11 @@
+package request
+
+type AdjustPointsRequest struct {
+	// CampaignID is 0 for an adjustment outside of any campaign.
+	CampaignID int     `json:"campaign_id" binding:"gte=0"`
+	Direction  string  `json:"direction" binding:"required,oneof=credit debit"`
+	Points     float64 `json:"points" binding:"required,gt=0"`
+	Reason     string  `json:"reason" binding:"required"`
+	// Operator defaults to the name of the API key of the request.
+	Operator string `json:"operator"`
+}
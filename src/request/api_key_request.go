@@ -0,0 +1,7 @@
+package request
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name" binding:"required"`
+	Role   string   `json:"role" binding:"required,oneof=viewer operator admin"`
+	Scopes []string `json:"scopes" binding:"required,min=1"`
+}
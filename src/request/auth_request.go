@@ -0,0 +1,7 @@
+package request
+
+type LoginRequest struct {
+	// Message is the EIP-4361 message exactly as the wallet signed it.
+	Message   string `json:"message" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
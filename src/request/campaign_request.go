@@ -0,0 +1,20 @@
+package request
+
+type CampaignRequest struct {
+	Name             string    `json:"name" binding:"required"`
+	Pools            []string  `json:"pools" binding:"required,min=1"`
+	StartTime        string    `json:"start_time" binding:"required"`
+	PeriodType       string    `json:"period_type" binding:"required,oneof=daily weekly monthly cron"`
+	PeriodCron       string    `json:"period_cron" binding:"required_if=PeriodType cron"`
+	Timezone         string    `json:"timezone"`
+	Periods          int       `json:"periods" binding:"required,gt=0"`
+	BudgetPerPeriod  float64   `json:"budget_per_period" binding:"required,gt=0"`
+	BudgetDecay      float64   `json:"budget_decay" binding:"gte=0"`
+	BudgetSchedule   []float64 `json:"budget_schedule" binding:"dive,gte=0"`
+	OnboardingAmount float64   `json:"onboarding_amount" binding:"gte=0"`
+	OnboardingReward float64   `json:"onboarding_reward" binding:"gte=0"`
+}
+
+type SearchCampaignsRequest struct {
+	Status []string `form:"status"`
+}
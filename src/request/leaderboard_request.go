@@ -0,0 +1,11 @@
+package request
+
+type GetLeaderboardRequest struct {
+	CampaignID int `form:"campaign_id" binding:"required,gt=0"`
+	// Period is the period index, omitted to rank the whole campaign.
+	Period   *int   `form:"period" binding:"omitempty,gte=0"`
+	SortBy   string `form:"sort_by" binding:"omitempty,oneof=volume points"`
+	Page     int    `form:"page" binding:"omitempty,gte=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,gte=1,lte=100"`
+	User     string `form:"user_address"`
+}
@@ -0,0 +1,18 @@
+package request
+
+import "time"
+
+type CreateMultiplierRequest struct {
+	Name   string  `json:"name" binding:"required"`
+	Factor float64 `json:"factor" binding:"required,gt=0"`
+	// CampaignID is 0 for every campaign.
+	CampaignID int `json:"campaign_id" binding:"gte=0"`
+	// UserIDs is empty for every user.
+	UserIDs   []string   `json:"user_ids"`
+	StartTime time.Time  `json:"start_time" binding:"required"`
+	EndTime   *time.Time `json:"end_time"`
+}
+
+type SearchMultipliersRequest struct {
+	CampaignID int `form:"campaign_id" binding:"gte=0"`
+}
@@ -0,0 +1,13 @@
+package request
+
+type RedemptionRequest struct {
+	ItemID string `json:"item_id" binding:"required"`
+}
+
+type SearchRedemptionsRequest struct {
+	Status []string `form:"status"`
+}
+
+type CancelRedemptionRequest struct {
+	Reason string `json:"reason" binding:"required"`
+}
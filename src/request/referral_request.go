@@ -0,0 +1,12 @@
+package request
+
+type RegisterReferralCodeRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// LinkReferralRequest carries the personal_sign signature of "Link <address>
+// to the referral code <CODE>" by the referee, the code upper case.
+type LinkReferralRequest struct {
+	Code      string `json:"code" binding:"required"`
+	Signature string `json:"signature" binding:"required"`
+}
@@ -4,4 +4,19 @@ type GetRewordHistoryRequest struct {
 	User      string `form:"user_address"`
 	StartTime string `form:"start_time"`
 	EndTime   string `form:"end_time"`
+	// CampaignID and Period only apply to CSV and NDJSON exports.
+	CampaignID int  `form:"campaign_id" binding:"omitempty,gt=0"`
+	Period     *int `form:"period" binding:"omitempty,gte=0"`
+	PageRequest
+}
+
+type GetRewardProjectionRequest struct {
+	User string `form:"user_address" binding:"required"`
+}
+
+type PageRequest struct {
+	Cursor string `form:"cursor"`
+	Limit  uint64 `form:"limit" binding:"omitempty,gte=1,lte=200"`
+	SortBy string `form:"sort_by"`
+	Order  string `form:"order" binding:"omitempty,oneof=asc desc"`
 }
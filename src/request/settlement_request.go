@@ -0,0 +1,8 @@
+package request
+
+type ExecuteSettlementRequest struct {
+	// Operator defaults to the name of the API key of the request.
+	Operator string `json:"operator"`
+	// Confirm has to be true, it keeps a replayed preview request from paying out.
+	Confirm bool `json:"confirm" binding:"required"`
+}
@@ -1,9 +1,14 @@
 package request
 
 type GetTaskRequest struct {
-	User      string `form:"user_address"`
-	Type      string `form:"type"`
-	Status    string `form:"status"`
-	StartTime string `form:"start_time"`
-	EndTime   string `form:"end_time"`
+	User string `form:"user_address"`
+	// Type and Status accept repeated or comma separated values.
+	Type      []string `form:"type"`
+	Status    []string `form:"status"`
+	StartTime string   `form:"start_time"`
+	EndTime   string   `form:"end_time"`
+	CampaignID int    `form:"campaign_id" binding:"omitempty,gt=0"`
+	// Period only applies to CSV and NDJSON exports.
+	Period *int `form:"period" binding:"omitempty,gte=0"`
+	PageRequest
 }
@@ -0,0 +1,40 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type Adjustment struct {
+	ID            int       `json:"id"`
+	User          string    `json:"user_address"`
+	CampaignID    int       `json:"campaign_id"`
+	Points        float64   `json:"points"`
+	BalanceBefore float64   `json:"balance_before"`
+	BalanceAfter  float64   `json:"balance_after"`
+	Reason        string    `json:"reason"`
+	Operator      string    `json:"operator"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func NewAdjustment(record *model.RewardRecord) *Adjustment {
+	return &Adjustment{
+		ID:            record.ID,
+		User:          record.UserID,
+		CampaignID:    record.CampaignID,
+		Points:        record.Points,
+		BalanceBefore: record.OriginPoints,
+		BalanceAfter:  record.UpdatedPoints,
+		Reason:        record.Reason,
+		Operator:      record.Operator,
+		CreatedAt:     record.CreatedAt,
+	}
+}
+
+func NewAdjustmentCollection(records []*model.RewardRecord) []*Adjustment {
+	collection := make([]*Adjustment, 0, len(records))
+	for _, record := range records {
+		collection = append(collection, NewAdjustment(record))
+	}
+	return collection
+}
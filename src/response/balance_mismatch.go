@@ -0,0 +1,28 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type BalanceMismatch struct {
+	User         string    `json:"user_address"`
+	CachedPoints float64   `json:"cached_points"`
+	LedgerPoints float64   `json:"ledger_points"`
+	Difference   float64   `json:"difference"`
+	DetectedAt   time.Time `json:"detected_at"`
+}
+
+func NewBalanceMismatchCollection(mismatches []*model.BalanceMismatch) []*BalanceMismatch {
+	collection := make([]*BalanceMismatch, 0, len(mismatches))
+	for _, mismatch := range mismatches {
+		collection = append(collection, &BalanceMismatch{
+			User:         mismatch.UserID,
+			CachedPoints: mismatch.CachedPoints,
+			LedgerPoints: mismatch.LedgerPoints,
+			Difference:   mismatch.Difference(),
+			DetectedAt:   mismatch.DetectedAt,
+		})
+	}
+	return collection
+}
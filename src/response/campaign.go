@@ -0,0 +1,59 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type Campaign struct {
+	ID               int       `json:"id"`
+	Name             string    `json:"name"`
+	Pools            []string  `json:"pools"`
+	StartTime        time.Time `json:"start_time"`
+	EndTime          time.Time `json:"end_time"`
+	PeriodType       string    `json:"period_type"`
+	PeriodCron       string    `json:"period_cron,omitempty"`
+	Timezone         string    `json:"timezone"`
+	Periods          int       `json:"periods"`
+	BudgetPerPeriod  float64   `json:"budget_per_period"`
+	BudgetDecay      float64   `json:"budget_decay"`
+	BudgetSchedule   []float64 `json:"budget_schedule"`
+	OnboardingAmount float64   `json:"onboarding_amount"`
+	OnboardingReward float64   `json:"onboarding_reward"`
+	Status           string    `json:"status"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type CampaignCollection []*Campaign
+
+func NewCampaign(campaign *model.Campaign) *Campaign {
+	return &Campaign{
+		ID:               campaign.ID,
+		Name:             campaign.Name,
+		Pools:            campaign.Pools,
+		StartTime:        campaign.StartTime,
+		EndTime:          campaign.EndTime(),
+		PeriodType:       string(campaign.PeriodType),
+		PeriodCron:       campaign.PeriodCron,
+		Timezone:         campaign.Timezone,
+		Periods:          campaign.Periods,
+		BudgetPerPeriod:  campaign.BudgetPerPeriod,
+		BudgetDecay:      campaign.BudgetDecay,
+		BudgetSchedule:   campaign.BudgetSchedule,
+		OnboardingAmount: campaign.OnboardingAmount,
+		OnboardingReward: campaign.OnboardingReward,
+		Status:           string(campaign.Status),
+		CreatedAt:        campaign.CreatedAt,
+		UpdatedAt:        campaign.UpdatedAt,
+	}
+}
+
+func NewCampaignCollection(campaigns []*model.Campaign) CampaignCollection {
+	collection := make(CampaignCollection, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		collection = append(collection, NewCampaign(campaign))
+	}
+
+	return collection
+}
@@ -0,0 +1,45 @@
+package response
+
+import "trading-ace/src/model"
+
+type Claim struct {
+	CampaignID    int      `json:"campaign_id"`
+	PeriodIndex   int      `json:"period_index"`
+	Root          string   `json:"merkle_root"`
+	TokenDecimals int      `json:"token_decimals"`
+	Address       string   `json:"address"`
+	Amount        string   `json:"amount"`
+	Proof         []string `json:"proof"`
+}
+
+func NewClaim(claim *model.ClaimOfCampaign) *Claim {
+	return &Claim{
+		CampaignID:    claim.Distribution.CampaignID,
+		PeriodIndex:   claim.Distribution.PeriodIndex,
+		Root:          claim.Distribution.Root,
+		TokenDecimals: claim.Distribution.TokenDecimals,
+		Address:       claim.Claim.Address,
+		Amount:        claim.Claim.Amount,
+		Proof:         claim.Claim.Proof,
+	}
+}
+
+func NewClaimCollection(claims []*model.ClaimOfCampaign) []*Claim {
+	collection := make([]*Claim, 0, len(claims))
+	for _, claim := range claims {
+		collection = append(collection, NewClaim(claim))
+	}
+	return collection
+}
+
+type ClaimRequest struct {
+	Address       string  `json:"address"`
+	ClaimedPoints float64 `json:"claimed_points"`
+}
+
+func NewClaimRequest(address string, claimedPoints float64) *ClaimRequest {
+	return &ClaimRequest{
+		Address:       address,
+		ClaimedPoints: claimedPoints,
+	}
+}
@@ -0,0 +1,17 @@
+package response
+
+import "trading-ace/src/model"
+
+type ExpiringPoints struct {
+	Total    float64                 `json:"total"`
+	Expiring []*model.ExpiringPoints `json:"expiring"`
+}
+
+func NewExpiringPoints(expiring []*model.ExpiringPoints) *ExpiringPoints {
+	res := &ExpiringPoints{Expiring: make([]*model.ExpiringPoints, 0, len(expiring))}
+	for _, points := range expiring {
+		res.Total += points.Points
+		res.Expiring = append(res.Expiring, points)
+	}
+	return res
+}
@@ -0,0 +1,56 @@
+package response
+
+import "trading-ace/src/model"
+
+type LeaderboardEntry struct {
+	Rank        int     `json:"rank"`
+	UserAddress string  `json:"user_address"`
+	Volume      float64 `json:"volume"`
+	Points      float64 `json:"points"`
+	SwapCount   int     `json:"swap_count"`
+	Tier        string  `json:"tier"`
+}
+
+type Leaderboard struct {
+	CampaignID  int                 `json:"campaign_id"`
+	PeriodIndex *int                `json:"period_index"`
+	SortBy      string              `json:"sort_by"`
+	Page        int                 `json:"page"`
+	PageSize    int                 `json:"page_size"`
+	Total       int                 `json:"total"`
+	Entries     []*LeaderboardEntry `json:"entries"`
+	Me          *LeaderboardEntry   `json:"me,omitempty"`
+}
+
+func NewLeaderboardEntry(entry *model.LeaderboardEntry) *LeaderboardEntry {
+	if entry == nil {
+		return nil
+	}
+
+	return &LeaderboardEntry{
+		Rank:        entry.Rank,
+		UserAddress: entry.UserID,
+		Volume:      entry.Volume,
+		Points:      entry.Points,
+		SwapCount:   entry.SwapCount,
+		Tier:        entry.Tier,
+	}
+}
+
+func NewLeaderboard(leaderboard *model.Leaderboard) *Leaderboard {
+	entries := make([]*LeaderboardEntry, 0, len(leaderboard.Entries))
+	for _, entry := range leaderboard.Entries {
+		entries = append(entries, NewLeaderboardEntry(entry))
+	}
+
+	return &Leaderboard{
+		CampaignID:  leaderboard.CampaignID,
+		PeriodIndex: leaderboard.PeriodIndex,
+		SortBy:      string(leaderboard.SortBy),
+		Page:        leaderboard.Page,
+		PageSize:    leaderboard.PageSize,
+		Total:       leaderboard.Total,
+		Entries:     entries,
+		Me:          NewLeaderboardEntry(leaderboard.Me),
+	}
+}
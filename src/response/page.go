@@ -0,0 +1,20 @@
+package response
+
+// CursorPage wraps a page of a keyset paginated search, NextCursor is null on the last page.
+type CursorPage[T any] struct {
+	Data       []T     `json:"data"`
+	NextCursor *string `json:"next_cursor"`
+}
+
+func NewCursorPage[T any](data []T, nextCursor string) *CursorPage[T] {
+	if data == nil {
+		data = make([]T, 0)
+	}
+
+	page := &CursorPage[T]{Data: data}
+	if nextCursor != "" {
+		page.NextCursor = &nextCursor
+	}
+
+	return page
+}
@@ -5,10 +5,15 @@ import (
 )
 
 type PointHistory struct {
-	User              string  `json:"user_address"`
-	DistributedPoints float64 `json:"distributed_points"`
-	TotalPoints       float64 `json:"total_points"`
-	UpdatedAt         string  `json:"updated_at"`
+	User              string                 `json:"user_address"`
+	Type              model.RewardRecordType `json:"type"`
+	DistributedPoints float64                `json:"distributed_points"`
+	TotalPoints       float64                `json:"total_points"`
+	// Reason explains adjustments.
+	Reason string `json:"reason,omitempty"`
+	// Multipliers boosted the points of task rewards.
+	Multipliers []*model.AppliedMultiplier `json:"multipliers,omitempty"`
+	UpdatedAt   string                     `json:"updated_at"`
 }
 
 type PointHistoryCollection []*PointHistory
@@ -16,8 +21,11 @@ type PointHistoryCollection []*PointHistory
 func CreatePointHistory(record *model.RewardRecord) *PointHistory {
 	return &PointHistory{
 		User:              record.UserID,
+		Type:              record.Type,
 		DistributedPoints: record.Points,
 		TotalPoints:       record.UpdatedPoints,
+		Reason:            record.Reason,
+		Multipliers:       record.Multipliers,
 		UpdatedAt:         record.CreatedAt.String(),
 	}
 }
@@ -0,0 +1,35 @@
+package response
+
+import "trading-ace/src/model"
+
+type ReferralInfo struct {
+	UserAddress string `json:"user_address"`
+	// Code is empty until the user registers one.
+	Code string `json:"code"`
+	// ReferredBy is the referrer of the user, empty when it wasn't referred.
+	ReferredBy string                 `json:"referred_by"`
+	Referrals  []*model.Referral      `json:"referrals"`
+	Rewards    *model.ReferralRewards `json:"rewards"`
+}
+
+func NewReferralInfo(userAddress string, info *model.ReferralInfo, rewards *model.ReferralRewards) *ReferralInfo {
+	referralInfo := &ReferralInfo{
+		UserAddress: userAddress,
+		Referrals:   info.Referrals,
+		Rewards:     rewards,
+	}
+
+	if info.Code != nil {
+		referralInfo.Code = info.Code.Code
+	}
+
+	if info.ReferredBy != nil {
+		referralInfo.ReferredBy = info.ReferredBy.ReferrerID
+	}
+
+	if referralInfo.Referrals == nil {
+		referralInfo.Referrals = []*model.Referral{}
+	}
+
+	return referralInfo
+}
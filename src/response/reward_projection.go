@@ -0,0 +1,49 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type RewardProjection struct {
+	CampaignID      int       `json:"campaign_id"`
+	CampaignName    string    `json:"campaign_name"`
+	PeriodIndex     int       `json:"period_index"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+	Budget          float64   `json:"budget"`
+	UserVolume      float64   `json:"user_volume"`
+	PoolVolume      float64   `json:"pool_volume"`
+	EligibleVolume  float64   `json:"eligible_volume"`
+	Onboarded       bool      `json:"onboarded"`
+	Share           float64   `json:"share"`
+	ProjectedPoints float64   `json:"projected_points"`
+}
+
+type RewardProjectionCollection []*RewardProjection
+
+func NewRewardProjection(projection *model.RewardProjection) *RewardProjection {
+	return &RewardProjection{
+		CampaignID:      projection.CampaignID,
+		CampaignName:    projection.CampaignName,
+		PeriodIndex:     projection.PeriodIndex,
+		StartTime:       projection.StartTime,
+		EndTime:         projection.EndTime,
+		Budget:          projection.Budget,
+		UserVolume:      projection.UserVolume,
+		PoolVolume:      projection.Totals.Volume,
+		EligibleVolume:  projection.Totals.EligibleVolume,
+		Onboarded:       projection.Onboarded,
+		Share:           projection.Share,
+		ProjectedPoints: projection.ProjectedPoints,
+	}
+}
+
+func NewRewardProjectionCollection(projections []*model.RewardProjection) RewardProjectionCollection {
+	collection := make(RewardProjectionCollection, 0, len(projections))
+	for _, projection := range projections {
+		collection = append(collection, NewRewardProjection(projection))
+	}
+
+	return collection
+}
@@ -0,0 +1,73 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type Settlement struct {
+	ID          int       `json:"id"`
+	CampaignID  int       `json:"campaign_id"`
+	PeriodIndex int       `json:"period_index"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	SettledAt   time.Time `json:"settled_at"`
+}
+
+type SharedPoolPayout struct {
+	TaskID      int                        `json:"task_id"`
+	UserAddress string                     `json:"user_address"`
+	SwapAmount  float64                    `json:"swap_amount"`
+	Weight      float64                    `json:"weight"`
+	Multipliers []*model.AppliedMultiplier `json:"multipliers,omitempty"`
+	Share       float64                    `json:"share"`
+	Points      float64                    `json:"points"`
+}
+
+type SettlementPreview struct {
+	CampaignID      int                 `json:"campaign_id"`
+	PeriodIndex     int                 `json:"period_index"`
+	StartTime       time.Time           `json:"start_time"`
+	EndTime         time.Time           `json:"end_time"`
+	Budget          float64             `json:"budget"`
+	TotalSwapAmount float64             `json:"total_swap_amount"`
+	Settled         bool                `json:"settled"`
+	Payouts         []*SharedPoolPayout `json:"payouts"`
+}
+
+func NewSettlement(settlement *model.Settlement) *Settlement {
+	return &Settlement{
+		ID:          settlement.ID,
+		CampaignID:  settlement.CampaignID,
+		PeriodIndex: settlement.PeriodIndex,
+		StartTime:   settlement.StartTime,
+		EndTime:     settlement.EndTime,
+		SettledAt:   settlement.SettledAt,
+	}
+}
+
+func NewSettlementPreview(preview *model.SettlementPreview) *SettlementPreview {
+	payouts := make([]*SharedPoolPayout, 0, len(preview.Payouts))
+	for _, payout := range preview.Payouts {
+		payouts = append(payouts, &SharedPoolPayout{
+			TaskID:      payout.TaskID,
+			UserAddress: payout.UserID,
+			SwapAmount:  payout.SwapAmount,
+			Weight:      payout.Weight,
+			Multipliers: payout.Multipliers,
+			Share:       payout.Share,
+			Points:      payout.Points,
+		})
+	}
+
+	return &SettlementPreview{
+		CampaignID:      preview.CampaignID,
+		PeriodIndex:     preview.PeriodIndex,
+		StartTime:       preview.StartTime,
+		EndTime:         preview.EndTime,
+		Budget:          preview.Budget,
+		TotalSwapAmount: preview.TotalSwapAmount,
+		Settled:         preview.Settled,
+		Payouts:         payouts,
+	}
+}
@@ -12,11 +12,13 @@ type Task struct {
 	Type              string    `json:"type"`
 	Status            string    `json:"status"`
 	SwapAmount        float64   `json:"swap_amount"`
-	DistributedPoints float64   `json:"distributed_points"`
+	DistributedPoints *float64  `json:"distributed_points,omitempty"`
 	CreatedAt         time.Time `json:"created_at"`
 }
 
-func NewTask(task *model.Task, distributedPoints float64) *Task {
+// NewTask converts a task, distributedPoints is nil when the points of the task
+// are not disclosed.
+func NewTask(task *model.Task, distributedPoints *float64) *Task {
 	return &Task{
 		User:              task.UserID,
 		Type:              string(task.Type),
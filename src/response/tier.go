@@ -0,0 +1,19 @@
+package response
+
+import "trading-ace/src/model"
+
+type TierHistory struct {
+	UserAddress string              `json:"user_address"`
+	History     []*model.TierChange `json:"history"`
+}
+
+func NewTierHistory(userAddress string, history []*model.TierChange) *TierHistory {
+	if history == nil {
+		history = []*model.TierChange{}
+	}
+
+	return &TierHistory{
+		UserAddress: userAddress,
+		History:     history,
+	}
+}
@@ -0,0 +1,70 @@
+package response
+
+import (
+	"time"
+	"trading-ace/src/model"
+)
+
+type UserCampaignProfile struct {
+	CampaignID          int        `json:"campaign_id"`
+	CampaignName        string     `json:"campaign_name"`
+	CampaignStatus      string     `json:"campaign_status"`
+	Onboarded           bool       `json:"onboarded"`
+	OnboardedAt         *time.Time `json:"onboarded_at"`
+	LifetimeVolume      float64    `json:"lifetime_volume"`
+	SwapCount           int        `json:"swap_count"`
+	Points              float64    `json:"points"`
+	CurrentPeriodIndex  *int       `json:"current_period_index"`
+	CurrentPeriodVolume float64    `json:"current_period_volume"`
+	Rank                int        `json:"rank"`
+}
+
+type UserStreak struct {
+	Period  string `json:"period"`
+	Current int    `json:"current"`
+	Best    int    `json:"best"`
+}
+
+type UserProfile struct {
+	UserAddress string                 `json:"user_address"`
+	TotalPoints float64                `json:"total_points"`
+	Tier        string                 `json:"tier"`
+	Streaks     []*UserStreak          `json:"streaks"`
+	Campaigns   []*UserCampaignProfile `json:"campaigns"`
+}
+
+func NewUserProfile(profile *model.UserProfile) *UserProfile {
+	campaigns := make([]*UserCampaignProfile, 0, len(profile.Campaigns))
+	for _, campaign := range profile.Campaigns {
+		campaigns = append(campaigns, &UserCampaignProfile{
+			CampaignID:          campaign.CampaignID,
+			CampaignName:        campaign.CampaignName,
+			CampaignStatus:      string(campaign.CampaignStatus),
+			Onboarded:           campaign.Onboarded,
+			OnboardedAt:         campaign.OnboardedAt,
+			LifetimeVolume:      campaign.LifetimeVolume,
+			SwapCount:           campaign.SwapCount,
+			Points:              campaign.Points,
+			CurrentPeriodIndex:  campaign.CurrentPeriodIndex,
+			CurrentPeriodVolume: campaign.CurrentPeriodVolume,
+			Rank:                campaign.Rank,
+		})
+	}
+
+	streaks := make([]*UserStreak, 0, len(profile.Streaks))
+	for _, streak := range profile.Streaks {
+		streaks = append(streaks, &UserStreak{
+			Period:  string(streak.Period),
+			Current: streak.Current,
+			Best:    streak.Best,
+		})
+	}
+
+	return &UserProfile{
+		UserAddress: profile.User.ID,
+		TotalPoints: profile.User.Points,
+		Tier:        profile.User.Tier,
+		Streaks:     streaks,
+		Campaigns:   campaigns,
+	}
+}
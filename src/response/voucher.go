@@ -0,0 +1,31 @@
+package response
+
+import "trading-ace/src/model"
+
+type Voucher struct {
+	Account   string               `json:"account"`
+	Amount    string               `json:"amount"`
+	Nonce     int64                `json:"nonce"`
+	Expiry    int64                `json:"expiry"`
+	Signature string               `json:"signature"`
+	Domain    *model.VoucherDomain `json:"domain,omitempty"`
+}
+
+func NewVoucher(voucher *model.Voucher) *Voucher {
+	return &Voucher{
+		Account:   voucher.Account,
+		Amount:    voucher.Amount,
+		Nonce:     voucher.Nonce,
+		Expiry:    voucher.Expiry.Unix(),
+		Signature: voucher.Signature,
+		Domain:    voucher.Domain,
+	}
+}
+
+func NewVoucherCollection(vouchers []*model.Voucher) []*Voucher {
+	collection := make([]*Voucher, 0, len(vouchers))
+	for _, voucher := range vouchers {
+		collection = append(collection, NewVoucher(voucher))
+	}
+	return collection
+}
@@ -0,0 +1,127 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"trading-ace/mock/service"
+	"trading-ace/src/controller"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type adminAuthTestSuite struct {
+	engine              *gin.Engine
+	mockedAPIKeyService *service.MockAPIKeyService
+	mockedAuditService  *service.MockAuditService
+}
+
+func (s *adminAuthTestSuite) setUp(t *testing.T) {
+	s.mockedAPIKeyService = service.NewMockAPIKeyService(t)
+	s.mockedAuditService = service.NewMockAuditService(t)
+	adminAuth := &adminAuthenticator{
+		apiKeyService: s.mockedAPIKeyService,
+		auditService:  s.mockedAuditService,
+	}
+
+	handler := func(c *gin.Context) {
+		c.String(http.StatusOK, c.GetString(controller.AdminOperatorKey))
+	}
+
+	s.engine = gin.New()
+	adminRoutes := s.engine.Group("/api/admin", adminAuth.authenticate, adminAuth.audit)
+	adminRoutes.GET("/campaigns", adminAuth.require(model.APIKeyScopeCampaigns, model.RoleViewer), handler)
+	adminRoutes.POST("/campaigns", adminAuth.require(model.APIKeyScopeCampaigns, model.RoleOperator), handler)
+	adminRoutes.GET("/tasks/export", adminAuth.auditRead, adminAuth.require(model.APIKeyScopeExports, model.RoleViewer), handler)
+}
+
+func (s *adminAuthTestSuite) serve(method string, key string) *httptest.ResponseRecorder {
+	return s.serveTarget(method, "/api/admin/campaigns", key)
+}
+
+func (s *adminAuthTestSuite) serveTarget(method string, target string, key string) *httptest.ResponseRecorder {
+	testResponseWriter := httptest.NewRecorder()
+	request := httptest.NewRequest(method, target, nil)
+	if key != "" {
+		request.Header.Set(apiKeyHeader, key)
+	}
+	s.engine.ServeHTTP(testResponseWriter, request)
+	return testResponseWriter
+}
+
+func TestAdminAuthenticator(t *testing.T) {
+	testSuite := &adminAuthTestSuite{}
+	viewer := &model.APIKey{ID: 1, Name: "dashboard", Role: model.RoleViewer, Scopes: []model.APIKeyScope{model.APIKeyScopeCampaigns}}
+	operator := &model.APIKey{ID: 2, Name: "ops", Role: model.RoleOperator, Scopes: []model.APIKeyScope{model.APIKeyScopeAll}}
+
+	t.Run("Read Without Audit", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("viewer_key").Return(viewer, nil).Times(1)
+
+		testResponseWriter := testSuite.serve(http.MethodGet, "viewer_key")
+
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "dashboard", testResponseWriter.Body.String())
+	})
+
+	t.Run("Write Audited", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("operator_key").Return(operator, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record("ops", model.AuditActionAdminRequest, "/api/admin/campaigns", map[string]any{
+			"api_key_id": 2,
+			"method":     http.MethodPost,
+			"path":       "/api/admin/campaigns",
+			"status":     http.StatusOK,
+		}).Return(nil).Times(1)
+
+		assert.Equal(t, http.StatusOK, testSuite.serve(http.MethodPost, "operator_key").Code)
+	})
+
+	t.Run("Export Audited", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("operator_key").Return(operator, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record("ops", model.AuditActionAdminRequest, "/api/admin/tasks/export", map[string]any{
+			"api_key_id": 2,
+			"method":     http.MethodGet,
+			"path":       "/api/admin/tasks/export",
+			"query":      "campaign_id=1",
+			"status":     http.StatusOK,
+		}).Return(nil).Times(1)
+
+		assert.Equal(t, http.StatusOK, testSuite.serveTarget(http.MethodGet, "/api/admin/tasks/export?campaign_id=1", "operator_key").Code)
+	})
+
+	t.Run("Export Out Of Scope", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("viewer_key").Return(viewer, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record("dashboard", model.AuditActionAdminRequest, "/api/admin/tasks/export", mock.Anything).
+			Return(nil).Times(1)
+
+		assert.Equal(t, http.StatusForbidden, testSuite.serveTarget(http.MethodGet, "/api/admin/tasks/export", "viewer_key").Code)
+	})
+
+	t.Run("Role Too Low", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("viewer_key").Return(viewer, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record("dashboard", model.AuditActionAdminRequest, "/api/admin/campaigns", mock.Anything).
+			Return(nil).Times(1)
+
+		assert.Equal(t, http.StatusForbidden, testSuite.serve(http.MethodPost, "viewer_key").Code)
+	})
+
+	t.Run("Unknown Key", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.mockedAPIKeyService.EXPECT().Authenticate("revoked_key").Return(nil, exception.APIKeyNotFoundError).Times(1)
+
+		assert.Equal(t, http.StatusUnauthorized, testSuite.serve(http.MethodGet, "revoked_key").Code)
+	})
+
+	t.Run("Missing Key", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		assert.Equal(t, http.StatusUnauthorized, testSuite.serve(http.MethodPost, "").Code)
+	})
+}
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/model"
+	"trading-ace/src/ratelimit"
+)
+
+const rateLimitKeyPrefix = "ratelimit:"
+
+// newRateLimiter returns the limiter of the config, nil when rate limiting is off.
+func newRateLimiter(rateLimitConfig *config.RateLimitConfig, redisConfig *config.RedisConfig) ratelimit.Limiter {
+	if rateLimitConfig == nil || rateLimitConfig.Limit <= 0 {
+		return nil
+	}
+
+	if rateLimitConfig.Backend != "redis" {
+		return ratelimit.NewMemoryLimiter(rateLimitConfig.Limit, rateLimitConfig.GetPeriod())
+	}
+
+	if redisConfig == nil || redisConfig.GetRateLimit() == nil {
+		log.Fatalf("Rate limit backend is redis but no redis is configured")
+	}
+
+	connection := redisConfig.GetRateLimit()
+	client := redis.NewClient(&redis.Options{
+		Addr: fmt.Sprintf("%s:%d", connection.Host, connection.Port),
+		DB:   connection.Database,
+	})
+
+	return ratelimit.NewRedisLimiter(client, rateLimitKeyPrefix, rateLimitConfig.Limit, rateLimitConfig.GetPeriod())
+}
+
+// rateLimit takes a token of the client for every request, and answers 429
+// once the bucket is empty. Responses carry the RateLimit-* headers of the
+// IETF draft. Requests go through when the limiter fails.
+func rateLimit(limiter ratelimit.Limiter) gin.HandlerFunc {
+	policy := fmt.Sprintf("%d;w=%d", limiter.Limit(), int(limiter.Period().Seconds()))
+
+	return func(c *gin.Context) {
+		result, err := limiter.Allow(c.Request.Context(), rateLimitKey(c))
+		if err != nil {
+			log.Printf("Failed to rate limit %s: %v", c.ClientIP(), err)
+			c.Next()
+			return
+		}
+
+		c.Header("RateLimit-Policy", policy)
+		c.Header("RateLimit-Limit", strconv.Itoa(result.Limit))
+		c.Header("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+		c.Header("RateLimit-Reset", strconv.Itoa(ceilSeconds(result.ResetAfter)))
+
+		if !result.Allowed {
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"exception": "rate limit exceeded"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// rateLimitKey identifies the client by the API key authenticate resolved, or
+// else by its IP. An unauthenticated X-API-Key header is ignored, so made up
+// keys neither get a bucket of their own nor grow the store.
+func rateLimitKey(c *gin.Context) string {
+	if apiKey, ok := c.Value(apiKeyContextKey).(*model.APIKey); ok {
+		return fmt.Sprintf("key:%d", apiKey.ID)
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
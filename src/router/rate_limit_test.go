@@ -0,0 +1,128 @@
+package router
+
+import (
+	"context"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/ratelimit"
+)
+
+type failingLimiter struct{}
+
+func (failingLimiter) Allow(context.Context, string) (*ratelimit.Result, error) {
+	return nil, assert.AnError
+}
+
+func (failingLimiter) Limit() int {
+	return 1
+}
+
+func (failingLimiter) Period() time.Duration {
+	return time.Minute
+}
+
+func TestRateLimit(t *testing.T) {
+	serve := func(engine *gin.Engine, remoteAddr string, apiKey string) *httptest.ResponseRecorder {
+		testResponseWriter := httptest.NewRecorder()
+		request := httptest.NewRequest(http.MethodGet, "/api/tasks", nil)
+		request.RemoteAddr = remoteAddr
+		request.Header.Set("X-Forwarded-For", "203.0.113.7")
+		if apiKey != "" {
+			request.Header.Set(apiKeyHeader, apiKey)
+		}
+		engine.ServeHTTP(testResponseWriter, request)
+		return testResponseWriter
+	}
+
+	// authenticate stands for the admin authenticator, only "ta_key" is valid.
+	authenticate := func(c *gin.Context) {
+		if c.GetHeader(apiKeyHeader) == "ta_key" {
+			c.Set(apiKeyContextKey, &model.APIKey{ID: 1})
+		}
+		c.Next()
+	}
+
+	newEngine := func(limiter ratelimit.Limiter) *gin.Engine {
+		engine := gin.New()
+		_ = engine.SetTrustedProxies(nil)
+		engine.GET("/api/tasks", authenticate, rateLimit(limiter), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		return engine
+	}
+
+	t.Run("Throttle Per Client", func(t *testing.T) {
+		engine := newEngine(ratelimit.NewMemoryLimiter(2, time.Minute))
+
+		testResponseWriter := serve(engine, "10.0.0.1:1234", "")
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Equal(t, "2;w=60", testResponseWriter.Header().Get("RateLimit-Policy"))
+		assert.Equal(t, "2", testResponseWriter.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "1", testResponseWriter.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "30", testResponseWriter.Header().Get("RateLimit-Reset"))
+
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "").Code)
+
+		testResponseWriter = serve(engine, "10.0.0.1:5678", "")
+		assert.Equal(t, http.StatusTooManyRequests, testResponseWriter.Code)
+		assert.Equal(t, "0", testResponseWriter.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "30", testResponseWriter.Header().Get("Retry-After"))
+
+		// Another IP, or an authenticated API key from the same IP, has its own bucket.
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.2:1234", "").Code)
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "ta_key").Code)
+	})
+
+	t.Run("Made Up Keys Share The IP Bucket", func(t *testing.T) {
+		engine := newEngine(ratelimit.NewMemoryLimiter(2, time.Minute))
+
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "made_up_1").Code)
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "made_up_2").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve(engine, "10.0.0.1:1234", "made_up_3").Code)
+	})
+
+	t.Run("Throttle Anonymous Admin Requests Before Authentication", func(t *testing.T) {
+		limiter := ratelimit.NewMemoryLimiter(2, time.Minute)
+		lookups := 0
+		engine := gin.New()
+		_ = engine.SetTrustedProxies(nil)
+		engine.GET("/api/tasks", rateLimit(limiter), func(c *gin.Context) {
+			lookups++
+			if c.GetHeader(apiKeyHeader) != "ta_key" {
+				c.AbortWithStatus(http.StatusUnauthorized)
+				return
+			}
+			authenticate(c)
+		}, rateLimit(limiter), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "ta_key").Code)
+		assert.Equal(t, http.StatusUnauthorized, serve(engine, "10.0.0.1:1234", "made_up_1").Code)
+		// the key is never looked up once the IP is throttled
+		assert.Equal(t, http.StatusTooManyRequests, serve(engine, "10.0.0.1:1234", "made_up_2").Code)
+		assert.Equal(t, 2, lookups)
+	})
+
+	t.Run("Ignore X-Forwarded-For Of Untrusted Proxies", func(t *testing.T) {
+		engine := newEngine(ratelimit.NewMemoryLimiter(1, time.Minute))
+
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.1:1234", "").Code)
+		// the same forwarded IP from another peer isn't the same client
+		assert.Equal(t, http.StatusOK, serve(engine, "10.0.0.2:1234", "").Code)
+		assert.Equal(t, http.StatusTooManyRequests, serve(engine, "10.0.0.1:1234", "").Code)
+	})
+
+	t.Run("Let Through When Limiter Fails", func(t *testing.T) {
+		engine := newEngine(failingLimiter{})
+
+		testResponseWriter := serve(engine, "10.0.0.1:1234", "")
+		assert.Equal(t, http.StatusOK, testResponseWriter.Code)
+		assert.Empty(t, testResponseWriter.Header().Get("RateLimit-Limit"))
+	})
+}
@@ -2,16 +2,118 @@ package router
 
 import (
 	"github.com/gin-gonic/gin"
+	"log"
+	"trading-ace/src/config"
 	"trading-ace/src/controller"
+	"trading-ace/src/model"
 )
 
 func SetupRouter() *gin.Engine {
 	r := gin.Default()
+	if err := r.SetTrustedProxies(config.GetAppConfig().RateLimit.GetTrustedProxies()); err != nil {
+		log.Fatalf("Invalid trusted proxies: %v", err)
+	}
+
+	authController := controller.GetAuthControllerInstance()
+
+	// Public and private routes are limited per IP. Admin routes are limited
+	// per IP before the key is looked up, so guessing keys is throttled, and
+	// per API key once it is authenticated.
+	var rateLimitMiddlewares []gin.HandlerFunc
+	if limiter := newRateLimiter(config.GetAppConfig().RateLimit, config.GetAppConfig().Redis); limiter != nil {
+		rateLimitMiddlewares = append(rateLimitMiddlewares, rateLimit(limiter))
+	}
+
+	api := r.Group("/api")
+	apiRoutes := api.Group("", rateLimitMiddlewares...)
+	{
+		apiRoutes.GET("/tasks", authController.RequireQueriedAddressOwner, controller.GetTaskControllerInstance().SearchTasks)
+		apiRoutes.GET("/leaderboard", controller.GetLeaderboardControllerInstance().GetLeaderboard)
+		apiRoutes.GET("/auth/nonce", authController.GetNonce)
+		apiRoutes.POST("/auth/login", authController.Login)
+		apiRoutes.GET("/catalogue", controller.GetRedemptionControllerInstance().GetCatalogue)
+		apiRoutes.POST("/referrals/:address/link", controller.GetReferralControllerInstance().LinkReferral)
+	}
+
+	// Routes about an address require a session of that address.
+	privateRoutes := apiRoutes.Group("", authController.RequireAddressOwner)
+	{
+		privateRoutes.GET("/reward-history", controller.GetRewardControllerInstance().GetRewardHistoryOfUser)
+		privateRoutes.GET("/reward-projection", controller.GetRewardControllerInstance().GetRewardProjectionOfUser)
+		privateRoutes.GET("/users/:address", controller.GetUserControllerInstance().GetUserProfile)
+		privateRoutes.GET("/users/:address/expiring-points", controller.GetUserControllerInstance().GetExpiringPoints)
+		privateRoutes.GET("/users/:address/tiers", controller.GetUserControllerInstance().GetTierHistory)
+		privateRoutes.GET("/claims/:address", controller.GetClaimControllerInstance().GetClaimsOfAddress)
+		privateRoutes.POST("/claims/:address", controller.GetClaimControllerInstance().RequestClaim)
+		privateRoutes.GET("/vouchers/:address", controller.GetVoucherControllerInstance().GetVouchersOfAddress)
+		privateRoutes.POST("/vouchers/:address", controller.GetVoucherControllerInstance().IssueVoucher)
+		privateRoutes.GET("/redemptions/:address", controller.GetRedemptionControllerInstance().GetRedemptionsOfAddress)
+		privateRoutes.POST("/redemptions/:address", controller.GetRedemptionControllerInstance().RequestRedemption)
+		privateRoutes.GET("/referrals/:address", controller.GetReferralControllerInstance().GetReferralInfo)
+		privateRoutes.POST("/referrals/:address/code", controller.GetReferralControllerInstance().RegisterCode)
+	}
+
+	adminAuth := newAdminAuthenticator()
+	adminRoutes := api.Group("/admin", rateLimitMiddlewares...)
+	adminRoutes.Use(adminAuth.authenticate)
+	adminRoutes.Use(rateLimitMiddlewares...)
+	adminRoutes.Use(adminAuth.audit)
+
+	campaignController := controller.GetCampaignControllerInstance()
+	multiplierController := controller.GetMultiplierControllerInstance()
+	campaignReadRoutes := adminRoutes.Group("", adminAuth.require(model.APIKeyScopeCampaigns, model.RoleViewer))
+	{
+		campaignReadRoutes.GET("/campaigns", campaignController.SearchCampaigns)
+		campaignReadRoutes.GET("/campaigns/:id", campaignController.GetCampaign)
+		campaignReadRoutes.GET("/multipliers", multiplierController.SearchMultipliers)
+	}
+
+	campaignWriteRoutes := adminRoutes.Group("", adminAuth.require(model.APIKeyScopeCampaigns, model.RoleOperator))
+	{
+		campaignWriteRoutes.POST("/campaigns", campaignController.CreateCampaign)
+		campaignWriteRoutes.PUT("/campaigns/:id", campaignController.UpdateCampaign)
+		campaignWriteRoutes.POST("/campaigns/:id/pause", campaignController.PauseCampaign)
+		campaignWriteRoutes.POST("/campaigns/:id/resume", campaignController.ResumeCampaign)
+		campaignWriteRoutes.POST("/campaigns/:id/archive", campaignController.ArchiveCampaign)
+		campaignWriteRoutes.POST("/multipliers", multiplierController.CreateMultiplier)
+		campaignWriteRoutes.DELETE("/multipliers/:id", multiplierController.DeleteMultiplier)
+	}
+
+	settlementController := controller.GetSettlementControllerInstance()
+	adminRoutes.GET("/campaigns/:id/periods/:period/settlement",
+		adminAuth.require(model.APIKeyScopeSettlements, model.RoleViewer), settlementController.PreviewSettlement)
+	adminRoutes.POST("/campaigns/:id/periods/:period/settlement",
+		adminAuth.require(model.APIKeyScopeSettlements, model.RoleOperator), settlementController.ExecuteSettlement)
+
+	adjustmentController := controller.GetAdjustmentControllerInstance()
+	adminRoutes.GET("/users/:address/adjustments",
+		adminAuth.require(model.APIKeyScopeAdjustments, model.RoleViewer), adjustmentController.GetAdjustments)
+	adminRoutes.POST("/users/:address/adjustments",
+		adminAuth.require(model.APIKeyScopeAdjustments, model.RoleOperator), adjustmentController.AdjustPoints)
+
+	ledgerController := controller.GetLedgerControllerInstance()
+	adminRoutes.GET("/ledger/mismatches",
+		adminAuth.require(model.APIKeyScopeAdjustments, model.RoleViewer), ledgerController.GetBalanceMismatches)
+
+	adminRoutes.GET("/tasks/export",
+		adminAuth.auditRead, adminAuth.require(model.APIKeyScopeExports, model.RoleViewer), controller.GetTaskControllerInstance().ExportTasks)
+	adminRoutes.GET("/reward-records/export",
+		adminAuth.auditRead, adminAuth.require(model.APIKeyScopeExports, model.RoleViewer), controller.GetRewardControllerInstance().ExportRewardRecords)
+
+	redemptionController := controller.GetRedemptionControllerInstance()
+	adminRoutes.GET("/redemptions",
+		adminAuth.require(model.APIKeyScopeRedemptions, model.RoleViewer), redemptionController.SearchRedemptions)
+	adminRoutes.POST("/redemptions/:id/fulfill",
+		adminAuth.require(model.APIKeyScopeRedemptions, model.RoleOperator), redemptionController.FulfillRedemption)
+	adminRoutes.POST("/redemptions/:id/cancel",
+		adminAuth.require(model.APIKeyScopeRedemptions, model.RoleOperator), redemptionController.CancelRedemption)
 
-	apiRoutes := r.Group("/api")
+	apiKeyController := controller.GetAPIKeyControllerInstance()
+	apiKeyRoutes := adminRoutes.Group("/api-keys", adminAuth.require(model.APIKeyScopeAPIKeys, model.RoleAdmin))
 	{
-		apiRoutes.GET("/tasks", controller.GetTaskControllerInstance().SearchTasks)
-		apiRoutes.GET("/reward-history", controller.GetRewardControllerInstance().GetRewardHistoryOfUser)
+		apiKeyRoutes.GET("", apiKeyController.SearchAPIKeys)
+		apiKeyRoutes.POST("", apiKeyController.CreateAPIKey)
+		apiKeyRoutes.DELETE("/:id", apiKeyController.RevokeAPIKey)
 	}
 
 	return r
@@ -1,24 +1,78 @@
 package scheduler
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"github.com/go-co-op/gocron/v2"
+	"log"
 	"time"
 )
 
-type CampaignCallback func(start time.Time, end time.Time) error
-
-func CreateCampaignJobs(s gocron.Scheduler, startTime time.Time, weeks int, callback CampaignCallback) {
-	weekDuration := time.Hour * 24 * 7
-	for i := 0; i < weeks; i++ {
-		start := startTime.Add(weekDuration * time.Duration(i))
-		end := start.Add(weekDuration)
-
-		_, _ = s.NewJob(
-			gocron.OneTimeJob(
-				gocron.OneTimeJobStartDateTime(end),
-			),
-			gocron.NewTask(callback, start, end),
-			gocron.WithName("campaign job"),
-		)
+const (
+	settlementJobName       = "campaign settlement job"
+	settlementSweepInterval = time.Minute
+)
+
+// SettlementLockKey is held by whoever settles periods, the scheduled sweep and
+// manual settlements alike.
+const SettlementLockKey = settlementJobName
+
+type SettlementCallback func(ctx context.Context, now time.Time) error
+
+// CreateCampaignJobs sweeps for finished campaign periods every minute. Campaigns
+// live in the database and change at runtime, so due periods are looked up on
+// each run instead of being scheduled upfront.
+func CreateCampaignJobs(s gocron.Scheduler, locker Locker, callback SettlementCallback) error {
+	_, err := s.NewJob(
+		gocron.DurationJob(settlementSweepInterval),
+		gocron.NewTask(runWithLock, locker, SettlementLockKey, func(ctx context.Context) error {
+			return callback(ctx, time.Now().UTC())
+		}),
+		gocron.WithName(settlementJobName),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	return err
+}
+
+// runWithLock runs fn on the replica holding the lock and skips it elsewhere.
+// The sweep runs again a minute later, so a replica taking over from a holder
+// that died midway picks up whatever was left unsettled.
+func runWithLock(locker Locker, key string, fn func(ctx context.Context) error) error {
+	lock, err := locker.TryLock(context.Background(), key)
+
+	if errors.Is(err, ErrLockHeld) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to acquire lock %s: %w", key, err)
 	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		select {
+		case <-lock.Lost():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	err = fn(ctx)
+
+	unlockErr := lock.Unlock()
+	if errors.Is(unlockErr, ErrLockLost) {
+		err = fmt.Errorf("%w while running %s: %v", ErrLockLost, key, err)
+	} else if unlockErr != nil {
+		log.Printf("Failed to release lock %s: %v", key, unlockErr)
+	}
+
+	if err != nil {
+		log.Printf("Job %s failed: %v", key, err)
+	}
+
+	return err
 }
@@ -0,0 +1,89 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type fakeLock struct {
+	lost      chan struct{}
+	unlockErr error
+	unlocked  bool
+}
+
+func (l *fakeLock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+func (l *fakeLock) Unlock() error {
+	l.unlocked = true
+	return l.unlockErr
+}
+
+type fakeLocker struct {
+	lock *fakeLock
+	err  error
+	keys []string
+}
+
+func (l *fakeLocker) TryLock(_ context.Context, key string) (Lock, error) {
+	l.keys = append(l.keys, key)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return l.lock, nil
+}
+
+func TestRunWithLock(t *testing.T) {
+	t.Run("Run while holding lock", func(t *testing.T) {
+		locker := &fakeLocker{lock: &fakeLock{lost: make(chan struct{})}}
+
+		called := false
+		err := runWithLock(locker, "test_job", func(ctx context.Context) error {
+			called = true
+			return nil
+		})
+
+		assert.Nil(t, err)
+		assert.True(t, called)
+		assert.True(t, locker.lock.unlocked)
+		assert.Equal(t, []string{"test_job"}, locker.keys)
+	})
+
+	t.Run("Skip when lock is held by another instance", func(t *testing.T) {
+		locker := &fakeLocker{err: ErrLockHeld}
+
+		err := runWithLock(locker, "test_job", func(ctx context.Context) error {
+			t.Errorf("job should not run")
+			return nil
+		})
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("Fail when lock cannot be acquired", func(t *testing.T) {
+		locker := &fakeLocker{err: assert.AnError}
+
+		err := runWithLock(locker, "test_job", func(ctx context.Context) error {
+			t.Errorf("job should not run")
+			return nil
+		})
+
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Cancel when lock is lost", func(t *testing.T) {
+		lock := &fakeLock{lost: make(chan struct{}), unlockErr: ErrLockLost}
+		locker := &fakeLocker{lock: lock}
+
+		err := runWithLock(locker, "test_job", func(ctx context.Context) error {
+			close(lock.lost)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		assert.ErrorIs(t, err, ErrLockLost)
+		assert.True(t, lock.unlocked)
+	})
+}
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/go-co-op/gocron/v2"
+	"time"
+)
+
+const (
+	reconciliationJobName  = "ledger reconciliation job"
+	reconciliationInterval = time.Hour
+	expiryJobName          = "point expiry job"
+	expiryInterval         = time.Hour
+)
+
+type ReconciliationCallback func(now time.Time) error
+
+type ExpiryCallback func(ctx context.Context, now time.Time) error
+
+// CreateLedgerJobs compares the cached balances with the ledger every hour, on
+// a single replica at a time.
+func CreateLedgerJobs(s gocron.Scheduler, locker Locker, callback ReconciliationCallback) error {
+	_, err := s.NewJob(
+		gocron.DurationJob(reconciliationInterval),
+		gocron.NewTask(runWithLock, locker, reconciliationJobName, func(ctx context.Context) error {
+			return callback(time.Now().UTC())
+		}),
+		gocron.WithName(reconciliationJobName),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	return err
+}
+
+// CreateExpiryJobs writes the expiry debits of the points expired since the
+// last run, every hour.
+func CreateExpiryJobs(s gocron.Scheduler, locker Locker, callback ExpiryCallback) error {
+	_, err := s.NewJob(
+		gocron.DurationJob(expiryInterval),
+		gocron.NewTask(runWithLock, locker, expiryJobName, func(ctx context.Context) error {
+			return callback(ctx, time.Now().UTC())
+		}),
+		gocron.WithName(expiryJobName),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	return err
+}
@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"hash/fnv"
+	"io"
+	"log"
+	"sync"
+	"time"
+	"trading-ace/src/database"
+)
+
+const lockHeartbeatInterval = 5 * time.Second
+
+// lockHeartbeatMaxFailures is how many heartbeats in a row may fail before the
+// lock is considered lost, so a transient error doesn't give it up.
+const lockHeartbeatMaxFailures = 3
+
+var (
+	ErrLockLost = errors.New("distributed lock lost")
+	ErrLockHeld = errors.New("distributed lock held by another instance")
+)
+
+// Lock is a held distributed lock. Lost is closed as soon as the holder can no
+// longer prove it still owns the lock, e.g. when its database session drops.
+type Lock interface {
+	Lost() <-chan struct{}
+	Unlock() error
+}
+
+type Locker interface {
+	// TryLock acquires the lock for key without waiting, it returns ErrLockHeld
+	// when another instance already holds it.
+	TryLock(ctx context.Context, key string) (Lock, error)
+}
+
+type postgresAdvisoryLocker struct {
+	dbInstance        *sql.DB
+	heartbeatInterval time.Duration
+}
+
+// NewPostgresAdvisoryLocker returns a Locker backed by session level Postgres
+// advisory locks, so every replica sharing the database agrees on one holder.
+func NewPostgresAdvisoryLocker() Locker {
+	return &postgresAdvisoryLocker{
+		dbInstance:        database.GetDBInstance(),
+		heartbeatInterval: lockHeartbeatInterval,
+	}
+}
+
+func (l *postgresAdvisoryLocker) TryLock(ctx context.Context, key string) (Lock, error) {
+	// advisory locks belong to the session, so the lock must keep its own connection
+	conn, err := l.dbInstance.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	lockID := advisoryLockID(key)
+
+	var acquired bool
+	err = conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockID).Scan(&acquired)
+	if err != nil || !acquired {
+		_ = conn.Close()
+		if err == nil {
+			err = ErrLockHeld
+		}
+		return nil, err
+	}
+
+	lock := &postgresAdvisoryLock{
+		key:    key,
+		lockID: lockID,
+		conn:   conn,
+		lost:   make(chan struct{}),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	go lock.heartbeat(l.heartbeatInterval)
+
+	return lock, nil
+}
+
+type postgresAdvisoryLock struct {
+	key      string
+	lockID   int64
+	conn     *sql.Conn
+	lost     chan struct{}
+	lostOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func (l *postgresAdvisoryLock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Unlock releases the lock even once it is considered lost, as the session may
+// still hold it. When it can't be released the connection is discarded rather
+// than returned to the pool, closing the session releases the lock.
+func (l *postgresAdvisoryLock) Unlock() error {
+	close(l.stop)
+	<-l.done
+	defer l.conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), lockHeartbeatInterval)
+	defer cancel()
+
+	if _, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.lockID); err != nil {
+		l.discardConn()
+		return err
+	}
+
+	select {
+	case <-l.lost:
+		return ErrLockLost
+	default:
+		return nil
+	}
+}
+
+// discardConn closes the session of the lock and keeps the pool from reusing
+// its connection.
+func (l *postgresAdvisoryLock) discardConn() {
+	_ = l.conn.Raw(func(driverConn any) error {
+		if closer, ok := driverConn.(io.Closer); ok {
+			_ = closer.Close()
+		}
+		return driver.ErrBadConn
+	})
+}
+
+func (l *postgresAdvisoryLock) heartbeat(interval time.Duration) {
+	defer close(l.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	failures := 0
+	for {
+		select {
+		case <-l.stop:
+			return
+		case <-ticker.C:
+			held, err := l.isHeld()
+			if err == nil && held {
+				failures = 0
+				continue
+			}
+
+			if err != nil {
+				failures++
+				if failures < lockHeartbeatMaxFailures {
+					log.Printf("Failed to check lock %s (%d in a row): %v", l.key, failures, err)
+					continue
+				}
+			}
+
+			log.Printf("Lock %s lost", l.key)
+			l.lostOnce.Do(func() { close(l.lost) })
+			return
+		}
+	}
+}
+
+func (l *postgresAdvisoryLock) isHeld() (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), lockHeartbeatInterval)
+	defer cancel()
+
+	var held bool
+	err := l.conn.QueryRowContext(ctx, `SELECT EXISTS (
+		SELECT 1 FROM pg_locks
+		WHERE locktype = 'advisory'
+		  AND pid = pg_backend_pid()
+		  AND granted
+		  AND ((classid::bigint << 32) | objid::bigint) = $1
+	)`, l.lockID).Scan(&held)
+
+	return held, err
+}
+
+// advisoryLockID maps a lock key onto the non-negative bigint space, so it can
+// be matched against the classid/objid pair Postgres reports in pg_locks.
+func advisoryLockID(key string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return int64(h.Sum64() >> 1)
+}
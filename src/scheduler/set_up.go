@@ -3,7 +3,7 @@ package scheduler
 import (
 	"github.com/go-co-op/gocron/v2"
 	"log"
-	"trading-ace/src/config"
+	"time"
 	"trading-ace/src/service"
 )
 
@@ -14,10 +14,28 @@ func SetUpScheduler() (gocron.Scheduler, error) {
 		return nil, err
 	}
 
-	campaignConfig := config.GetAppConfig().Campaign
+	err = CreateCampaignJobs(sch, NewPostgresAdvisoryLocker(), service.NewSettlementService().SettleDuePeriods)
+	if err != nil {
+		return nil, err
+	}
+
+	ledgerService := service.NewLedgerService()
+	err = CreateLedgerJobs(sch, NewPostgresAdvisoryLocker(), func(now time.Time) error {
+		_, err := ledgerService.ReconcileBalances(now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	if campaignConfig != nil {
-		CreateCampaignJobs(sch, campaignConfig.GetCampaignStartTime(), campaignConfig.Weeks, service.NewUniSwapService().ProcessSharedPool)
+	err = CreateExpiryJobs(sch, NewPostgresAdvisoryLocker(), service.NewExpiryService().ExpirePoints)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CreateTierJobs(sch, NewPostgresAdvisoryLocker(), service.NewTierService().RefreshTiers)
+	if err != nil {
+		return nil, err
 	}
 
 	sch.Start()
@@ -0,0 +1,29 @@
+package scheduler
+
+import (
+	"context"
+	"github.com/go-co-op/gocron/v2"
+	"time"
+)
+
+const (
+	tierJobName  = "tier refresh job"
+	tierInterval = time.Hour
+)
+
+type TierRefreshCallback func(ctx context.Context, now time.Time) error
+
+// CreateTierJobs drops every hour the users whose rolling volume fell below
+// their tier, the tier of a user who swaps is refreshed right away.
+func CreateTierJobs(s gocron.Scheduler, locker Locker, callback TierRefreshCallback) error {
+	_, err := s.NewJob(
+		gocron.DurationJob(tierInterval),
+		gocron.NewTask(runWithLock, locker, tierJobName, func(ctx context.Context) error {
+			return callback(ctx, time.Now().UTC())
+		}),
+		gocron.WithName(tierJobName),
+		gocron.WithSingletonMode(gocron.LimitModeReschedule),
+	)
+
+	return err
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"math"
+	"strings"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type AdjustmentService interface {
+	AdjustPoints(adjustment *model.PointAdjustment) (*model.RewardRecord, error)
+	GetAdjustments(userID string) ([]*model.RewardRecord, error)
+}
+
+type adjustmentServiceImpl struct {
+	rewardRecordRepository repository.RewardRecordRepository
+	campaignService        CampaignService
+}
+
+func NewAdjustmentService() AdjustmentService {
+	return &adjustmentServiceImpl{
+		rewardRecordRepository: repository.NewRewardRecordRepository(),
+		campaignService:        NewCampaignService(),
+	}
+}
+
+// AdjustPoints credits or debits the user on behalf of an operator. It adds
+// an adjustment record, earlier records are never changed, together with its
+// audit log.
+func (s *adjustmentServiceImpl) AdjustPoints(adjustment *model.PointAdjustment) (*model.RewardRecord, error) {
+	switch {
+	case !common.IsHexAddress(adjustment.UserID):
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, adjustment.UserID)
+	case adjustment.Direction != model.AdjustmentDirectionCredit && adjustment.Direction != model.AdjustmentDirectionDebit:
+		return nil, fmt.Errorf("%w: direction should be credit or debit", exception.InvalidAdjustmentError)
+	case adjustment.Points <= 0 || math.IsInf(adjustment.Points, 0):
+		return nil, fmt.Errorf("%w: points should be greater than 0", exception.InvalidAdjustmentError)
+	case strings.TrimSpace(adjustment.Reason) == "":
+		return nil, fmt.Errorf("%w: reason is required", exception.InvalidAdjustmentError)
+	case strings.TrimSpace(adjustment.Operator) == "":
+		return nil, fmt.Errorf("%w: operator is required", exception.InvalidAdjustmentError)
+	}
+
+	if adjustment.CampaignID != 0 {
+		if _, err := s.campaignService.GetCampaign(adjustment.CampaignID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.rewardRecordRepository.CreateAdjustment(&model.RewardRecord{
+		UserID:     common.HexToAddress(adjustment.UserID).Hex(),
+		CampaignID: adjustment.CampaignID,
+		Points:     adjustment.SignedPoints(),
+		Reason:     strings.TrimSpace(adjustment.Reason),
+		Operator:   adjustment.Operator,
+		CreatedAt:  time.Now().UTC(),
+	}, func(record *model.RewardRecord) *model.AuditLog {
+		return model.NewAuditLog(adjustment.Operator, model.AuditActionAdjustPoints, "user:"+record.UserID, map[string]any{
+			"reward_record_id": record.ID,
+			"campaign_id":      record.CampaignID,
+			"points":           record.Points,
+			"reason":           record.Reason,
+		})
+	})
+}
+
+func (s *adjustmentServiceImpl) GetAdjustments(userID string) ([]*model.RewardRecord, error) {
+	if !common.IsHexAddress(userID) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, userID)
+	}
+
+	return s.rewardRecordRepository.SearchRewardRecords(&repository.RewardRecordSearchCondition{
+		UserID: common.HexToAddress(userID).Hex(),
+		Types:  []model.RewardRecordType{model.RewardRecordTypeAdjustment},
+	})
+}
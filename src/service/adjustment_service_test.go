@@ -0,0 +1,151 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	realRepo "trading-ace/src/repository"
+)
+
+const testAdjustmentAddress = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+type adjustmentServiceTestSuite struct {
+	adjustmentService            AdjustmentService
+	mockedRewardRecordRepository *repository.MockRewardRecordRepository
+	mockedCampaignService        *service.MockCampaignService
+}
+
+func (s *adjustmentServiceTestSuite) setUp(t *testing.T) {
+	s.mockedRewardRecordRepository = repository.NewMockRewardRecordRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.adjustmentService = &adjustmentServiceImpl{
+		rewardRecordRepository: s.mockedRewardRecordRepository,
+		campaignService:        s.mockedCampaignService,
+	}
+}
+
+func TestAdjustmentServiceImpl_AdjustPoints(t *testing.T) {
+	testSuite := &adjustmentServiceTestSuite{}
+
+	t.Run("Debit", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(&model.Campaign{ID: 1}, nil).Times(1)
+		testSuite.mockedRewardRecordRepository.EXPECT().CreateAdjustment(mock.MatchedBy(func(record *model.RewardRecord) bool {
+			return record.UserID == testAdjustmentAddress && record.CampaignID == 1 && record.Points == -40 &&
+				record.Reason == "double credit" && record.Operator == "ops" && record.TaskID == 0
+		}), mock.Anything).RunAndReturn(func(record *model.RewardRecord, audit func(*model.RewardRecord) *model.AuditLog) (*model.RewardRecord, error) {
+			record.ID = 9
+			record.Type = model.RewardRecordTypeAdjustment
+
+			auditLog := audit(record)
+			assert.Equal(t, "ops", auditLog.Operator)
+			assert.Equal(t, model.AuditActionAdjustPoints, auditLog.Action)
+			assert.Equal(t, "user:"+testAdjustmentAddress, auditLog.Target)
+			assert.Equal(t, map[string]any{
+				"reward_record_id": 9,
+				"campaign_id":      1,
+				"points":           -40.0,
+				"reason":           "double credit",
+			}, auditLog.Detail)
+			return record, nil
+		}).Times(1)
+
+		record, err := testSuite.adjustmentService.AdjustPoints(&model.PointAdjustment{
+			UserID:     strings.ToLower(testAdjustmentAddress),
+			CampaignID: 1,
+			Direction:  model.AdjustmentDirectionDebit,
+			Points:     40,
+			Reason:     " double credit ",
+			Operator:   "ops",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 9, record.ID)
+	})
+
+	t.Run("Insufficient Points", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRewardRecordRepository.EXPECT().CreateAdjustment(mock.Anything, mock.Anything).Return(nil, exception.InsufficientPointsError).Times(1)
+
+		_, err := testSuite.adjustmentService.AdjustPoints(&model.PointAdjustment{
+			UserID:    testAdjustmentAddress,
+			Direction: model.AdjustmentDirectionDebit,
+			Points:    1000,
+			Reason:    "wash trading",
+			Operator:  "ops",
+		})
+		assert.ErrorIs(t, err, exception.InsufficientPointsError)
+	})
+
+	t.Run("Unknown Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(9).Return(nil, exception.CampaignNotFoundError).Times(1)
+
+		_, err := testSuite.adjustmentService.AdjustPoints(&model.PointAdjustment{
+			UserID:     testAdjustmentAddress,
+			CampaignID: 9,
+			Direction:  model.AdjustmentDirectionCredit,
+			Points:     10,
+			Reason:     "goodwill",
+			Operator:   "ops",
+		})
+		assert.ErrorIs(t, err, exception.CampaignNotFoundError)
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.adjustmentService.AdjustPoints(&model.PointAdjustment{
+			UserID:    "test_user_id",
+			Direction: model.AdjustmentDirectionCredit,
+			Points:    10,
+			Reason:    "goodwill",
+			Operator:  "ops",
+		})
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+
+	for name, adjustment := range map[string]*model.PointAdjustment{
+		"Unknown Direction": {UserID: testAdjustmentAddress, Direction: "refund", Points: 10, Reason: "goodwill", Operator: "ops"},
+		"Negative Points":   {UserID: testAdjustmentAddress, Direction: model.AdjustmentDirectionDebit, Points: -10, Reason: "goodwill", Operator: "ops"},
+		"Without Reason":    {UserID: testAdjustmentAddress, Direction: model.AdjustmentDirectionCredit, Points: 10, Reason: " ", Operator: "ops"},
+		"Without Operator":  {UserID: testAdjustmentAddress, Direction: model.AdjustmentDirectionCredit, Points: 10, Reason: "goodwill"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testSuite.setUp(t)
+
+			_, err := testSuite.adjustmentService.AdjustPoints(adjustment)
+			assert.ErrorIs(t, err, exception.InvalidAdjustmentError)
+		})
+	}
+}
+
+func TestAdjustmentServiceImpl_GetAdjustments(t *testing.T) {
+	testSuite := &adjustmentServiceTestSuite{}
+
+	t.Run("Normalize Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRewardRecordRepository.EXPECT().SearchRewardRecords(&realRepo.RewardRecordSearchCondition{
+			UserID: testAdjustmentAddress,
+			Types:  []model.RewardRecordType{model.RewardRecordTypeAdjustment},
+		}).Return(nil, nil).Times(1)
+
+		_, err := testSuite.adjustmentService.GetAdjustments(strings.ToLower(testAdjustmentAddress))
+		assert.NoError(t, err)
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.adjustmentService.GetAdjustments("test_user_id")
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+const (
+	apiKeyPrefix = "ta_"
+	// apiKeyDisplayLength is the length of the start of the key kept to tell keys apart.
+	apiKeyDisplayLength = 10
+)
+
+type APIKeyService interface {
+	CreateAPIKey(name string, role model.Role, scopes []model.APIKeyScope) (*model.APIKey, error)
+	Authenticate(key string) (*model.APIKey, error)
+	SearchAPIKeys() ([]*model.APIKey, error)
+	RevokeAPIKey(id int) error
+}
+
+type apiKeyServiceImpl struct {
+	apiKeyRepository repository.APIKeyRepository
+}
+
+func NewAPIKeyService() APIKeyService {
+	return &apiKeyServiceImpl{
+		apiKeyRepository: repository.NewAPIKeyRepository(),
+	}
+}
+
+// CreateAPIKey generates a random key and stores its hash. The returned key
+// is the only time the plain key is available.
+func (s *apiKeyServiceImpl) CreateAPIKey(name string, role model.Role, scopes []model.APIKeyScope) (*model.APIKey, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", exception.InvalidAPIKeyError)
+	}
+
+	if !role.IsValid() {
+		return nil, fmt.Errorf("%w: unknown role %s", exception.InvalidAPIKeyError, role)
+	}
+
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", exception.InvalidAPIKeyError)
+	}
+
+	for _, scope := range scopes {
+		if !scope.IsValid() {
+			return nil, fmt.Errorf("%w: unknown scope %s", exception.InvalidAPIKeyError, scope)
+		}
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+
+	key := apiKeyPrefix + hex.EncodeToString(secret)
+	apiKey, err := s.apiKeyRepository.CreateAPIKey(&model.APIKey{
+		Name:      name,
+		Prefix:    key[:apiKeyDisplayLength],
+		KeyHash:   hashAPIKey(key),
+		Role:      role,
+		Scopes:    scopes,
+		CreatedAt: time.Now().UTC(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey.Key = key
+	return apiKey, nil
+}
+
+// Authenticate returns the active key matching the plain key.
+func (s *apiKeyServiceImpl) Authenticate(key string) (*model.APIKey, error) {
+	return s.apiKeyRepository.GetActiveAPIKeyByHash(hashAPIKey(key))
+}
+
+func (s *apiKeyServiceImpl) SearchAPIKeys() ([]*model.APIKey, error) {
+	return s.apiKeyRepository.SearchAPIKeys()
+}
+
+func (s *apiKeyServiceImpl) RevokeAPIKey(id int) error {
+	return s.apiKeyRepository.RevokeAPIKey(id, time.Now().UTC())
+}
+
+// hashAPIKey is a plain SHA-256, keys are random enough not to need a slow hash.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"trading-ace/mock/repository"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type apiKeyServiceTestSuite struct {
+	apiKeyService          APIKeyService
+	mockedAPIKeyRepository *repository.MockAPIKeyRepository
+}
+
+func (s *apiKeyServiceTestSuite) setUp(t *testing.T) {
+	s.mockedAPIKeyRepository = repository.NewMockAPIKeyRepository(t)
+	s.apiKeyService = &apiKeyServiceImpl{
+		apiKeyRepository: s.mockedAPIKeyRepository,
+	}
+}
+
+func TestAPIKeyServiceImpl_CreateAPIKey(t *testing.T) {
+	testSuite := &apiKeyServiceTestSuite{}
+
+	t.Run("Store Hash Only", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		var stored *model.APIKey
+		testSuite.mockedAPIKeyRepository.EXPECT().CreateAPIKey(mock.Anything).
+			RunAndReturn(func(apiKey *model.APIKey) (*model.APIKey, error) {
+				stored = apiKey
+				apiKey.ID = 1
+				return apiKey, nil
+			}).Times(1)
+
+		apiKey, err := testSuite.apiKeyService.CreateAPIKey("ops", model.RoleOperator, []model.APIKeyScope{model.APIKeyScopeCampaigns})
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(apiKey.Key, "ta_"))
+		assert.Equal(t, 67, len(apiKey.Key))
+		assert.Equal(t, apiKey.Key[:10], stored.Prefix)
+		assert.Equal(t, hashAPIKey(apiKey.Key), stored.KeyHash)
+		assert.NotContains(t, stored.KeyHash, apiKey.Key[3:])
+	})
+
+	for name, testCase := range map[string]struct {
+		name   string
+		role   model.Role
+		scopes []model.APIKeyScope
+	}{
+		"Without Name":  {"", model.RoleAdmin, []model.APIKeyScope{model.APIKeyScopeAll}},
+		"Unknown Role":  {"ops", "root", []model.APIKeyScope{model.APIKeyScopeAll}},
+		"No Scope":      {"ops", model.RoleAdmin, nil},
+		"Unknown Scope": {"ops", model.RoleAdmin, []model.APIKeyScope{"users"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			testSuite.setUp(t)
+
+			_, err := testSuite.apiKeyService.CreateAPIKey(testCase.name, testCase.role, testCase.scopes)
+			assert.ErrorIs(t, err, exception.InvalidAPIKeyError)
+		})
+	}
+}
+
+func TestAPIKeyServiceImpl_Authenticate(t *testing.T) {
+	testSuite := &apiKeyServiceTestSuite{}
+	testSuite.setUp(t)
+
+	testSuite.mockedAPIKeyRepository.EXPECT().GetActiveAPIKeyByHash(hashAPIKey("ta_key")).
+		Return(&model.APIKey{ID: 1, Name: "ops"}, nil).Times(1)
+
+	apiKey, err := testSuite.apiKeyService.Authenticate("ta_key")
+	assert.NoError(t, err)
+	assert.Equal(t, "ops", apiKey.Name)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type AuditService interface {
+	Record(operator string, action model.AuditAction, target string, detail map[string]any) error
+}
+
+type auditServiceImpl struct {
+	auditLogRepository repository.AuditLogRepository
+}
+
+func NewAuditService() AuditService {
+	return &auditServiceImpl{
+		auditLogRepository: repository.NewAuditLogRepository(),
+	}
+}
+
+func (s *auditServiceImpl) Record(operator string, action model.AuditAction, target string, detail map[string]any) error {
+	_, err := s.auditLogRepository.CreateAuditLog(model.NewAuditLog(operator, action, target, detail))
+	return err
+}
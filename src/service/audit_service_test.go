@@ -0,0 +1,24 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"trading-ace/mock/repository"
+	"trading-ace/src/model"
+)
+
+func TestAuditServiceImpl_Record(t *testing.T) {
+	mockedAuditLogRepository := repository.NewMockAuditLogRepository(t)
+	auditService := &auditServiceImpl{
+		auditLogRepository: mockedAuditLogRepository,
+	}
+
+	mockedAuditLogRepository.EXPECT().CreateAuditLog(mock.MatchedBy(func(auditLog *model.AuditLog) bool {
+		return auditLog.Operator == "ops" && auditLog.Action == model.AuditActionExecuteSettlement &&
+			auditLog.Target == "campaign:1:period:0" && !auditLog.CreatedAt.IsZero()
+	})).Return(&model.AuditLog{ID: 1}, nil).Times(1)
+
+	err := auditService.Record("ops", model.AuditActionExecuteSettlement, "campaign:1:period:0", nil)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"github.com/golang-jwt/jwt/v5"
+	"log"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+	"trading-ace/src/siwe"
+)
+
+// issuedAtSkew tolerates the clock of the wallet being ahead of ours.
+const issuedAtSkew = time.Minute
+
+type AuthService interface {
+	IssueNonce(now time.Time) (string, error)
+	Login(message string, signature string, now time.Time) (*model.Session, error)
+	Authenticate(token string, now time.Time) (string, error)
+}
+
+type authServiceImpl struct {
+	authNonceRepository repository.AuthNonceRepository
+	domain              string
+	chainID             int64
+	jwtSecret           []byte
+	sessionTTL          time.Duration
+	nonceTTL            time.Duration
+}
+
+func NewAuthService() AuthService {
+	authConfig := config.GetAppConfig().Auth
+
+	service := &authServiceImpl{
+		authNonceRepository: repository.NewAuthNonceRepository(),
+		sessionTTL:          authConfig.GetSessionTTL(),
+		nonceTTL:            authConfig.GetNonceTTL(),
+	}
+
+	if authConfig != nil {
+		service.domain = authConfig.Domain
+		service.chainID = authConfig.ChainID
+		service.jwtSecret = []byte(authConfig.JWTSecret)
+	}
+
+	if len(service.jwtSecret) == 0 {
+		// A random secret is only good enough for development, every replica
+		// would sign with its own.
+		if config.GetAppConfig().AppEnv != "development" {
+			log.Fatalf("No JWT secret configured, set auth.jwt_secret or AUTH_JWT_SECRET")
+		}
+
+		log.Printf("No JWT secret configured, sessions won't survive a restart")
+		service.jwtSecret = make([]byte, 32)
+		if _, err := rand.Read(service.jwtSecret); err != nil {
+			log.Fatalf("Failed to generate JWT secret: %v", err)
+		}
+	}
+
+	return service
+}
+
+// IssueNonce returns a random nonce to put in the SIWE message, valid for the
+// nonce TTL and usable once.
+func (s *authServiceImpl) IssueNonce(now time.Time) (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	nonce := hex.EncodeToString(bytes)
+	if err := s.authNonceRepository.CreateNonce(nonce, now.Add(s.nonceTTL)); err != nil {
+		return "", err
+	}
+
+	return nonce, nil
+}
+
+// Login verifies the SIWE message and its signature, consumes its nonce and
+// opens a session of the signing address.
+func (s *authServiceImpl) Login(message string, signature string, now time.Time) (*model.Session, error) {
+	parsed, err := siwe.ParseMessage(message)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", exception.AuthenticationError, err)
+	}
+
+	switch {
+	case s.domain != "" && parsed.Domain != s.domain:
+		return nil, fmt.Errorf("%w: message is for domain %s", exception.AuthenticationError, parsed.Domain)
+	case s.chainID != 0 && parsed.ChainID != s.chainID:
+		return nil, fmt.Errorf("%w: message is for chain %d", exception.AuthenticationError, parsed.ChainID)
+	case parsed.IssuedAt.After(now.Add(issuedAtSkew)) || !parsed.ValidAt(now):
+		return nil, fmt.Errorf("%w: message is expired or not valid yet", exception.AuthenticationError)
+	}
+
+	if err := siwe.VerifySignature(message, parsed, signature); err != nil {
+		return nil, fmt.Errorf("%w: %s", exception.AuthenticationError, err)
+	}
+
+	if err := s.authNonceRepository.ConsumeNonce(parsed.Nonce, now); err != nil {
+		return nil, err
+	}
+
+	expiresAt := now.Add(s.sessionTTL).Truncate(time.Second).UTC()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   parsed.Address.Hex(),
+		Issuer:    parsed.Domain,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}).SignedString(s.jwtSecret)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Session{
+		Address:   parsed.Address.Hex(),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Authenticate returns the address of the session token.
+func (s *authServiceImpl) Authenticate(token string, now time.Time) (string, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(func() time.Time { return now }),
+	}
+	if s.domain != "" {
+		options = append(options, jwt.WithIssuer(s.domain))
+	}
+
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return s.jwtSecret, nil
+	}, options...)
+
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", exception.UnauthenticatedError, err)
+	}
+
+	return claims.Subject, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/exception"
+)
+
+type authServiceTestSuite struct {
+	authService               AuthService
+	mockedAuthNonceRepository *repository.MockAuthNonceRepository
+}
+
+func (s *authServiceTestSuite) setUp(t *testing.T) {
+	s.mockedAuthNonceRepository = repository.NewMockAuthNonceRepository(t)
+	s.authService = &authServiceImpl{
+		authNonceRepository: s.mockedAuthNonceRepository,
+		domain:              "example.com",
+		chainID:             1,
+		jwtSecret:           []byte("test_secret"),
+		sessionTTL:          time.Hour,
+		nonceTTL:            10 * time.Minute,
+	}
+}
+
+func newSIWEMessage(domain string, chainID int64, issuedAt time.Time) string {
+	return fmt.Sprintf("%s wants you to sign in with your Ethereum account:\n"+
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\n"+
+		"Sign in to Trading Ace.\n\n"+
+		"URI: https://%s\nVersion: 1\nChain ID: %d\nNonce: 32891756abcdef01\nIssued At: %s\n"+
+		"Expiration Time: %s", domain, domain, chainID, issuedAt.Format(time.RFC3339), issuedAt.Add(5*time.Minute).Format(time.RFC3339))
+}
+
+func signSIWEMessage(message string) string {
+	key, _ := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	signature, _ := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	signature[64] += 27
+	return hexutil.Encode(signature)
+}
+
+func TestAuthServiceImpl_IssueNonce(t *testing.T) {
+	testSuite := &authServiceTestSuite{}
+	testSuite.setUp(t)
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+
+	var stored string
+	testSuite.mockedAuthNonceRepository.EXPECT().CreateNonce(mock.MatchedBy(func(nonce string) bool {
+		stored = nonce
+		return true
+	}), now.Add(10*time.Minute)).Return(nil).Times(1)
+
+	nonce, err := testSuite.authService.IssueNonce(now)
+	assert.NoError(t, err)
+	assert.Equal(t, stored, nonce)
+	assert.Regexp(t, "^[0-9a-f]{32}$", nonce)
+}
+
+func TestAuthServiceImpl_Login(t *testing.T) {
+	testSuite := &authServiceTestSuite{}
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Open Session", func(t *testing.T) {
+		testSuite.setUp(t)
+		message := newSIWEMessage("example.com", 1, now.Add(-time.Minute))
+
+		testSuite.mockedAuthNonceRepository.EXPECT().ConsumeNonce("32891756abcdef01", now).Return(nil).Times(1)
+
+		session, err := testSuite.authService.Login(message, signSIWEMessage(message), now)
+		assert.NoError(t, err)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", session.Address)
+		assert.Equal(t, now.Add(time.Hour), session.ExpiresAt)
+
+		address, err := testSuite.authService.Authenticate(session.Token, now.Add(30*time.Minute))
+		assert.NoError(t, err)
+		assert.Equal(t, session.Address, address)
+
+		_, err = testSuite.authService.Authenticate(session.Token, now.Add(2*time.Hour))
+		assert.ErrorIs(t, err, exception.UnauthenticatedError)
+	})
+
+	t.Run("Nonce Already Used", func(t *testing.T) {
+		testSuite.setUp(t)
+		message := newSIWEMessage("example.com", 1, now)
+
+		testSuite.mockedAuthNonceRepository.EXPECT().ConsumeNonce("32891756abcdef01", now).Return(exception.InvalidNonceError).Times(1)
+
+		_, err := testSuite.authService.Login(message, signSIWEMessage(message), now)
+		assert.ErrorIs(t, err, exception.InvalidNonceError)
+	})
+
+	for name, message := range map[string]string{
+		"Other Domain":     newSIWEMessage("evil.com", 1, now),
+		"Other Chain":      newSIWEMessage("example.com", 5, now),
+		"Expired":          newSIWEMessage("example.com", 1, now.Add(-time.Hour)),
+		"Issued In Future": newSIWEMessage("example.com", 1, now.Add(time.Hour)),
+		"Malformed":        "hello",
+	} {
+		t.Run(name, func(t *testing.T) {
+			testSuite.setUp(t)
+
+			_, err := testSuite.authService.Login(message, signSIWEMessage(message), now)
+			assert.ErrorIs(t, err, exception.AuthenticationError)
+		})
+	}
+
+	t.Run("Signed By Another Address", func(t *testing.T) {
+		testSuite.setUp(t)
+		message := newSIWEMessage("example.com", 1, now)
+
+		_, err := testSuite.authService.Login(message, signSIWEMessage(message+" "), now)
+		assert.ErrorIs(t, err, exception.AuthenticationError)
+	})
+}
+
+func TestAuthServiceImpl_Authenticate(t *testing.T) {
+	testSuite := &authServiceTestSuite{}
+	testSuite.setUp(t)
+
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+
+	_, err := testSuite.authService.Authenticate("not_a_token", now)
+	assert.ErrorIs(t, err, exception.UnauthenticatedError)
+
+	// A token signed with another secret.
+	token, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		Subject:   "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		Issuer:    "example.com",
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}).SignedString([]byte("other_secret"))
+
+	_, err = testSuite.authService.Authenticate(token, now)
+	assert.ErrorIs(t, err, exception.UnauthenticatedError)
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type CampaignService interface {
+	CreateCampaign(campaign *model.Campaign) (*model.Campaign, error)
+	UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error)
+	PauseCampaign(campaignID int) (*model.Campaign, error)
+	ResumeCampaign(campaignID int) (*model.Campaign, error)
+	ArchiveCampaign(campaignID int) (*model.Campaign, error)
+	GetCampaign(campaignID int) (*model.Campaign, error)
+	SearchCampaigns(statuses []model.CampaignStatus) ([]*model.Campaign, error)
+	GetRunningCampaigns(poolAddress string, at time.Time) ([]*model.Campaign, error)
+}
+
+type campaignServiceImpl struct {
+	campaignRepository repository.CampaignRepository
+}
+
+func NewCampaignService() CampaignService {
+	return &campaignServiceImpl{
+		campaignRepository: repository.NewCampaignRepository(),
+	}
+}
+
+func (s *campaignServiceImpl) CreateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	campaign.Status = model.CampaignStatusActive
+	campaign.CreatedAt = now
+	campaign.UpdatedAt = now
+
+	return s.campaignRepository.CreateCampaign(campaign)
+}
+
+// UpdateCampaign replaces the editable fields of a campaign. Once a campaign
+// has started its schedule is frozen, otherwise already settled periods would
+// no longer line up with the stored tasks.
+func (s *campaignServiceImpl) UpdateCampaign(campaign *model.Campaign) (*model.Campaign, error) {
+	if err := validateCampaign(campaign); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.campaignRepository.GetCampaignByID(campaign.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing.Status == model.CampaignStatusArchived {
+		return nil, fmt.Errorf("%w: archived campaign cannot be updated", exception.InvalidCampaignError)
+	}
+
+	if !time.Now().UTC().Before(existing.StartTime) {
+		if !campaign.StartTime.Equal(existing.StartTime) || campaign.PeriodType != existing.PeriodType ||
+			campaign.PeriodCron != existing.PeriodCron || campaign.Timezone != existing.Timezone {
+			return nil, fmt.Errorf("%w: schedule cannot be changed after the campaign started", exception.InvalidCampaignError)
+		}
+	}
+
+	campaign.Status = existing.Status
+	campaign.CreatedAt = existing.CreatedAt
+
+	return s.campaignRepository.UpdateCampaign(campaign)
+}
+
+func (s *campaignServiceImpl) PauseCampaign(campaignID int) (*model.Campaign, error) {
+	return s.transitCampaign(campaignID, model.CampaignStatusPaused, model.CampaignStatusActive)
+}
+
+func (s *campaignServiceImpl) ResumeCampaign(campaignID int) (*model.Campaign, error) {
+	return s.transitCampaign(campaignID, model.CampaignStatusActive, model.CampaignStatusPaused)
+}
+
+func (s *campaignServiceImpl) ArchiveCampaign(campaignID int) (*model.Campaign, error) {
+	return s.transitCampaign(campaignID, model.CampaignStatusArchived, model.CampaignStatusActive, model.CampaignStatusPaused)
+}
+
+func (s *campaignServiceImpl) GetCampaign(campaignID int) (*model.Campaign, error) {
+	return s.campaignRepository.GetCampaignByID(campaignID)
+}
+
+func (s *campaignServiceImpl) SearchCampaigns(statuses []model.CampaignStatus) ([]*model.Campaign, error) {
+	return s.campaignRepository.SearchCampaigns(&repository.SearchCampaignsCondition{
+		Statuses: statuses,
+	})
+}
+
+func (s *campaignServiceImpl) GetRunningCampaigns(poolAddress string, at time.Time) ([]*model.Campaign, error) {
+	campaigns, err := s.SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	var runningCampaigns []*model.Campaign
+	for _, campaign := range campaigns {
+		if campaign.HasPool(poolAddress) && campaign.IsRunningAt(at) {
+			runningCampaigns = append(runningCampaigns, campaign)
+		}
+	}
+
+	return runningCampaigns, nil
+}
+
+func (s *campaignServiceImpl) transitCampaign(campaignID int, to model.CampaignStatus, from ...model.CampaignStatus) (*model.Campaign, error) {
+	campaign, err := s.campaignRepository.GetCampaignByID(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, status := range from {
+		if campaign.Status == status {
+			campaign.Status = to
+			return s.campaignRepository.UpdateCampaign(campaign)
+		}
+	}
+
+	return nil, fmt.Errorf("%w: cannot change status from %s to %s", exception.InvalidCampaignError, campaign.Status, to)
+}
+
+func validateCampaign(campaign *model.Campaign) error {
+	if campaign.Name == "" {
+		return fmt.Errorf("%w: name is required", exception.InvalidCampaignError)
+	}
+
+	if len(campaign.Pools) == 0 {
+		return fmt.Errorf("%w: at least one pool is required", exception.InvalidCampaignError)
+	}
+
+	for _, pool := range campaign.Pools {
+		if !common.IsHexAddress(pool) {
+			return fmt.Errorf("%w: invalid pool address %s", exception.InvalidCampaignError, pool)
+		}
+	}
+
+	if campaign.StartTime.IsZero() {
+		return fmt.Errorf("%w: start time is required", exception.InvalidCampaignError)
+	}
+
+	if err := campaign.ValidateSchedule(); err != nil {
+		return fmt.Errorf("%w: %v", exception.InvalidCampaignError, err)
+	}
+
+	if campaign.Periods <= 0 {
+		return fmt.Errorf("%w: number of periods should be greater than 0", exception.InvalidCampaignError)
+	}
+
+	if campaign.BudgetPerPeriod <= 0 || campaign.BudgetDecay <= 0 {
+		return fmt.Errorf("%w: budget per period and budget decay should be greater than 0", exception.InvalidCampaignError)
+	}
+
+	for _, budget := range campaign.BudgetSchedule {
+		if budget < 0 {
+			return fmt.Errorf("%w: budget schedule should not be negative", exception.InvalidCampaignError)
+		}
+	}
+
+	if campaign.OnboardingAmount < 0 || campaign.OnboardingReward < 0 {
+		return fmt.Errorf("%w: onboarding rule should not be negative", exception.InvalidCampaignError)
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	realRepo "trading-ace/src/repository"
+)
+
+type campaignServiceTestSuite struct {
+	campaignService          CampaignService
+	mockedCampaignRepository *repository.MockCampaignRepository
+}
+
+func (s *campaignServiceTestSuite) setUp(t *testing.T) {
+	s.mockedCampaignRepository = repository.NewMockCampaignRepository(t)
+	s.campaignService = &campaignServiceImpl{
+		campaignRepository: s.mockedCampaignRepository,
+	}
+}
+
+func newTestCampaign(startTime time.Time) *model.Campaign {
+	return &model.Campaign{
+		ID:               1,
+		Name:             "test_campaign",
+		Pools:            []string{"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"},
+		StartTime:        startTime,
+		PeriodType:       model.PeriodTypeWeekly,
+		Timezone:         model.DefaultTimezone,
+		Periods:          4,
+		BudgetPerPeriod:  10000,
+		BudgetDecay:      1,
+		OnboardingAmount: 1000,
+		OnboardingReward: 100,
+		Status:           model.CampaignStatusActive,
+	}
+}
+
+func TestCampaignServiceImpl_CreateCampaign(t *testing.T) {
+	testSuite := &campaignServiceTestSuite{}
+
+	t.Run("CreateCampaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(time.Now().Add(time.Hour))
+		campaign.Status = model.CampaignStatusArchived
+
+		testSuite.mockedCampaignRepository.EXPECT().CreateCampaign(mock.MatchedBy(func(c *model.Campaign) bool {
+			return c.Status == model.CampaignStatusActive && !c.CreatedAt.IsZero()
+		})).Return(campaign, nil).Times(1)
+
+		created, err := testSuite.campaignService.CreateCampaign(campaign)
+		assert.Nil(t, err)
+		assert.Equal(t, campaign, created)
+	})
+
+	t.Run("Invalid Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		invalidCampaigns := map[string]func(c *model.Campaign){
+			"Empty Name":      func(c *model.Campaign) { c.Name = "" },
+			"No Pool":         func(c *model.Campaign) { c.Pools = nil },
+			"Invalid Pool":    func(c *model.Campaign) { c.Pools = []string{"not_an_address"} },
+			"No Start Time":   func(c *model.Campaign) { c.StartTime = time.Time{} },
+			"Unknown Period":  func(c *model.Campaign) { c.PeriodType = "hourly" },
+			"Invalid Cron":    func(c *model.Campaign) { c.PeriodType, c.PeriodCron = model.PeriodTypeCron, "every friday" },
+			"Invalid Zone":    func(c *model.Campaign) { c.Timezone = "Mars/Olympus" },
+			"Zero Periods":    func(c *model.Campaign) { c.Periods = 0 },
+			"Zero Budget":     func(c *model.Campaign) { c.BudgetPerPeriod = 0 },
+			"Zero Decay":      func(c *model.Campaign) { c.BudgetDecay = 0 },
+			"Negative Budget": func(c *model.Campaign) { c.BudgetSchedule = []float64{100, -1} },
+			"Negative Reward": func(c *model.Campaign) { c.OnboardingReward = -1 },
+		}
+
+		for name, modify := range invalidCampaigns {
+			t.Run(name, func(t *testing.T) {
+				campaign := newTestCampaign(time.Now())
+				modify(campaign)
+
+				created, err := testSuite.campaignService.CreateCampaign(campaign)
+				assert.ErrorIs(t, err, exception.InvalidCampaignError)
+				assert.Nil(t, created)
+			})
+		}
+	})
+}
+
+func TestCampaignServiceImpl_UpdateCampaign(t *testing.T) {
+	testSuite := &campaignServiceTestSuite{}
+
+	t.Run("Update Budget Of Started Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		existing := newTestCampaign(time.Now().Add(-time.Hour).UTC())
+		update := *existing
+		update.BudgetPerPeriod = 20000
+		update.Status = model.CampaignStatusArchived
+
+		testSuite.mockedCampaignRepository.EXPECT().GetCampaignByID(1).Return(existing, nil).Times(1)
+		testSuite.mockedCampaignRepository.EXPECT().UpdateCampaign(mock.MatchedBy(func(c *model.Campaign) bool {
+			return c.BudgetPerPeriod == 20000 && c.Status == model.CampaignStatusActive
+		})).Return(&update, nil).Times(1)
+
+		_, err := testSuite.campaignService.UpdateCampaign(&update)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Reschedule Started Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		existing := newTestCampaign(time.Now().Add(-time.Hour).UTC())
+		update := *existing
+		update.PeriodType = model.PeriodTypeDaily
+
+		testSuite.mockedCampaignRepository.EXPECT().GetCampaignByID(1).Return(existing, nil).Times(1)
+
+		_, err := testSuite.campaignService.UpdateCampaign(&update)
+		assert.ErrorIs(t, err, exception.InvalidCampaignError)
+	})
+
+	t.Run("Update Archived Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		existing := newTestCampaign(time.Now().Add(time.Hour).UTC())
+		existing.Status = model.CampaignStatusArchived
+		update := *existing
+
+		testSuite.mockedCampaignRepository.EXPECT().GetCampaignByID(1).Return(existing, nil).Times(1)
+
+		_, err := testSuite.campaignService.UpdateCampaign(&update)
+		assert.ErrorIs(t, err, exception.InvalidCampaignError)
+	})
+
+	t.Run("Campaign Not Found", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignRepository.EXPECT().GetCampaignByID(1).Return(nil, exception.CampaignNotFoundError).Times(1)
+
+		_, err := testSuite.campaignService.UpdateCampaign(newTestCampaign(time.Now()))
+		assert.ErrorIs(t, err, exception.CampaignNotFoundError)
+	})
+}
+
+func TestCampaignServiceImpl_TransitCampaign(t *testing.T) {
+	testSuite := &campaignServiceTestSuite{}
+
+	testCases := []struct {
+		name      string
+		from      model.CampaignStatus
+		to        model.CampaignStatus
+		transit   func(s CampaignService) (*model.Campaign, error)
+		isAllowed bool
+	}{
+		{"Pause Active", model.CampaignStatusActive, model.CampaignStatusPaused, func(s CampaignService) (*model.Campaign, error) { return s.PauseCampaign(1) }, true},
+		{"Pause Archived", model.CampaignStatusArchived, model.CampaignStatusPaused, func(s CampaignService) (*model.Campaign, error) { return s.PauseCampaign(1) }, false},
+		{"Resume Paused", model.CampaignStatusPaused, model.CampaignStatusActive, func(s CampaignService) (*model.Campaign, error) { return s.ResumeCampaign(1) }, true},
+		{"Resume Active", model.CampaignStatusActive, model.CampaignStatusActive, func(s CampaignService) (*model.Campaign, error) { return s.ResumeCampaign(1) }, false},
+		{"Archive Paused", model.CampaignStatusPaused, model.CampaignStatusArchived, func(s CampaignService) (*model.Campaign, error) { return s.ArchiveCampaign(1) }, true},
+		{"Archive Archived", model.CampaignStatusArchived, model.CampaignStatusArchived, func(s CampaignService) (*model.Campaign, error) { return s.ArchiveCampaign(1) }, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testSuite.setUp(t)
+
+			campaign := newTestCampaign(time.Now())
+			campaign.Status = testCase.from
+
+			testSuite.mockedCampaignRepository.EXPECT().GetCampaignByID(1).Return(campaign, nil).Times(1)
+
+			if testCase.isAllowed {
+				testSuite.mockedCampaignRepository.EXPECT().UpdateCampaign(mock.MatchedBy(func(c *model.Campaign) bool {
+					return c.Status == testCase.to
+				})).Return(campaign, nil).Times(1)
+			}
+
+			_, err := testCase.transit(testSuite.campaignService)
+			if testCase.isAllowed {
+				assert.Nil(t, err)
+			} else {
+				assert.ErrorIs(t, err, exception.InvalidCampaignError)
+			}
+		})
+	}
+}
+
+func TestCampaignServiceImpl_GetRunningCampaigns(t *testing.T) {
+	testSuite := &campaignServiceTestSuite{}
+
+	t.Run("Filter By Pool And Time", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		now := time.Now().UTC()
+		running := newTestCampaign(now.Add(-time.Hour))
+		notStarted := newTestCampaign(now.Add(time.Hour))
+		otherPool := newTestCampaign(now.Add(-time.Hour))
+		otherPool.Pools = []string{"0x0000000000000000000000000000000000000001"}
+
+		testSuite.mockedCampaignRepository.EXPECT().SearchCampaigns(&realRepo.SearchCampaignsCondition{
+			Statuses: []model.CampaignStatus{model.CampaignStatusActive},
+		}).Return([]*model.Campaign{running, notStarted, otherPool}, nil).Times(1)
+
+		campaigns, err := testSuite.campaignService.GetRunningCampaigns("0xb4e16d0168e52d35cacd2c6185b44281ec28c9dc", now)
+		assert.Nil(t, err)
+		assert.Equal(t, []*model.Campaign{running}, campaigns)
+	})
+
+	t.Run("Query Error", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignRepository.EXPECT().SearchCampaigns(mock.Anything).Return(nil, assert.AnError).Times(1)
+
+		campaigns, err := testSuite.campaignService.GetRunningCampaigns("0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc", time.Now())
+		assert.NotNil(t, err)
+		assert.Nil(t, campaigns)
+	})
+}
@@ -0,0 +1,148 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"log"
+	"math/big"
+	"strconv"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/merkle"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type ClaimService interface {
+	// BuildDistribution returns nil when Merkle distributions are disabled.
+	BuildDistribution(campaign *model.Campaign, periodIndex int) (*model.MerkleDistribution, error)
+	// RequestClaim returns the cumulative points the address claimed.
+	RequestClaim(address string, now time.Time) (float64, error)
+	GetClaims(address string) ([]*model.ClaimOfCampaign, error)
+}
+
+type claimServiceImpl struct {
+	claimRepository  repository.ClaimRepository
+	ledgerRepository repository.LedgerRepository
+	mode             string
+	tokenDecimals    int
+}
+
+func NewClaimService() ClaimService {
+	return &claimServiceImpl{
+		claimRepository:  repository.NewClaimRepository(),
+		ledgerRepository: repository.NewLedgerRepository(),
+		mode:             config.GetAppConfig().Claim.GetMode(),
+		tokenDecimals:    config.GetAppConfig().Claim.GetTokenDecimals(),
+	}
+}
+
+// BuildDistribution builds the Merkle tree of the cumulative points every
+// address claimed, over all campaigns, and stores its root and proofs. Only
+// the points users asked to claim are part of it, balances are left to be
+// redeemed, see RequestClaim.
+func (s *claimServiceImpl) BuildDistribution(campaign *model.Campaign, periodIndex int) (*model.MerkleDistribution, error) {
+	if s.mode != config.ClaimModeMerkle {
+		return nil, nil
+	}
+
+	now := time.Now().UTC()
+
+	points, err := s.ledgerRepository.SumClaimedPoints()
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := make(map[common.Address]*big.Int)
+	for userID, userPoints := range points {
+		if !common.IsHexAddress(userID) {
+			log.Printf("Skipping claim of user %s: not an address", userID)
+			continue
+		}
+
+		account := common.HexToAddress(userID)
+		if amounts[account] == nil {
+			amounts[account] = new(big.Int)
+		}
+		amounts[account].Add(amounts[account], pointsToAmount(userPoints, s.tokenDecimals))
+	}
+
+	var balances []merkle.Balance
+	for account, amount := range amounts {
+		if amount.Sign() > 0 {
+			balances = append(balances, merkle.Balance{Account: account, Amount: amount})
+		}
+	}
+
+	tree := merkle.NewBalanceTree(balances)
+	totalAmount := new(big.Int)
+	claims := make([]*model.Claim, 0, len(balances))
+	for index, balance := range tree.Balances() {
+		proof := make([]string, 0)
+		for _, node := range tree.Proof(index) {
+			proof = append(proof, node.Hex())
+		}
+
+		totalAmount.Add(totalAmount, balance.Amount)
+		claims = append(claims, &model.Claim{
+			Address: balance.Account.Hex(),
+			Amount:  balance.Amount.String(),
+			Proof:   proof,
+		})
+	}
+
+	return s.claimRepository.CreateDistribution(&model.MerkleDistribution{
+		CampaignID:    campaign.ID,
+		PeriodIndex:   periodIndex,
+		Root:          tree.Root().Hex(),
+		TokenDecimals: s.tokenDecimals,
+		TotalAmount:   totalAmount.String(),
+		CreatedAt:     now,
+	}, claims)
+}
+
+// RequestClaim moves the balance of the address to the claims account of the
+// ledger, so the points can't be redeemed or expire anymore. The points are
+// claimable on chain once the next distribution is built.
+func (s *claimServiceImpl) RequestClaim(address string, now time.Time) (float64, error) {
+	if !common.IsHexAddress(address) {
+		return 0, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	if s.mode != config.ClaimModeMerkle {
+		return 0, exception.MerkleClaimsDisabledError
+	}
+
+	claimed, err := s.ledgerRepository.ClaimPoints(common.HexToAddress(address).Hex(), now)
+	if err != nil {
+		return 0, err
+	}
+
+	if pointsToAmount(claimed, s.tokenDecimals).Sign() <= 0 {
+		return 0, fmt.Errorf("%w: %s", exception.NothingToClaimError, address)
+	}
+
+	return claimed, nil
+}
+
+func (s *claimServiceImpl) GetClaims(address string) ([]*model.ClaimOfCampaign, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	return s.claimRepository.SearchLatestClaims(common.HexToAddress(address).Hex())
+}
+
+// pointsToAmount converts points to token base units, one point is one token.
+// The decimal representation of the points is used so 0.1 point is exactly
+// 10^(decimals-1) units.
+func pointsToAmount(points float64, decimals int) *big.Int {
+	amount, ok := new(big.Rat).SetString(strconv.FormatFloat(points, 'f', -1, 64))
+	if !ok {
+		return new(big.Int)
+	}
+
+	amount.Mul(amount, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	return new(big.Int).Quo(amount.Num(), amount.Denom())
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/merkle"
+	"trading-ace/src/model"
+)
+
+type claimServiceTestSuite struct {
+	claimService           ClaimService
+	mockedClaimRepository  *repository.MockClaimRepository
+	mockedLedgerRepository *repository.MockLedgerRepository
+}
+
+func (s *claimServiceTestSuite) setUp(t *testing.T) {
+	s.mockedClaimRepository = repository.NewMockClaimRepository(t)
+	s.mockedLedgerRepository = repository.NewMockLedgerRepository(t)
+	s.claimService = &claimServiceImpl{
+		claimRepository:  s.mockedClaimRepository,
+		ledgerRepository: s.mockedLedgerRepository,
+		mode:             config.ClaimModeMerkle,
+		tokenDecimals:    18,
+	}
+}
+
+func TestClaimServiceImpl_BuildDistribution(t *testing.T) {
+	testSuite := &claimServiceTestSuite{}
+	campaign := newTestCampaign(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC))
+	alice := common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	bob := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	t.Run("Build Tree Of Cumulative Claimed Amounts", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().SumClaimedPoints().Return(map[string]float64{
+			"0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266": 100,
+			alice.Hex():          0.5,
+			bob.Hex():            2500.25,
+			"not_an_address":     10,
+			"0x0000000000000001": 0,
+		}, nil).Times(1)
+
+		var storedClaims []*model.Claim
+		testSuite.mockedClaimRepository.EXPECT().CreateDistribution(mock.Anything, mock.Anything).
+			RunAndReturn(func(distribution *model.MerkleDistribution, claims []*model.Claim) (*model.MerkleDistribution, error) {
+				storedClaims = claims
+				return distribution, nil
+			}).Times(1)
+
+		distribution, err := testSuite.claimService.BuildDistribution(campaign, 2)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, distribution.CampaignID)
+		assert.Equal(t, 2, distribution.PeriodIndex)
+		assert.Equal(t, 18, distribution.TokenDecimals)
+		assert.Equal(t, "2600750000000000000000", distribution.TotalAmount)
+
+		assert.Equal(t, 2, len(storedClaims))
+		assert.Equal(t, bob.Hex(), storedClaims[0].Address)
+		assert.Equal(t, "2500250000000000000000", storedClaims[0].Amount)
+		assert.Equal(t, alice.Hex(), storedClaims[1].Address)
+		assert.Equal(t, "100500000000000000000", storedClaims[1].Amount)
+
+		root := common.HexToHash(distribution.Root)
+		for _, claim := range storedClaims {
+			amount, _ := new(big.Int).SetString(claim.Amount, 10)
+			var proof []common.Hash
+			for _, node := range claim.Proof {
+				proof = append(proof, common.HexToHash(node))
+			}
+			assert.True(t, merkle.VerifyProof(proof, root, merkle.LeafHash(common.HexToAddress(claim.Address), amount)))
+		}
+	})
+
+	t.Run("Distribution Already Exists", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().SumClaimedPoints().Return(map[string]float64{}, nil).Times(1)
+		testSuite.mockedClaimRepository.EXPECT().CreateDistribution(mock.Anything, mock.Anything).
+			Return(nil, exception.DistributionAlreadyExistsError).Times(1)
+
+		_, err := testSuite.claimService.BuildDistribution(campaign, 0)
+		assert.ErrorIs(t, err, exception.DistributionAlreadyExistsError)
+	})
+
+	t.Run("Voucher Mode", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.claimService.(*claimServiceImpl).mode = config.ClaimModeVoucher
+
+		distribution, err := testSuite.claimService.BuildDistribution(campaign, 0)
+		assert.NoError(t, err)
+		assert.Nil(t, distribution)
+	})
+
+}
+
+func TestClaimServiceImpl_RequestClaim(t *testing.T) {
+	testSuite := &claimServiceTestSuite{}
+	now := time.Date(2024, 9, 10, 0, 0, 0, 0, time.UTC)
+	address := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+	t.Run("Claim Balance Of Checksum Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(address, now).Return(2500.25, nil).Times(1)
+
+		claimed, err := testSuite.claimService.RequestClaim("0x70997970c51812dc3a010c7d01b50e0d17dc79c8", now)
+		assert.Nil(t, err)
+		assert.Equal(t, 2500.25, claimed)
+	})
+
+	t.Run("Nothing To Claim", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(address, now).Return(0, nil).Times(1)
+
+		_, err := testSuite.claimService.RequestClaim(address, now)
+		assert.ErrorIs(t, err, exception.NothingToClaimError)
+	})
+
+	t.Run("Claim Failed", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(address, now).Return(0, assert.AnError).Times(1)
+
+		_, err := testSuite.claimService.RequestClaim(address, now)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Voucher Mode", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.claimService.(*claimServiceImpl).mode = config.ClaimModeVoucher
+
+		_, err := testSuite.claimService.RequestClaim(address, now)
+		assert.ErrorIs(t, err, exception.MerkleClaimsDisabledError)
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.claimService.RequestClaim("test_user", now)
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+}
+
+func TestClaimServiceImpl_GetClaims(t *testing.T) {
+	testSuite := &claimServiceTestSuite{}
+
+	t.Run("Search By Checksum Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		claims := []*model.ClaimOfCampaign{{Distribution: &model.MerkleDistribution{ID: 1}, Claim: &model.Claim{DistributionID: 1}}}
+		testSuite.mockedClaimRepository.EXPECT().SearchLatestClaims("0x70997970C51812dc3A010C7d01b50e0d17dc79C8").Return(claims, nil).Times(1)
+
+		result, err := testSuite.claimService.GetClaims("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+		assert.Nil(t, err)
+		assert.Equal(t, claims, result)
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.claimService.GetClaims("test_user")
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+}
+
+func TestPointsToAmount(t *testing.T) {
+	assert.Equal(t, "100000000000000000", pointsToAmount(0.1, 18).String())
+	assert.Equal(t, "1234567", pointsToAmount(1.234567, 6).String())
+	assert.Equal(t, "1", pointsToAmount(1.9, 0).String())
+}
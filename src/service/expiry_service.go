@@ -0,0 +1,141 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"log"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type ExpiryService interface {
+	ExpirePoints(ctx context.Context, now time.Time) error
+	GetExpiringPoints(address string, now time.Time) ([]*model.ExpiringPoints, error)
+}
+
+type expiryServiceImpl struct {
+	ledgerRepository repository.LedgerRepository
+	campaignService  CampaignService
+	rule             *model.ExpiryRule
+	warningWindow    time.Duration
+}
+
+func NewExpiryService() ExpiryService {
+	expiryConfig := config.GetAppConfig().Expiry
+
+	rule := &model.ExpiryRule{}
+	if expiryConfig != nil {
+		rule.Days = expiryConfig.Days
+		rule.AtCampaignEnd = expiryConfig.AtCampaignEnd
+	}
+
+	return &expiryServiceImpl{
+		ledgerRepository: repository.NewLedgerRepository(),
+		campaignService:  NewCampaignService(),
+		rule:             rule,
+		warningWindow:    expiryConfig.GetWarningWindow(),
+	}
+}
+
+// ExpirePoints debits every user of what is left of their expired lots. Debits
+// spend the lots expiring first, the expiry debits included, so a lot never
+// expires twice.
+func (s *expiryServiceImpl) ExpirePoints(ctx context.Context, now time.Time) error {
+	if !s.rule.IsEnabled() {
+		return nil
+	}
+
+	userIDs, err := s.ledgerRepository.SearchUsersWithBalance()
+	if err != nil {
+		return err
+	}
+
+	campaignEnds := make(map[int]*time.Time)
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var lotsErr error
+		points, err := s.ledgerRepository.ExpirePoints(userID, now, func(lots []*model.PointLot, debited float64) float64 {
+			if lotsErr = s.setExpiries(lots, campaignEnds); lotsErr != nil {
+				return 0
+			}
+			model.ConsumeLots(lots, debited)
+			return model.ExpiredPoints(lots, now)
+		})
+
+		if err == nil {
+			err = lotsErr
+		}
+
+		// one user failing shouldn't stop the others from expiring, the next run retries
+		if err != nil {
+			log.Printf("Failed to expire points of user %s: %v", userID, err)
+			continue
+		}
+
+		if points > 0 {
+			log.Printf("Expired %f points of user %s", points, userID)
+		}
+	}
+
+	return nil
+}
+
+// GetExpiringPoints returns the points of the user expiring within the warning
+// window, grouped by expiry time.
+func (s *expiryServiceImpl) GetExpiringPoints(address string, now time.Time) ([]*model.ExpiringPoints, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	if !s.rule.IsEnabled() {
+		return nil, nil
+	}
+
+	lots, debited, err := s.ledgerRepository.GetPointLots(address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.setExpiries(lots, make(map[int]*time.Time)); err != nil {
+		return nil, err
+	}
+	model.ConsumeLots(lots, debited)
+
+	return model.ExpiringSoon(lots, now, s.warningWindow), nil
+}
+
+// setExpiries sets when each lot expires, campaignEnds caches the end of the
+// campaigns already loaded.
+func (s *expiryServiceImpl) setExpiries(lots []*model.PointLot, campaignEnds map[int]*time.Time) error {
+	for _, lot := range lots {
+		var campaignEnd *time.Time
+		if lot.CampaignID != 0 && s.rule.AtCampaignEnd {
+			end, ok := campaignEnds[lot.CampaignID]
+			if !ok {
+				campaign, err := s.campaignService.GetCampaign(lot.CampaignID)
+				if err != nil && !errors.Is(err, exception.CampaignNotFoundError) {
+					return err
+				}
+
+				if campaign != nil {
+					endTime := campaign.EndTime()
+					end = &endTime
+				}
+				campaignEnds[lot.CampaignID] = end
+			}
+			campaignEnd = end
+		}
+
+		lot.ExpiresAt = s.rule.ExpiresAt(lot.EarnedAt, campaignEnd)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type expiryServiceTestSuite struct {
+	expiryService          *expiryServiceImpl
+	mockedLedgerRepository *repository.MockLedgerRepository
+	mockedCampaignService  *service.MockCampaignService
+}
+
+func (s *expiryServiceTestSuite) setUp(t *testing.T, rule *model.ExpiryRule) {
+	s.mockedLedgerRepository = repository.NewMockLedgerRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.expiryService = &expiryServiceImpl{
+		ledgerRepository: s.mockedLedgerRepository,
+		campaignService:  s.mockedCampaignService,
+		rule:             rule,
+		warningWindow:    7 * 24 * time.Hour,
+	}
+}
+
+func TestExpiryService(t *testing.T) {
+	testSuite := &expiryServiceTestSuite{}
+	now := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	address := "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+	campaign := model.NewCampaign("test", nil, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), model.PeriodTypeWeekly, 4)
+	campaign.ID = 1
+
+	newLots := func() []*model.PointLot {
+		return []*model.PointLot{
+			{TransactionID: 1, CampaignID: 1, Points: 100, Remaining: 100, EarnedAt: now.AddDate(0, 0, -40)},
+			{TransactionID: 2, CampaignID: 1, Points: 50, Remaining: 50, EarnedAt: now.AddDate(0, 0, -25)},
+			{TransactionID: 3, Points: 20, Remaining: 20, EarnedAt: now.AddDate(0, 0, -2)},
+		}
+	}
+
+	t.Run("ExpirePoints", func(t *testing.T) {
+		testSuite.setUp(t, &model.ExpiryRule{Days: 30})
+
+		testSuite.mockedLedgerRepository.EXPECT().SearchUsersWithBalance().Return([]string{address}, nil).Times(1)
+		testSuite.mockedLedgerRepository.EXPECT().ExpirePoints(address, now, mock.Anything).RunAndReturn(
+			func(userID string, now time.Time, expire func([]*model.PointLot, float64) float64) (float64, error) {
+				// 40 points of the lot expiring first were spent, the 60 left expire
+				points := expire(newLots(), 40)
+				assert.Equal(t, 60.0, points)
+				return points, nil
+			}).Times(1)
+
+		err := testSuite.expiryService.ExpirePoints(context.Background(), now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpirePoints at Campaign End", func(t *testing.T) {
+		testSuite.setUp(t, &model.ExpiryRule{AtCampaignEnd: true})
+
+		testSuite.mockedLedgerRepository.EXPECT().SearchUsersWithBalance().Return([]string{address}, nil).Times(1)
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedLedgerRepository.EXPECT().ExpirePoints(address, now, mock.Anything).RunAndReturn(
+			func(userID string, now time.Time, expire func([]*model.PointLot, float64) float64) (float64, error) {
+				// the campaign ended on 2024-09-29, the lot without campaign doesn't expire
+				points := expire(newLots(), 0)
+				assert.Equal(t, 150.0, points)
+				return points, nil
+			}).Times(1)
+
+		err := testSuite.expiryService.ExpirePoints(context.Background(), now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("ExpirePoints Disabled", func(t *testing.T) {
+		testSuite.setUp(t, &model.ExpiryRule{})
+
+		err := testSuite.expiryService.ExpirePoints(context.Background(), now)
+		assert.NoError(t, err)
+	})
+
+	t.Run("GetExpiringPoints", func(t *testing.T) {
+		testSuite.setUp(t, &model.ExpiryRule{Days: 30})
+
+		testSuite.mockedLedgerRepository.EXPECT().GetPointLots(address).Return(newLots(), 100.0, nil).Times(1)
+
+		expiring, err := testSuite.expiryService.GetExpiringPoints(address, now)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.ExpiringPoints{{Points: 50, ExpiresAt: now.AddDate(0, 0, 5)}}, expiring)
+	})
+
+	t.Run("GetExpiringPoints with Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t, &model.ExpiryRule{Days: 30})
+
+		_, err := testSuite.expiryService.GetExpiringPoints("test_user_id", now)
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+}
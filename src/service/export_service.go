@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type ExportService interface {
+	ExportTasks(ctx context.Context, query *model.ExportQuery, w io.Writer) error
+	ExportRewardRecords(ctx context.Context, query *model.ExportQuery, w io.Writer) error
+}
+
+type exportServiceImpl struct {
+	campaignService        CampaignService
+	taskRepository         repository.TaskRepository
+	rewardRecordRepository repository.RewardRecordRepository
+}
+
+func NewExportService() ExportService {
+	return &exportServiceImpl{
+		campaignService:        NewCampaignService(),
+		taskRepository:         repository.NewTaskRepository(),
+		rewardRecordRepository: repository.NewRewardRecordRepository(),
+	}
+}
+
+// ExportTasks writes the matching tasks to w as they are read from the
+// database. The query is validated before anything is written.
+func (s *exportServiceImpl) ExportTasks(ctx context.Context, query *model.ExportQuery, w io.Writer) error {
+	startTime, endTime, err := s.resolveTimeRange(query)
+	if err != nil {
+		return err
+	}
+
+	writer := newExportWriter(query.Format, w, taskExportHeader)
+	err = s.taskRepository.StreamTasks(ctx, &repository.SearchTasksCondition{
+		UserID:     query.UserID,
+		CampaignID: query.CampaignID,
+		Types:      query.Types,
+		Statuses:   query.Statuses,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	}, func(task *model.Task) error {
+		return writer.Write(newTaskExportRow(task))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// ExportRewardRecords writes the matching reward records to w as they are read
+// from the database. The query is validated before anything is written.
+func (s *exportServiceImpl) ExportRewardRecords(ctx context.Context, query *model.ExportQuery, w io.Writer) error {
+	startTime, endTime, err := s.resolveTimeRange(query)
+	if err != nil {
+		return err
+	}
+
+	writer := newExportWriter(query.Format, w, rewardRecordExportHeader)
+	err = s.rewardRecordRepository.StreamRewardRecords(ctx, &repository.RewardRecordSearchCondition{
+		UserID:     query.UserID,
+		CampaignID: query.CampaignID,
+		StartTime:  startTime,
+		Duration:   endTime.Sub(startTime),
+	}, func(record *model.RewardRecord) error {
+		return writer.Write(newRewardRecordExportRow(record))
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// resolveTimeRange validates the query and returns the window of the queried
+// period, or its time range. Both bounds are zero when the export is not time
+// bounded.
+func (s *exportServiceImpl) resolveTimeRange(query *model.ExportQuery) (time.Time, time.Time, error) {
+	if !query.Format.IsValid() {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: unsupported format %q", exception.InvalidExportError, query.Format)
+	}
+
+	if query.UserID == "" && query.CampaignID == 0 {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: export needs a user or a campaign", exception.InvalidExportError)
+	}
+
+	for _, taskType := range query.Types {
+		if !taskType.IsValid() {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid task type %q", exception.InvalidExportError, taskType)
+		}
+	}
+
+	for _, status := range query.Statuses {
+		if !status.IsValid() {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: invalid task status %q", exception.InvalidExportError, status)
+		}
+	}
+
+	if query.PeriodIndex != nil {
+		if query.CampaignID == 0 {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: period requires a campaign", exception.InvalidExportError)
+		}
+
+		campaign, err := s.campaignService.GetCampaign(query.CampaignID)
+		if err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+
+		if *query.PeriodIndex < 0 || *query.PeriodIndex >= campaign.Periods {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: campaign %d has no period %d", exception.InvalidExportError, campaign.ID, *query.PeriodIndex)
+		}
+
+		startTime, endTime := campaign.PeriodWindow(*query.PeriodIndex)
+		return startTime, endTime, nil
+	}
+
+	if query.StartTime.IsZero() != query.EndTime.IsZero() {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: both start time and end time should be provided", exception.InvalidExportError)
+	}
+
+	if query.StartTime.After(query.EndTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start time should be before end time", exception.InvalidExportError)
+	}
+
+	return query.StartTime, query.EndTime, nil
+}
+
+type exportRow interface {
+	values() []string
+}
+
+type exportWriter interface {
+	Write(row exportRow) error
+	Flush() error
+}
+
+func newExportWriter(format model.ExportFormat, w io.Writer, header []string) exportWriter {
+	if format == model.ExportFormatNDJSON {
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}
+	}
+	return &csvExportWriter{writer: csv.NewWriter(w), header: header}
+}
+
+type csvExportWriter struct {
+	writer      *csv.Writer
+	header      []string
+	wroteHeader bool
+}
+
+func (c *csvExportWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.writer.Write(c.header)
+}
+
+func (c *csvExportWriter) Write(row exportRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	return c.writer.Write(row.values())
+}
+
+// Flush writes the header of an empty export, so the file always has its columns.
+func (c *csvExportWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+type ndjsonExportWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonExportWriter) Write(row exportRow) error {
+	return n.encoder.Encode(row)
+}
+
+func (n *ndjsonExportWriter) Flush() error {
+	return nil
+}
+
+var taskExportHeader = []string{"id", "campaign_id", "user_address", "type", "status", "swap_amount", "created_at", "completed_at"}
+
+type taskExportRow struct {
+	ID          int        `json:"id"`
+	CampaignID  int        `json:"campaign_id"`
+	User        string     `json:"user_address"`
+	Type        string     `json:"type"`
+	Status      string     `json:"status"`
+	SwapAmount  float64    `json:"swap_amount"`
+	CreatedAt   time.Time  `json:"created_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+}
+
+func newTaskExportRow(task *model.Task) *taskExportRow {
+	row := &taskExportRow{
+		ID:         task.ID,
+		CampaignID: task.CampaignID,
+		User:       task.UserID,
+		Type:       string(task.Type),
+		Status:     string(task.Status),
+		SwapAmount: task.SwapAmount,
+		CreatedAt:  task.CreatedAt.UTC(),
+	}
+
+	if task.CompletedAt.Valid {
+		completedAt := task.CompletedAt.Time.UTC()
+		row.CompletedAt = &completedAt
+	}
+
+	return row
+}
+
+func (t *taskExportRow) values() []string {
+	completedAt := ""
+	if t.CompletedAt != nil {
+		completedAt = t.CompletedAt.Format(time.RFC3339)
+	}
+
+	return []string{
+		strconv.Itoa(t.ID),
+		strconv.Itoa(t.CampaignID),
+		t.User,
+		t.Type,
+		t.Status,
+		strconv.FormatFloat(t.SwapAmount, 'f', -1, 64),
+		t.CreatedAt.Format(time.RFC3339),
+		completedAt,
+	}
+}
+
+var rewardRecordExportHeader = []string{"id", "campaign_id", "user_address", "task_id", "points", "original_points", "updated_points", "created_at"}
+
+type rewardRecordExportRow struct {
+	ID             int       `json:"id"`
+	CampaignID     int       `json:"campaign_id"`
+	User           string    `json:"user_address"`
+	TaskID         int       `json:"task_id"`
+	Points         float64   `json:"points"`
+	OriginalPoints float64   `json:"original_points"`
+	UpdatedPoints  float64   `json:"updated_points"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func newRewardRecordExportRow(record *model.RewardRecord) *rewardRecordExportRow {
+	return &rewardRecordExportRow{
+		ID:             record.ID,
+		CampaignID:     record.CampaignID,
+		User:           record.UserID,
+		TaskID:         record.TaskID,
+		Points:         record.Points,
+		OriginalPoints: record.OriginPoints,
+		UpdatedPoints:  record.UpdatedPoints,
+		CreatedAt:      record.CreatedAt.UTC(),
+	}
+}
+
+func (r *rewardRecordExportRow) values() []string {
+	return []string{
+		strconv.Itoa(r.ID),
+		strconv.Itoa(r.CampaignID),
+		r.User,
+		strconv.Itoa(r.TaskID),
+		strconv.FormatFloat(r.Points, 'f', -1, 64),
+		strconv.FormatFloat(r.OriginalPoints, 'f', -1, 64),
+		strconv.FormatFloat(r.UpdatedPoints, 'f', -1, 64),
+		r.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	realRepo "trading-ace/src/repository"
+)
+
+type exportServiceTestSuite struct {
+	exportService                ExportService
+	mockedCampaignService        *service.MockCampaignService
+	mockedTaskRepository         *repository.MockTaskRepository
+	mockedRewardRecordRepository *repository.MockRewardRecordRepository
+}
+
+func (s *exportServiceTestSuite) setUp(t *testing.T) {
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.mockedTaskRepository = repository.NewMockTaskRepository(t)
+	s.mockedRewardRecordRepository = repository.NewMockRewardRecordRepository(t)
+	s.exportService = &exportServiceImpl{
+		campaignService:        s.mockedCampaignService,
+		taskRepository:         s.mockedTaskRepository,
+		rewardRecordRepository: s.mockedRewardRecordRepository,
+	}
+}
+
+func TestExportServiceImpl_ExportTasks(t *testing.T) {
+	testSuite := &exportServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	createdAt := startTime.Add(time.Hour)
+	tasks := []*model.Task{
+		{ID: 2, CampaignID: 1, UserID: "test_user", Type: model.TaskTypeSharedPool, Status: model.TaskStatusPending, SwapAmount: 10.5, CreatedAt: createdAt},
+		{ID: 1, CampaignID: 1, UserID: "test_user", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone, SwapAmount: 1500,
+			CreatedAt: createdAt, CompletedAt: sql.NullTime{Time: createdAt, Valid: true}},
+	}
+
+	streamTasks := func(_ context.Context, _ *realRepo.SearchTasksCondition, fn func(task *model.Task) error) error {
+		for _, task := range tasks {
+			if err := fn(task); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	t.Run("CSV Of Campaign Period", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		periodIndex := 1
+		periodStart, periodEnd := campaign.PeriodWindow(periodIndex)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedTaskRepository.EXPECT().StreamTasks(mock.Anything, &realRepo.SearchTasksCondition{
+			CampaignID: 1,
+			StartTime:  periodStart,
+			EndTime:    periodEnd,
+		}, mock.Anything).RunAndReturn(streamTasks).Times(1)
+
+		var buffer bytes.Buffer
+		err := testSuite.exportService.ExportTasks(context.Background(), &model.ExportQuery{
+			Format:      model.ExportFormatCSV,
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+		}, &buffer)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id,campaign_id,user_address,type,status,swap_amount,created_at,completed_at\n"+
+			"2,1,test_user,shared_pool,pending,10.5,2024-09-01T01:00:00Z,\n"+
+			"1,1,test_user,on_boarding,done,1500,2024-09-01T01:00:00Z,2024-09-01T01:00:00Z\n", buffer.String())
+	})
+
+	t.Run("NDJSON Of User", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedTaskRepository.EXPECT().StreamTasks(mock.Anything, &realRepo.SearchTasksCondition{
+			UserID: "test_user",
+			Types:  []model.TaskType{model.TaskTypeSharedPool},
+		}, mock.Anything).RunAndReturn(streamTasks).Times(1)
+
+		var buffer bytes.Buffer
+		err := testSuite.exportService.ExportTasks(context.Background(), &model.ExportQuery{
+			Format: model.ExportFormatNDJSON,
+			UserID: "test_user",
+			Types:  []model.TaskType{model.TaskTypeSharedPool},
+		}, &buffer)
+
+		assert.Nil(t, err)
+		assert.Equal(t, `{"id":2,"campaign_id":1,"user_address":"test_user","type":"shared_pool","status":"pending","swap_amount":10.5,"created_at":"2024-09-01T01:00:00Z","completed_at":null}`+"\n"+
+			`{"id":1,"campaign_id":1,"user_address":"test_user","type":"on_boarding","status":"done","swap_amount":1500,"created_at":"2024-09-01T01:00:00Z","completed_at":"2024-09-01T01:00:00Z"}`+"\n",
+			buffer.String())
+	})
+
+	t.Run("Invalid Query Writes Nothing", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		periodIndex := 4
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(newTestCampaign(startTime), nil).Times(1)
+
+		for _, query := range []*model.ExportQuery{
+			{Format: "xlsx"},
+			{Format: model.ExportFormatCSV, PeriodIndex: &periodIndex},
+			{Format: model.ExportFormatCSV, CampaignID: 1, PeriodIndex: &periodIndex},
+			{Format: model.ExportFormatCSV},
+			{Format: model.ExportFormatCSV, UserID: "test_user", Types: []model.TaskType{"swap"}},
+			{Format: model.ExportFormatCSV, UserID: "test_user", StartTime: startTime},
+			{Format: model.ExportFormatCSV, UserID: "test_user", StartTime: startTime, EndTime: startTime.Add(-time.Hour)},
+		} {
+			var buffer bytes.Buffer
+			err := testSuite.exportService.ExportTasks(context.Background(), query, &buffer)
+			assert.ErrorIs(t, err, exception.InvalidExportError)
+			assert.Empty(t, buffer.String())
+		}
+	})
+}
+
+func TestExportServiceImpl_ExportRewardRecords(t *testing.T) {
+	testSuite := &exportServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour * 24)
+
+	t.Run("CSV Of Time Range", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRewardRecordRepository.EXPECT().StreamRewardRecords(mock.Anything, &realRepo.RewardRecordSearchCondition{
+			CampaignID: 1,
+			StartTime:  startTime,
+			Duration:   time.Hour * 24,
+		}, mock.Anything).RunAndReturn(func(_ context.Context, _ *realRepo.RewardRecordSearchCondition, fn func(record *model.RewardRecord) error) error {
+			return fn(&model.RewardRecord{ID: 1, CampaignID: 1, UserID: "test_user", TaskID: 3, Points: 100, UpdatedPoints: 100, CreatedAt: startTime})
+		}).Times(1)
+
+		var buffer bytes.Buffer
+		err := testSuite.exportService.ExportRewardRecords(context.Background(), &model.ExportQuery{
+			Format:     model.ExportFormatCSV,
+			CampaignID: 1,
+			StartTime:  startTime,
+			EndTime:    endTime,
+		}, &buffer)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id,campaign_id,user_address,task_id,points,original_points,updated_points,created_at\n"+
+			"1,1,test_user,3,100,0,100,2024-09-01T00:00:00Z\n", buffer.String())
+	})
+
+	t.Run("Header Of Empty CSV", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRewardRecordRepository.EXPECT().StreamRewardRecords(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(1)
+
+		var buffer bytes.Buffer
+		err := testSuite.exportService.ExportRewardRecords(context.Background(), &model.ExportQuery{Format: model.ExportFormatCSV, UserID: "test_user"}, &buffer)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "id,campaign_id,user_address,task_id,points,original_points,updated_points,created_at\n", buffer.String())
+	})
+}
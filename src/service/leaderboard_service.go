@@ -0,0 +1,84 @@
+package service
+
+import (
+	"fmt"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+const (
+	defaultLeaderboardPageSize = 20
+	maxLeaderboardPageSize     = 100
+)
+
+type LeaderboardService interface {
+	GetLeaderboard(query *model.LeaderboardQuery) (*model.Leaderboard, error)
+}
+
+type leaderboardServiceImpl struct {
+	periodStatsRepository repository.PeriodStatsRepository
+	campaignService       CampaignService
+}
+
+func NewLeaderboardService() LeaderboardService {
+	return &leaderboardServiceImpl{
+		periodStatsRepository: repository.NewPeriodStatsRepository(),
+		campaignService:       NewCampaignService(),
+	}
+}
+
+func (s *leaderboardServiceImpl) GetLeaderboard(query *model.LeaderboardQuery) (*model.Leaderboard, error) {
+	campaign, err := s.campaignService.GetCampaign(query.CampaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if query.PeriodIndex != nil && (*query.PeriodIndex < 0 || *query.PeriodIndex >= campaign.Periods) {
+		return nil, fmt.Errorf("%w: campaign %d has no period %d", exception.InvalidCampaignError, campaign.ID, *query.PeriodIndex)
+	}
+
+	sortBy := query.SortBy
+	if sortBy == "" {
+		sortBy = model.LeaderboardSortByVolume
+	}
+
+	page := max(query.Page, 1)
+	pageSize := query.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultLeaderboardPageSize
+	}
+	pageSize = min(pageSize, maxLeaderboardPageSize)
+
+	condition := &repository.LeaderboardCondition{
+		CampaignID:  campaign.ID,
+		PeriodIndex: query.PeriodIndex,
+		SortBy:      sortBy,
+		Offset:      (page - 1) * pageSize,
+		Limit:       pageSize,
+	}
+
+	entries, total, err := s.periodStatsRepository.SearchLeaderboard(condition)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard := &model.Leaderboard{
+		CampaignID:  campaign.ID,
+		PeriodIndex: query.PeriodIndex,
+		SortBy:      sortBy,
+		Page:        page,
+		PageSize:    pageSize,
+		Total:       total,
+		Entries:     entries,
+	}
+
+	if query.UserID != "" {
+		leaderboard.Me, err = s.periodStatsRepository.GetLeaderboardEntry(condition, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return leaderboard, nil
+}
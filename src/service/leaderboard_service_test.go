@@ -0,0 +1,93 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	realRepo "trading-ace/src/repository"
+)
+
+type leaderboardServiceTestSuite struct {
+	leaderboardService          LeaderboardService
+	mockedPeriodStatsRepository *repository.MockPeriodStatsRepository
+	mockedCampaignService       *service.MockCampaignService
+}
+
+func (s *leaderboardServiceTestSuite) setUp(t *testing.T) {
+	s.mockedPeriodStatsRepository = repository.NewMockPeriodStatsRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.leaderboardService = &leaderboardServiceImpl{
+		periodStatsRepository: s.mockedPeriodStatsRepository,
+		campaignService:       s.mockedCampaignService,
+	}
+}
+
+func TestLeaderboardServiceImpl_GetLeaderboard(t *testing.T) {
+	testSuite := &leaderboardServiceTestSuite{}
+	campaign := newTestCampaign(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC))
+	entries := []*model.LeaderboardEntry{
+		{Rank: 1, UserID: "test_user_1", Volume: 3000},
+		{Rank: 2, UserID: "test_user_2", Volume: 1000},
+	}
+
+	t.Run("Whole Campaign With Defaults", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().SearchLeaderboard(&realRepo.LeaderboardCondition{
+			CampaignID: 1,
+			SortBy:     model.LeaderboardSortByVolume,
+			Offset:     0,
+			Limit:      defaultLeaderboardPageSize,
+		}).Return(entries, 2, nil).Times(1)
+
+		leaderboard, err := testSuite.leaderboardService.GetLeaderboard(&model.LeaderboardQuery{CampaignID: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, leaderboard.Total)
+		assert.Equal(t, 1, leaderboard.Page)
+		assert.Nil(t, leaderboard.Me)
+	})
+
+	t.Run("Period Page With Own Rank", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		periodIndex := 1
+		condition := &realRepo.LeaderboardCondition{
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+			SortBy:      model.LeaderboardSortByPoints,
+			Offset:      maxLeaderboardPageSize,
+			Limit:       maxLeaderboardPageSize,
+		}
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().SearchLeaderboard(condition).Return(nil, 2, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetLeaderboardEntry(condition, "test_user_2").Return(entries[1], nil).Times(1)
+
+		leaderboard, err := testSuite.leaderboardService.GetLeaderboard(&model.LeaderboardQuery{
+			CampaignID:  1,
+			PeriodIndex: &periodIndex,
+			SortBy:      model.LeaderboardSortByPoints,
+			Page:        2,
+			PageSize:    1000,
+			UserID:      "test_user_2",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, leaderboard.Me.Rank)
+		assert.Equal(t, maxLeaderboardPageSize, leaderboard.PageSize)
+	})
+
+	t.Run("Period Out Of Range", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		periodIndex := 4
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+
+		_, err := testSuite.leaderboardService.GetLeaderboard(&model.LeaderboardQuery{CampaignID: 1, PeriodIndex: &periodIndex})
+		assert.ErrorIs(t, err, exception.InvalidCampaignError)
+	})
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"log"
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type LedgerService interface {
+	ReconcileBalances(now time.Time) ([]*model.BalanceMismatch, error)
+	GetBalanceMismatches() ([]*model.BalanceMismatch, error)
+}
+
+type ledgerServiceImpl struct {
+	ledgerRepository repository.LedgerRepository
+}
+
+func NewLedgerService() LedgerService {
+	return &ledgerServiceImpl{
+		ledgerRepository: repository.NewLedgerRepository(),
+	}
+}
+
+// ReconcileBalances flags every user whose cached balance differs from the
+// sum of their ledger account. The ledger is the source of truth, a flagged
+// balance is corrected by an operator, not by the reconciliation.
+func (s *ledgerServiceImpl) ReconcileBalances(now time.Time) ([]*model.BalanceMismatch, error) {
+	mismatches, err := s.ledgerRepository.RefreshBalanceMismatches(now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mismatch := range mismatches {
+		log.Printf("Balance of user %s is %f but the ledger sums to %f, flagged since %s",
+			mismatch.UserID, mismatch.CachedPoints, mismatch.LedgerPoints, mismatch.DetectedAt)
+	}
+
+	return mismatches, nil
+}
+
+func (s *ledgerServiceImpl) GetBalanceMismatches() ([]*model.BalanceMismatch, error) {
+	return s.ledgerRepository.SearchBalanceMismatches()
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/model"
+)
+
+type ledgerServiceTestSuite struct {
+	ledgerService          LedgerService
+	mockedLedgerRepository *repository.MockLedgerRepository
+}
+
+func (s *ledgerServiceTestSuite) setUp(t *testing.T) {
+	s.mockedLedgerRepository = repository.NewMockLedgerRepository(t)
+	s.ledgerService = &ledgerServiceImpl{
+		ledgerRepository: s.mockedLedgerRepository,
+	}
+}
+
+func TestLedgerService(t *testing.T) {
+	testSuite := &ledgerServiceTestSuite{}
+
+	t.Run("ReconcileBalances", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		now := time.Now().UTC()
+		mismatches := []*model.BalanceMismatch{
+			{UserID: "test_user_id", CachedPoints: 150, LedgerPoints: 100, DetectedAt: now},
+		}
+		testSuite.mockedLedgerRepository.EXPECT().RefreshBalanceMismatches(now).Return(mismatches, nil).Times(1)
+
+		result, err := testSuite.ledgerService.ReconcileBalances(now)
+		assert.NoError(t, err)
+		assert.Equal(t, mismatches, result)
+	})
+
+	t.Run("ReconcileBalances Fail", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		now := time.Now().UTC()
+		testSuite.mockedLedgerRepository.EXPECT().RefreshBalanceMismatches(now).Return(nil, assert.AnError).Times(1)
+
+		_, err := testSuite.ledgerService.ReconcileBalances(now)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"cmp"
+	"log"
+	"slices"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type MilestoneService interface {
+	GetVolumeMilestones() []*model.MilestoneRule
+	// GetPendingVolumeMilestones returns the volume milestones the lifetime
+	// volume of the user reached and the user didn't achieve yet.
+	GetPendingVolumeMilestones(userID string) ([]*model.MilestoneRule, error)
+	// ClaimMilestone records that the user achieved the milestone, it tells
+	// false when the user already had so the milestone is rewarded once.
+	ClaimMilestone(userID string, milestone *model.MilestoneRule, now time.Time) (bool, error)
+	// ReleaseMilestone forgets a milestone whose reward failed, so the user
+	// can achieve it again.
+	ReleaseMilestone(userID string, milestone *model.MilestoneRule) error
+}
+
+type milestoneServiceImpl struct {
+	milestoneRepository repository.MilestoneRepository
+	volumeRepository    repository.VolumeRepository
+	volumeMilestones    []*model.MilestoneRule
+}
+
+func NewMilestoneService() MilestoneService {
+	var volumeMilestones []*model.MilestoneRule
+	if milestoneConfig := config.GetAppConfig().Milestone; milestoneConfig != nil {
+		for _, milestone := range milestoneConfig.Volume {
+			if milestone.Volume <= 0 || milestone.Reward <= 0 {
+				log.Printf("Skipping volume milestone of %f without volume or reward", milestone.Volume)
+				continue
+			}
+			volumeMilestones = append(volumeMilestones, model.VolumeMilestone(milestone.Volume, milestone.Reward))
+		}
+	}
+
+	slices.SortFunc(volumeMilestones, func(a, b *model.MilestoneRule) int {
+		return cmp.Compare(a.Threshold, b.Threshold)
+	})
+
+	return &milestoneServiceImpl{
+		milestoneRepository: repository.NewMilestoneRepository(),
+		volumeRepository:    repository.NewVolumeRepository(),
+		volumeMilestones:    volumeMilestones,
+	}
+}
+
+func (s *milestoneServiceImpl) GetVolumeMilestones() []*model.MilestoneRule {
+	return s.volumeMilestones
+}
+
+func (s *milestoneServiceImpl) GetPendingVolumeMilestones(userID string) ([]*model.MilestoneRule, error) {
+	if len(s.volumeMilestones) == 0 {
+		return nil, nil
+	}
+
+	volume, err := s.volumeRepository.GetVolume(userID, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	var reached []*model.MilestoneRule
+	for _, milestone := range s.volumeMilestones {
+		if milestone.ReachedBy(volume) {
+			reached = append(reached, milestone)
+		}
+	}
+
+	if len(reached) == 0 {
+		return nil, nil
+	}
+
+	achieved, err := s.milestoneRepository.GetAchievedMilestones(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(reached, func(milestone *model.MilestoneRule) bool {
+		return slices.Contains(achieved, milestone.Key)
+	}), nil
+}
+
+func (s *milestoneServiceImpl) ClaimMilestone(userID string, milestone *model.MilestoneRule, now time.Time) (bool, error) {
+	return s.milestoneRepository.ClaimMilestone(userID, milestone.Key, now)
+}
+
+func (s *milestoneServiceImpl) ReleaseMilestone(userID string, milestone *model.MilestoneRule) error {
+	return s.milestoneRepository.ReleaseMilestone(userID, milestone.Key)
+}
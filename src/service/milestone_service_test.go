@@ -0,0 +1,77 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/model"
+)
+
+type milestoneServiceTestSuite struct {
+	milestoneService          MilestoneService
+	mockedMilestoneRepository *repository.MockMilestoneRepository
+	mockedVolumeRepository    *repository.MockVolumeRepository
+}
+
+var testVolumeMilestones = []*model.MilestoneRule{
+	model.VolumeMilestone(10000, 100),
+	model.VolumeMilestone(100000, 1000),
+	model.VolumeMilestone(1000000, 10000),
+}
+
+func (s *milestoneServiceTestSuite) setUp(t *testing.T) {
+	s.mockedMilestoneRepository = repository.NewMockMilestoneRepository(t)
+	s.mockedVolumeRepository = repository.NewMockVolumeRepository(t)
+	s.milestoneService = &milestoneServiceImpl{
+		milestoneRepository: s.mockedMilestoneRepository,
+		volumeRepository:    s.mockedVolumeRepository,
+		volumeMilestones:    testVolumeMilestones,
+	}
+}
+
+func TestMilestoneServiceImpl_GetPendingVolumeMilestones(t *testing.T) {
+	testSuite := &milestoneServiceTestSuite{}
+
+	t.Run("Reached And Not Achieved", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user", time.Time{}).Return(150000.0, nil).Times(1)
+		testSuite.mockedMilestoneRepository.EXPECT().GetAchievedMilestones("test_user").Return([]string{"on_boarding:1", "volume:10000"}, nil).Times(1)
+
+		milestones, err := testSuite.milestoneService.GetPendingVolumeMilestones("test_user")
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.MilestoneRule{testVolumeMilestones[1]}, milestones)
+	})
+
+	t.Run("None Reached", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user", time.Time{}).Return(9999.0, nil).Times(1)
+
+		milestones, err := testSuite.milestoneService.GetPendingVolumeMilestones("test_user")
+		assert.NoError(t, err)
+		assert.Empty(t, milestones)
+	})
+
+	t.Run("No Milestones", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.milestoneService.(*milestoneServiceImpl).volumeMilestones = nil
+
+		milestones, err := testSuite.milestoneService.GetPendingVolumeMilestones("test_user")
+		assert.NoError(t, err)
+		assert.Empty(t, milestones)
+	})
+}
+
+func TestMilestoneServiceImpl_ClaimMilestone(t *testing.T) {
+	testSuite := &milestoneServiceTestSuite{}
+	testSuite.setUp(t)
+	now := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+
+	testSuite.mockedMilestoneRepository.EXPECT().ClaimMilestone("test_user", "volume:100000", now).Return(false, nil).Times(1)
+
+	claimed, err := testSuite.milestoneService.ClaimMilestone("test_user", testVolumeMilestones[1], now)
+	assert.NoError(t, err)
+	assert.False(t, claimed)
+}
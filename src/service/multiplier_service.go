@@ -0,0 +1,81 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"math"
+	"strings"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type MultiplierService interface {
+	CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error)
+	GetMultipliers(campaignID int) ([]*model.Multiplier, error)
+	GetActiveMultipliers(campaignID int, from time.Time, to time.Time) ([]*model.Multiplier, error)
+	DeleteMultiplier(id int) error
+}
+
+type multiplierServiceImpl struct {
+	multiplierRepository repository.MultiplierRepository
+	campaignService      CampaignService
+}
+
+func NewMultiplierService() MultiplierService {
+	return &multiplierServiceImpl{
+		multiplierRepository: repository.NewMultiplierRepository(),
+		campaignService:      NewCampaignService(),
+	}
+}
+
+func (s *multiplierServiceImpl) CreateMultiplier(multiplier *model.Multiplier) (*model.Multiplier, error) {
+	multiplier.Name = strings.TrimSpace(multiplier.Name)
+
+	switch {
+	case multiplier.Name == "":
+		return nil, fmt.Errorf("%w: name is required", exception.InvalidMultiplierError)
+	case multiplier.Factor <= 0 || math.IsInf(multiplier.Factor, 0):
+		return nil, fmt.Errorf("%w: factor should be greater than 0", exception.InvalidMultiplierError)
+	case multiplier.StartTime.IsZero():
+		return nil, fmt.Errorf("%w: start time is required", exception.InvalidMultiplierError)
+	case multiplier.EndTime != nil && !multiplier.EndTime.After(multiplier.StartTime):
+		return nil, fmt.Errorf("%w: end time should be after start time", exception.InvalidMultiplierError)
+	}
+
+	for _, userID := range multiplier.UserIDs {
+		if !common.IsHexAddress(userID) {
+			return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, userID)
+		}
+	}
+
+	if multiplier.CampaignID != 0 {
+		if _, err := s.campaignService.GetCampaign(multiplier.CampaignID); err != nil {
+			return nil, err
+		}
+	}
+
+	multiplier.CreatedAt = time.Now().UTC()
+	return s.multiplierRepository.CreateMultiplier(multiplier)
+}
+
+// GetMultipliers returns the multipliers of the campaign, including the ones of
+// every campaign, or all of them for campaign 0.
+func (s *multiplierServiceImpl) GetMultipliers(campaignID int) ([]*model.Multiplier, error) {
+	return s.multiplierRepository.SearchMultipliers(&repository.SearchMultipliersCondition{CampaignID: campaignID})
+}
+
+// GetActiveMultipliers returns the multipliers of the campaign active at some
+// point of [from, to), to be applied with model.ApplyMultipliers.
+func (s *multiplierServiceImpl) GetActiveMultipliers(campaignID int, from time.Time, to time.Time) ([]*model.Multiplier, error) {
+	return s.multiplierRepository.SearchMultipliers(&repository.SearchMultipliersCondition{
+		CampaignID: campaignID,
+		From:       from,
+		To:         to,
+	})
+}
+
+func (s *multiplierServiceImpl) DeleteMultiplier(id int) error {
+	return s.multiplierRepository.DeleteMultiplier(id)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	repoReal "trading-ace/src/repository"
+)
+
+type multiplierServiceTestSuite struct {
+	multiplierService          MultiplierService
+	mockedMultiplierRepository *repository.MockMultiplierRepository
+	mockedCampaignService      *service.MockCampaignService
+}
+
+func (s *multiplierServiceTestSuite) setUp(t *testing.T) {
+	s.mockedMultiplierRepository = repository.NewMockMultiplierRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.multiplierService = &multiplierServiceImpl{
+		multiplierRepository: s.mockedMultiplierRepository,
+		campaignService:      s.mockedCampaignService,
+	}
+}
+
+func TestMultiplierServiceImpl_CreateMultiplier(t *testing.T) {
+	testSuite := &multiplierServiceTestSuite{}
+	startTime := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(48 * time.Hour)
+
+	t.Run("Success", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(&model.Campaign{ID: 1}, nil).Times(1)
+		testSuite.mockedMultiplierRepository.EXPECT().CreateMultiplier(mock.MatchedBy(func(multiplier *model.Multiplier) bool {
+			return multiplier.Name == "weekend" && multiplier.Factor == 2 && !multiplier.CreatedAt.IsZero()
+		})).RunAndReturn(func(multiplier *model.Multiplier) (*model.Multiplier, error) {
+			multiplier.ID = 3
+			return multiplier, nil
+		}).Times(1)
+
+		multiplier, err := testSuite.multiplierService.CreateMultiplier(&model.Multiplier{
+			Name:       " weekend ",
+			Factor:     2,
+			CampaignID: 1,
+			UserIDs:    []string{"0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"},
+			StartTime:  startTime,
+			EndTime:    &endTime,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 3, multiplier.ID)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		for _, multiplier := range []*model.Multiplier{
+			{Factor: 2, StartTime: startTime},
+			{Name: "weekend", Factor: 0, StartTime: startTime},
+			{Name: "weekend", Factor: 2},
+			{Name: "weekend", Factor: 2, StartTime: endTime, EndTime: &startTime},
+		} {
+			_, err := testSuite.multiplierService.CreateMultiplier(multiplier)
+			assert.ErrorIs(t, err, exception.InvalidMultiplierError)
+		}
+
+		_, err := testSuite.multiplierService.CreateMultiplier(&model.Multiplier{
+			Name: "weekend", Factor: 2, StartTime: startTime, UserIDs: []string{"not an address"},
+		})
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+
+	t.Run("Unknown Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(9).Return(nil, exception.CampaignNotFoundError).Times(1)
+
+		_, err := testSuite.multiplierService.CreateMultiplier(&model.Multiplier{Name: "weekend", Factor: 2, CampaignID: 9, StartTime: startTime})
+		assert.ErrorIs(t, err, exception.CampaignNotFoundError)
+	})
+}
+
+func TestMultiplierServiceImpl_GetActiveMultipliers(t *testing.T) {
+	testSuite := &multiplierServiceTestSuite{}
+	testSuite.setUp(t)
+
+	from := time.Date(2024, 9, 7, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	multipliers := []*model.Multiplier{{ID: 1, Name: "weekend", Factor: 2, StartTime: from}}
+
+	testSuite.mockedMultiplierRepository.EXPECT().SearchMultipliers(&repoReal.SearchMultipliersCondition{
+		CampaignID: 1,
+		From:       from,
+		To:         to,
+	}).Return(multipliers, nil).Times(1)
+
+	active, err := testSuite.multiplierService.GetActiveMultipliers(1, from, to)
+	assert.NoError(t, err)
+	assert.Equal(t, multipliers, active)
+}
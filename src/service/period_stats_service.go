@@ -0,0 +1,141 @@
+package service
+
+import (
+	"log"
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type PeriodStatsService interface {
+	RecordSwap(swapID string, campaign *model.Campaign, userID string, at time.Time, swapAmount float64, onboarded bool, tier *model.Tier) error
+	RefreshPeriod(campaign *model.Campaign, periodIndex int) error
+	GetCurrentProjections(userID string, now time.Time) ([]*model.RewardProjection, error)
+}
+
+type periodStatsServiceImpl struct {
+	periodStatsRepository repository.PeriodStatsRepository
+	campaignService       CampaignService
+	multiplierService     MultiplierService
+	tierService           TierService
+}
+
+func NewPeriodStatsService() PeriodStatsService {
+	return &periodStatsServiceImpl{
+		periodStatsRepository: repository.NewPeriodStatsRepository(),
+		campaignService:       NewCampaignService(),
+		multiplierService:     NewMultiplierService(),
+		tierService:           NewTierService(),
+	}
+}
+
+// RecordSwap adds a swap to the stats of the campaign period it happened in,
+// weighed like its shared pool task by the multipliers active when it happened
+// and the tier of the user. A swap counts once per campaign.
+func (s *periodStatsServiceImpl) RecordSwap(swapID string, campaign *model.Campaign, userID string, at time.Time, swapAmount float64, onboarded bool, tier *model.Tier) error {
+	periodIndex, ok := campaign.PeriodIndexAt(at)
+	if !ok {
+		log.Printf("Swap of %s at %s is outside campaign %d", userID, at, campaign.ID)
+		return nil
+	}
+
+	start, end := campaign.PeriodWindow(periodIndex)
+	multipliers, err := s.multiplierService.GetActiveMultipliers(campaign.ID, start, end)
+	if err != nil {
+		return err
+	}
+
+	factor, _ := model.ApplyMultipliers(multipliers, userID, campaign.ID, at)
+
+	return s.periodStatsRepository.AddSwap(swapID, &model.UserPeriodStats{
+		CampaignID:  campaign.ID,
+		PeriodIndex: periodIndex,
+		UserID:      userID,
+		Volume:      swapAmount,
+		SwapCount:   1,
+		Onboarded:   onboarded,
+		Weight:      swapAmount * factor * tier.Factor(),
+		UpdatedAt:   at,
+	})
+}
+
+// RefreshPeriod rebuilds the stats of a period from its tasks and rewards, so
+// settled points show up and drift from missed swap updates is corrected.
+func (s *periodStatsServiceImpl) RefreshPeriod(campaign *model.Campaign, periodIndex int) error {
+	start, end := campaign.PeriodWindow(periodIndex)
+	return s.periodStatsRepository.RefreshPeriodStats(campaign.ID, periodIndex, start, end)
+}
+
+// GetCurrentProjections projects the shared pool reward of the user for the
+// running period of every active campaign from the stats, as if the period
+// closed now: the share of the weight of the onboarded users, with the bonus
+// of the current tier of the user capped per swap.
+func (s *periodStatsServiceImpl) GetCurrentProjections(userID string, now time.Time) ([]*model.RewardProjection, error) {
+	campaigns, err := s.campaignService.SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive})
+	if err != nil {
+		return nil, err
+	}
+
+	projections := make([]*model.RewardProjection, 0, len(campaigns))
+	for _, campaign := range campaigns {
+		periodIndex, ok := campaign.PeriodIndexAt(now)
+		if !ok {
+			continue
+		}
+
+		projection, err := s.project(campaign, periodIndex, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		projections = append(projections, projection)
+	}
+
+	return projections, nil
+}
+
+func (s *periodStatsServiceImpl) project(campaign *model.Campaign, periodIndex int, userID string) (*model.RewardProjection, error) {
+	totals, err := s.periodStatsRepository.GetPeriodTotals(campaign.ID, periodIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.periodStatsRepository.GetUserPeriodStats(campaign.ID, periodIndex, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := campaign.PeriodWindow(periodIndex)
+	projection := &model.RewardProjection{
+		CampaignID:   campaign.ID,
+		CampaignName: campaign.Name,
+		PeriodIndex:  periodIndex,
+		StartTime:    start,
+		EndTime:      end,
+		Budget:       campaign.BudgetOfPeriod(periodIndex),
+		Totals:       *totals,
+	}
+
+	if stats == nil {
+		return projection, nil
+	}
+
+	projection.UserVolume = stats.Volume
+	projection.Onboarded = stats.Onboarded
+
+	if !stats.Onboarded || totals.EligibleWeight <= 0 || stats.SwapCount == 0 {
+		return projection, nil
+	}
+
+	tiers, err := s.tierService.GetUserTiers([]string{userID})
+	if err != nil {
+		return nil, err
+	}
+
+	projection.Share = stats.Weight / totals.EligibleWeight
+	// the settlement caps the bonus of every task, as if the swaps weighed the same
+	swaps := float64(stats.SwapCount)
+	projection.ProjectedPoints = tiers[userID].CapBonus(projection.Budget*projection.Share/swaps) * swaps
+
+	return projection, nil
+}
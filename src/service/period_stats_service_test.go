@@ -0,0 +1,144 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/model"
+)
+
+type periodStatsServiceTestSuite struct {
+	periodStatsService          PeriodStatsService
+	mockedPeriodStatsRepository *repository.MockPeriodStatsRepository
+	mockedCampaignService       *service.MockCampaignService
+	mockedMultiplierService     *service.MockMultiplierService
+	mockedTierService           *service.MockTierService
+}
+
+func (s *periodStatsServiceTestSuite) setUp(t *testing.T) {
+	s.mockedPeriodStatsRepository = repository.NewMockPeriodStatsRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.mockedMultiplierService = service.NewMockMultiplierService(t)
+	s.mockedTierService = service.NewMockTierService(t)
+	s.periodStatsService = &periodStatsServiceImpl{
+		periodStatsRepository: s.mockedPeriodStatsRepository,
+		campaignService:       s.mockedCampaignService,
+		multiplierService:     s.mockedMultiplierService,
+		tierService:           s.mockedTierService,
+	}
+}
+
+func TestPeriodStatsServiceImpl_RecordSwap(t *testing.T) {
+	testSuite := &periodStatsServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Record In Running Period", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		swapTime := startTime.Add(time.Hour * 24 * 8)
+		start, end := campaign.PeriodWindow(1)
+
+		testSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, start, end).Return([]*model.Multiplier{
+			{ID: 1, Name: "happy hour", Factor: 3, StartTime: swapTime.Add(-time.Hour)},
+			{ID: 2, Name: "later", Factor: 2, StartTime: swapTime.Add(time.Hour)},
+		}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().AddSwap("test_swap", &model.UserPeriodStats{
+			CampaignID:  1,
+			PeriodIndex: 1,
+			UserID:      "test_user",
+			Volume:      500,
+			SwapCount:   1,
+			Onboarded:   true,
+			Weight:      500 * 3 * 1.25,
+			UpdatedAt:   swapTime,
+		}).Return(nil).Times(1)
+
+		err := testSuite.periodStatsService.RecordSwap("test_swap", campaign, "test_user", swapTime, 500, true, &model.Tier{Name: "gold", Multiplier: 1.25})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Ignore Swap Outside Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		err := testSuite.periodStatsService.RecordSwap("test_swap", newTestCampaign(startTime), "test_user", startTime.Add(-time.Hour), 500, true, nil)
+		assert.Nil(t, err)
+	})
+}
+
+func TestPeriodStatsServiceImpl_RefreshPeriod(t *testing.T) {
+	testSuite := &periodStatsServiceTestSuite{}
+	testSuite.setUp(t)
+
+	campaign := newTestCampaign(time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC))
+	start, end := campaign.PeriodWindow(2)
+
+	testSuite.mockedPeriodStatsRepository.EXPECT().RefreshPeriodStats(1, 2, start, end).Return(nil).Times(1)
+
+	err := testSuite.periodStatsService.RefreshPeriod(campaign, 2)
+	assert.Nil(t, err)
+}
+
+func TestPeriodStatsServiceImpl_GetCurrentProjections(t *testing.T) {
+	testSuite := &periodStatsServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	now := startTime.Add(time.Hour * 24 * 3)
+
+	t.Run("Project Onboarded User", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		finished := newTestCampaign(startTime.Add(-time.Hour * 24 * 7 * 5))
+		finished.ID = 2
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive}).
+			Return([]*model.Campaign{campaign, finished}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetPeriodTotals(1, 0).
+			Return(&model.PeriodTotals{Volume: 5000, EligibleVolume: 4000, EligibleWeight: 5000, Participants: 3}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetUserPeriodStats(1, 0, "test_user").
+			Return(&model.UserPeriodStats{Volume: 1000, SwapCount: 2, Onboarded: true, Weight: 1500}, nil).Times(1)
+		testSuite.mockedTierService.EXPECT().GetUserTiers([]string{"test_user"}).Return(map[string]*model.Tier{
+			"test_user": {Name: "gold", Multiplier: 1.5, BonusCap: 400},
+		}, nil).Times(1)
+
+		projections, err := testSuite.periodStatsService.GetCurrentProjections("test_user", now)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(projections))
+		assert.InDelta(t, 0.3, projections[0].Share, 1e-9)
+		// each swap earns 1500 points, 500 of them the gold bonus capped at 400
+		assert.InDelta(t, 2800.0, projections[0].ProjectedPoints, 1e-9)
+		assert.Equal(t, 5000.0, projections[0].Totals.Volume)
+	})
+
+	t.Run("Not Onboarded User Gets Nothing", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).
+			Return([]*model.Campaign{newTestCampaign(startTime)}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetPeriodTotals(1, 0).
+			Return(&model.PeriodTotals{Volume: 5000, EligibleVolume: 4000, Participants: 3}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetUserPeriodStats(1, 0, "test_user").
+			Return(&model.UserPeriodStats{Volume: 1000, SwapCount: 1}, nil).Times(1)
+
+		projections, err := testSuite.periodStatsService.GetCurrentProjections("test_user", now)
+		assert.Nil(t, err)
+		assert.Equal(t, 1000.0, projections[0].UserVolume)
+		assert.Equal(t, 0.0, projections[0].ProjectedPoints)
+	})
+
+	t.Run("No Swap Yet", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).
+			Return([]*model.Campaign{newTestCampaign(startTime)}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetPeriodTotals(1, 0).Return(&model.PeriodTotals{}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetUserPeriodStats(1, 0, "test_user").Return(nil, nil).Times(1)
+
+		projections, err := testSuite.periodStatsService.GetCurrentProjections("test_user", now)
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, projections[0].ProjectedPoints)
+	})
+}
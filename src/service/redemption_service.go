@@ -0,0 +1,121 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"log"
+	"strings"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type RedemptionService interface {
+	GetCatalogue() []*model.CatalogueItem
+	RequestRedemption(address string, itemID string, now time.Time) (*model.Redemption, error)
+	GetRedemptions(address string) ([]*model.Redemption, error)
+	SearchRedemptions(statuses []model.RedemptionStatus) ([]*model.Redemption, error)
+	FulfillRedemption(id int, operator string, now time.Time) (*model.Redemption, error)
+	CancelRedemption(id int, reason string, operator string, now time.Time) (*model.Redemption, error)
+}
+
+type redemptionServiceImpl struct {
+	redemptionRepository repository.RedemptionRepository
+	catalogue            []*model.CatalogueItem
+}
+
+func NewRedemptionService() RedemptionService {
+	var catalogue []*model.CatalogueItem
+	if redemptionConfig := config.GetAppConfig().Redemption; redemptionConfig != nil {
+		for _, item := range redemptionConfig.Catalogue {
+			if item.ID == "" || item.Points <= 0 {
+				log.Printf("Skipping catalogue item %q without id or points", item.Name)
+				continue
+			}
+			catalogue = append(catalogue, &model.CatalogueItem{ID: item.ID, Name: item.Name, Points: item.Points})
+		}
+	}
+
+	return &redemptionServiceImpl{
+		redemptionRepository: repository.NewRedemptionRepository(),
+		catalogue:            catalogue,
+	}
+}
+
+func (s *redemptionServiceImpl) GetCatalogue() []*model.CatalogueItem {
+	return s.catalogue
+}
+
+// RequestRedemption spends the points of the catalogue item right away, the
+// redemption is then pending until an operator fulfills or cancels it.
+func (s *redemptionServiceImpl) RequestRedemption(address string, itemID string, now time.Time) (*model.Redemption, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	var item *model.CatalogueItem
+	for _, catalogueItem := range s.catalogue {
+		if catalogueItem.ID == itemID {
+			item = catalogueItem
+			break
+		}
+	}
+
+	if item == nil {
+		return nil, fmt.Errorf("%w: unknown item %s", exception.InvalidRedemptionError, itemID)
+	}
+
+	return s.redemptionRepository.CreateRedemption(&model.Redemption{
+		UserID:    common.HexToAddress(address).Hex(),
+		ItemID:    item.ID,
+		Points:    item.Points,
+		CreatedAt: now.UTC(),
+	})
+}
+
+func (s *redemptionServiceImpl) GetRedemptions(address string) ([]*model.Redemption, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	return s.redemptionRepository.SearchRedemptions(&repository.SearchRedemptionsCondition{
+		UserID: common.HexToAddress(address).Hex(),
+	})
+}
+
+func (s *redemptionServiceImpl) SearchRedemptions(statuses []model.RedemptionStatus) ([]*model.Redemption, error) {
+	for _, status := range statuses {
+		if !status.IsValid() {
+			return nil, fmt.Errorf("%w: unknown status %s", exception.InvalidRedemptionError, status)
+		}
+	}
+
+	return s.redemptionRepository.SearchRedemptions(&repository.SearchRedemptionsCondition{Statuses: statuses})
+}
+
+func (s *redemptionServiceImpl) FulfillRedemption(id int, operator string, now time.Time) (*model.Redemption, error) {
+	return s.redemptionRepository.FulfillRedemption(id, operator, now, auditRedemption(model.AuditActionFulfillRedemption))
+}
+
+// CancelRedemption refunds the points of a pending redemption.
+func (s *redemptionServiceImpl) CancelRedemption(id int, reason string, operator string, now time.Time) (*model.Redemption, error) {
+	if strings.TrimSpace(reason) == "" {
+		return nil, fmt.Errorf("%w: reason is required", exception.InvalidRedemptionError)
+	}
+
+	return s.redemptionRepository.CancelRedemption(id, strings.TrimSpace(reason), operator, now, auditRedemption(model.AuditActionCancelRedemption))
+}
+
+// auditRedemption builds the audit log of a redemption closed by an operator.
+func auditRedemption(action model.AuditAction) func(redemption *model.Redemption) *model.AuditLog {
+	return func(redemption *model.Redemption) *model.AuditLog {
+		return model.NewAuditLog(redemption.Operator, action, fmt.Sprintf("redemption:%d", redemption.ID), map[string]any{
+			"user_id": redemption.UserID,
+			"item_id": redemption.ItemID,
+			"points":  redemption.Points,
+			"reason":  redemption.Reason,
+		})
+	}
+}
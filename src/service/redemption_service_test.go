@@ -0,0 +1,139 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"strings"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	repoReal "trading-ace/src/repository"
+)
+
+const testRedemptionAddress = "0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed"
+
+type redemptionServiceTestSuite struct {
+	redemptionService          RedemptionService
+	mockedRedemptionRepository *repository.MockRedemptionRepository
+}
+
+func (s *redemptionServiceTestSuite) setUp(t *testing.T) {
+	s.mockedRedemptionRepository = repository.NewMockRedemptionRepository(t)
+	s.redemptionService = &redemptionServiceImpl{
+		redemptionRepository: s.mockedRedemptionRepository,
+		catalogue: []*model.CatalogueItem{
+			{ID: "mug", Name: "Mug", Points: 60},
+		},
+	}
+}
+
+func TestRedemptionService(t *testing.T) {
+	testSuite := &redemptionServiceTestSuite{}
+	now := time.Now().UTC()
+
+	t.Run("RequestRedemption", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().CreateRedemption(&model.Redemption{
+			UserID:    testRedemptionAddress,
+			ItemID:    "mug",
+			Points:    60,
+			CreatedAt: now,
+		}).Return(&model.Redemption{ID: 1, Status: model.RedemptionStatusPending}, nil).Times(1)
+
+		redemption, err := testSuite.redemptionService.RequestRedemption(strings.ToLower(testRedemptionAddress), "mug", now)
+		assert.NoError(t, err)
+		assert.Equal(t, model.RedemptionStatusPending, redemption.Status)
+	})
+
+	t.Run("RequestRedemption of Unknown Item", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.redemptionService.RequestRedemption(testRedemptionAddress, "car", now)
+		assert.ErrorIs(t, err, exception.InvalidRedemptionError)
+	})
+
+	t.Run("RequestRedemption with Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.redemptionService.RequestRedemption("not_an_address", "mug", now)
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+
+	t.Run("GetRedemptions", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().SearchRedemptions(&repoReal.SearchRedemptionsCondition{
+			UserID: testRedemptionAddress,
+		}).Return(nil, nil).Times(1)
+
+		_, err := testSuite.redemptionService.GetRedemptions(strings.ToLower(testRedemptionAddress))
+		assert.NoError(t, err)
+	})
+
+	t.Run("RequestRedemption with Insufficient Points", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().CreateRedemption(mock.Anything).Return(nil, exception.InsufficientPointsError).Times(1)
+
+		_, err := testSuite.redemptionService.RequestRedemption(testRedemptionAddress, "mug", now)
+		assert.ErrorIs(t, err, exception.InsufficientPointsError)
+	})
+
+	t.Run("SearchRedemptions", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().SearchRedemptions(&repoReal.SearchRedemptionsCondition{
+			Statuses: []model.RedemptionStatus{model.RedemptionStatusPending},
+		}).Return(nil, nil).Times(1)
+
+		_, err := testSuite.redemptionService.SearchRedemptions([]model.RedemptionStatus{model.RedemptionStatusPending})
+		assert.NoError(t, err)
+
+		_, err = testSuite.redemptionService.SearchRedemptions([]model.RedemptionStatus{"lost"})
+		assert.ErrorIs(t, err, exception.InvalidRedemptionError)
+	})
+
+	t.Run("CancelRedemption", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().CancelRedemption(1, "out of stock", "ops", now, mock.Anything).
+			RunAndReturn(func(id int, reason string, operator string, now time.Time, audit func(*model.Redemption) *model.AuditLog) (*model.Redemption, error) {
+				redemption := &model.Redemption{
+					ID:       1,
+					UserID:   testRedemptionAddress,
+					Status:   model.RedemptionStatusCancelled,
+					Reason:   reason,
+					Operator: operator,
+				}
+
+				auditLog := audit(redemption)
+				assert.Equal(t, "ops", auditLog.Operator)
+				assert.Equal(t, model.AuditActionCancelRedemption, auditLog.Action)
+				assert.Equal(t, "redemption:1", auditLog.Target)
+				return redemption, nil
+			}).Times(1)
+
+		redemption, err := testSuite.redemptionService.CancelRedemption(1, " out of stock ", "ops", now)
+		assert.NoError(t, err)
+		assert.Equal(t, model.RedemptionStatusCancelled, redemption.Status)
+	})
+
+	t.Run("CancelRedemption without Reason", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.redemptionService.CancelRedemption(1, " ", "ops", now)
+		assert.ErrorIs(t, err, exception.InvalidRedemptionError)
+	})
+
+	t.Run("FulfillRedemption not Pending", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedRedemptionRepository.EXPECT().FulfillRedemption(1, "ops", now, mock.Anything).Return(nil, exception.RedemptionNotPendingError).Times(1)
+
+		_, err := testSuite.redemptionService.FulfillRedemption(1, "ops", now)
+		assert.ErrorIs(t, err, exception.RedemptionNotPendingError)
+	})
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"log"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+	"trading-ace/src/siwe"
+)
+
+type ReferralService interface {
+	GetRewards() *model.ReferralRewards
+	RegisterCode(userID string, code string) (*model.ReferralCode, error)
+	// LinkReferral links the referee to the code, with the signature of
+	// model.ReferralLinkMessage by the referee.
+	LinkReferral(refereeID string, code string, signature string) (*model.Referral, error)
+	GetReferralInfo(userID string) (*model.ReferralInfo, error)
+	// OnboardReferee returns the referral of the referee the first time it
+	// onboards, nil when it wasn't referred or already onboarded.
+	OnboardReferee(refereeID string, now time.Time) (*model.Referral, error)
+	// ReleaseReferee forgets the onboarding of a referee whose referrer reward
+	// failed, so the next swap of the referee pays it.
+	ReleaseReferee(refereeID string) error
+	// GetReferrers returns the referrer of each onboarded referee.
+	GetReferrers(refereeIDs []string) (map[string]string, error)
+	// ClaimSharedPoolReward tells whether the referrer is still to be paid its
+	// share of the shared pool task of a referee, and claims it.
+	ClaimSharedPoolReward(taskID int, referrerID string, now time.Time) (bool, error)
+	ReleaseSharedPoolReward(taskID int) error
+}
+
+type referralServiceImpl struct {
+	referralRepository repository.ReferralRepository
+	volumeRepository   repository.VolumeRepository
+	userService        UserService
+	rewards            *model.ReferralRewards
+}
+
+func NewReferralService() ReferralService {
+	rewards := &model.ReferralRewards{}
+	if referralConfig := config.GetAppConfig().Referral; referralConfig != nil {
+		rewards.OnboardingReward = max(referralConfig.ReferrerReward, 0)
+		if referralConfig.SharedPoolShare < 0 || referralConfig.SharedPoolShare > 1 {
+			log.Printf("Ignoring referral shared pool share %f out of [0, 1]", referralConfig.SharedPoolShare)
+		} else {
+			rewards.SharedPoolShare = referralConfig.SharedPoolShare
+		}
+	}
+
+	return &referralServiceImpl{
+		referralRepository: repository.NewReferralRepository(),
+		volumeRepository:   repository.NewVolumeRepository(),
+		userService:        NewUserService(),
+		rewards:            rewards,
+	}
+}
+
+func (s *referralServiceImpl) GetRewards() *model.ReferralRewards {
+	return s.rewards
+}
+
+// RegisterCode gives its referral code to a user who already swapped, users
+// have a single code.
+func (s *referralServiceImpl) RegisterCode(userID string, code string) (*model.ReferralCode, error) {
+	if !common.IsHexAddress(userID) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, userID)
+	}
+
+	code = model.NormalizeReferralCode(code)
+	if !model.IsValidReferralCode(code) {
+		return nil, fmt.Errorf("%w: should be 4 to 32 letters or digits", exception.InvalidReferralCodeError)
+	}
+
+	userID = common.HexToAddress(userID).Hex()
+	if _, err := s.userService.GetUserByID(userID); err != nil {
+		return nil, err
+	}
+
+	return s.referralRepository.CreateCode(&model.ReferralCode{
+		Code:      code,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+// LinkReferral only links new addresses, which never swapped, to the code of
+// someone else.
+func (s *referralServiceImpl) LinkReferral(refereeID string, code string, signature string) (*model.Referral, error) {
+	if !common.IsHexAddress(refereeID) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, refereeID)
+	}
+
+	referee := common.HexToAddress(refereeID)
+	code = model.NormalizeReferralCode(code)
+	if err := siwe.VerifyAddressSignature(model.ReferralLinkMessage(code, referee.Hex()), referee, signature); err != nil {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidReferralError, err)
+	}
+
+	referralCode, err := s.referralRepository.GetCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	if referralCode.UserID == referee.Hex() {
+		return nil, fmt.Errorf("%w: users can't refer themselves", exception.InvalidReferralError)
+	}
+
+	volume, err := s.volumeRepository.GetVolume(referee.Hex(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	if volume > 0 {
+		return nil, fmt.Errorf("%w: %s already swapped", exception.InvalidReferralError, referee.Hex())
+	}
+
+	return s.referralRepository.CreateReferral(&model.Referral{
+		RefereeID:  referee.Hex(),
+		ReferrerID: referralCode.UserID,
+		Code:       referralCode.Code,
+		CreatedAt:  time.Now().UTC(),
+	})
+}
+
+func (s *referralServiceImpl) GetReferralInfo(userID string) (*model.ReferralInfo, error) {
+	if !common.IsHexAddress(userID) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, userID)
+	}
+
+	userID = common.HexToAddress(userID).Hex()
+	code, err := s.referralRepository.GetCodeOfUser(userID)
+	if err != nil && !errors.Is(err, exception.ReferralCodeNotFoundError) {
+		return nil, err
+	}
+
+	referredBy, err := s.referralRepository.GetReferral(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	referrals, err := s.referralRepository.SearchReferrals(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ReferralInfo{
+		Code:       code,
+		ReferredBy: referredBy,
+		Referrals:  referrals,
+	}, nil
+}
+
+func (s *referralServiceImpl) OnboardReferee(refereeID string, now time.Time) (*model.Referral, error) {
+	return s.referralRepository.MarkOnboarded(refereeID, now)
+}
+
+func (s *referralServiceImpl) ReleaseReferee(refereeID string) error {
+	return s.referralRepository.UnmarkOnboarded(refereeID)
+}
+
+func (s *referralServiceImpl) GetReferrers(refereeIDs []string) (map[string]string, error) {
+	return s.referralRepository.GetReferrers(refereeIDs)
+}
+
+func (s *referralServiceImpl) ClaimSharedPoolReward(taskID int, referrerID string, now time.Time) (bool, error) {
+	return s.referralRepository.ClaimReward(taskID, referrerID, now)
+}
+
+func (s *referralServiceImpl) ReleaseSharedPoolReward(taskID int) error {
+	return s.referralRepository.ReleaseReward(taskID)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type referralServiceTestSuite struct {
+	referralService          ReferralService
+	mockedReferralRepository *repository.MockReferralRepository
+	mockedVolumeRepository   *repository.MockVolumeRepository
+	mockedUserService        *service.MockUserService
+}
+
+func (s *referralServiceTestSuite) setUp(t *testing.T) {
+	s.mockedReferralRepository = repository.NewMockReferralRepository(t)
+	s.mockedVolumeRepository = repository.NewMockVolumeRepository(t)
+	s.mockedUserService = service.NewMockUserService(t)
+	s.referralService = &referralServiceImpl{
+		referralRepository: s.mockedReferralRepository,
+		volumeRepository:   s.mockedVolumeRepository,
+		userService:        s.mockedUserService,
+		rewards:            &model.ReferralRewards{OnboardingReward: 50, SharedPoolShare: 0.1},
+	}
+}
+
+const (
+	testReferrer = "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	// testReferee is the address of the key of signSIWEMessage
+	testReferee = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+)
+
+func TestReferralServiceImpl_RegisterCode(t *testing.T) {
+	testSuite := &referralServiceTestSuite{}
+
+	t.Run("Success", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedUserService.EXPECT().GetUserByID(testReferrer).Return(&model.User{ID: testReferrer}, nil).Times(1)
+		testSuite.mockedReferralRepository.EXPECT().CreateCode(mock.MatchedBy(func(code *model.ReferralCode) bool {
+			return code.Code == "ALICE" && code.UserID == testReferrer
+		})).RunAndReturn(func(code *model.ReferralCode) (*model.ReferralCode, error) {
+			return code, nil
+		}).Times(1)
+
+		code, err := testSuite.referralService.RegisterCode("0x70997970c51812dc3a010c7d01b50e0d17dc79c8", " alice ")
+		assert.NoError(t, err)
+		assert.Equal(t, "ALICE", code.Code)
+	})
+
+	t.Run("Invalid Code", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.referralService.RegisterCode(testReferrer, "a-b")
+		assert.ErrorIs(t, err, exception.InvalidReferralCodeError)
+	})
+
+	t.Run("Unknown User", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedUserService.EXPECT().GetUserByID(testReferrer).Return(nil, exception.UserNotFoundError).Times(1)
+
+		_, err := testSuite.referralService.RegisterCode(testReferrer, "ALICE")
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+}
+
+func TestReferralServiceImpl_LinkReferral(t *testing.T) {
+	testSuite := &referralServiceTestSuite{}
+	signature := signSIWEMessage(model.ReferralLinkMessage("ALICE", testReferee))
+
+	t.Run("Success", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedReferralRepository.EXPECT().GetCode("ALICE").Return(&model.ReferralCode{Code: "ALICE", UserID: testReferrer}, nil).Times(1)
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume(testReferee, time.Time{}).Return(0.0, nil).Times(1)
+		testSuite.mockedReferralRepository.EXPECT().CreateReferral(mock.MatchedBy(func(referral *model.Referral) bool {
+			return referral.RefereeID == testReferee && referral.ReferrerID == testReferrer && referral.Code == "ALICE"
+		})).RunAndReturn(func(referral *model.Referral) (*model.Referral, error) {
+			return referral, nil
+		}).Times(1)
+
+		referral, err := testSuite.referralService.LinkReferral(testReferee, "alice", signature)
+		assert.NoError(t, err)
+		assert.Equal(t, testReferrer, referral.ReferrerID)
+	})
+
+	t.Run("Signed By Someone Else", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.referralService.LinkReferral(testReferrer, "ALICE", signature)
+		assert.ErrorIs(t, err, exception.InvalidReferralError)
+	})
+
+	t.Run("Self Referral", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedReferralRepository.EXPECT().GetCode("ALICE").Return(&model.ReferralCode{Code: "ALICE", UserID: testReferee}, nil).Times(1)
+
+		_, err := testSuite.referralService.LinkReferral(testReferee, "ALICE", signature)
+		assert.ErrorIs(t, err, exception.InvalidReferralError)
+	})
+
+	t.Run("Referee Already Swapped", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedReferralRepository.EXPECT().GetCode("ALICE").Return(&model.ReferralCode{Code: "ALICE", UserID: testReferrer}, nil).Times(1)
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume(testReferee, time.Time{}).Return(100.0, nil).Times(1)
+
+		_, err := testSuite.referralService.LinkReferral(testReferee, "ALICE", signature)
+		assert.ErrorIs(t, err, exception.InvalidReferralError)
+	})
+
+	t.Run("Unknown Code", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedReferralRepository.EXPECT().GetCode("ALICE").Return(nil, exception.ReferralCodeNotFoundError).Times(1)
+
+		_, err := testSuite.referralService.LinkReferral(testReferee, "ALICE", signature)
+		assert.ErrorIs(t, err, exception.ReferralCodeNotFoundError)
+	})
+}
+
+func TestReferralServiceImpl_GetReferralInfo(t *testing.T) {
+	testSuite := &referralServiceTestSuite{}
+	testSuite.setUp(t)
+
+	testSuite.mockedReferralRepository.EXPECT().GetCodeOfUser(testReferee).Return(nil, exception.ReferralCodeNotFoundError).Times(1)
+	testSuite.mockedReferralRepository.EXPECT().GetReferral(testReferee).Return(&model.Referral{RefereeID: testReferee, ReferrerID: testReferrer}, nil).Times(1)
+	testSuite.mockedReferralRepository.EXPECT().SearchReferrals(testReferee).Return(nil, nil).Times(1)
+
+	info, err := testSuite.referralService.GetReferralInfo("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	assert.NoError(t, err)
+	assert.Nil(t, info.Code)
+	assert.Equal(t, testReferrer, info.ReferredBy.ReferrerID)
+}
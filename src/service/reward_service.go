@@ -12,62 +12,42 @@ const (
 )
 
 type RewardService interface {
-	RewardUser(userID string, TaskID int, points float64) error
-	GetRewardHistory(userID string, startTime time.Time, duration time.Duration) ([]*model.RewardRecord, error)
+	RewardUser(userID string, campaignID int, TaskID int, points float64, multipliers []*model.AppliedMultiplier) error
+	GetRewardHistory(userID string, startTime time.Time, duration time.Duration, page *repository.Page) ([]*model.RewardRecord, error)
 	GetRewardHistoryByTaskID(taskID int) (*model.RewardRecord, error)
+	GetRewardHistoryByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error)
 }
 
 type rewardServiceImpl struct {
 	rewardRecordRepository repository.RewardRecordRepository
-	userService            UserService
 }
 
 func NewRewardService() RewardService {
 	return &rewardServiceImpl{
 		rewardRecordRepository: repository.NewRewardRecordRepository(),
-		userService:            NewUserService(),
 	}
 }
 
-func (r *rewardServiceImpl) RewardUser(userID string, TaskID int, points float64) error {
+// RewardUser credits the points of a task to the user, through the ledger, and
+// records the multipliers that boosted them.
+func (r *rewardServiceImpl) RewardUser(userID string, campaignID int, TaskID int, points float64, multipliers []*model.AppliedMultiplier) error {
 	if points <= 0 {
 		return errors.New("points should be greater than 0")
 	}
 
-	user, err := r.userService.GetUserByID(userID)
-
-	if err != nil {
-		return err
-	}
-
-	originalPoints := user.Points
-
-	err = r.userService.UpdateUserPoints(userID, points)
-	if err != nil {
-		return err
-	}
-
-	user, err = r.userService.GetUserByID(userID)
-	if err != nil {
-		return err
-	}
-
-	updatedPoints := user.Points
-
-	rewardRecord := &model.RewardRecord{
-		UserID:        userID,
-		Points:        points,
-		TaskID:        TaskID,
-		OriginPoints:  originalPoints,
-		UpdatedPoints: updatedPoints,
-		CreatedAt:     time.Now().UTC(),
-	}
-	_, err = r.rewardRecordRepository.CreateRewardRecord(rewardRecord)
+	_, err := r.rewardRecordRepository.CreateRewardRecord(&model.RewardRecord{
+		UserID:      userID,
+		CampaignID:  campaignID,
+		Points:      points,
+		TaskID:      TaskID,
+		Multipliers: multipliers,
+		CreatedAt:   time.Now().UTC(),
+	})
 
 	return err
 }
 
-func (r *rewardServiceImpl) GetRewardHistory(userID string, startTime time.Time, duration time.Duration) ([]*model.RewardRecord, error) {
+func (r *rewardServiceImpl) GetRewardHistory(userID string, startTime time.Time, duration time.Duration, page *repository.Page) ([]*model.RewardRecord, error) {
 	if duration <= 0 {
 		return nil, errors.New("duration should be greater than 0")
 	}
@@ -84,6 +64,7 @@ func (r *rewardServiceImpl) GetRewardHistory(userID string, startTime time.Time,
 		UserID:    userID,
 		StartTime: startTime,
 		Duration:  duration,
+		Page:      page,
 	})
 }
 
@@ -102,3 +83,7 @@ func (r *rewardServiceImpl) GetRewardHistoryByTaskID(taskID int) (*model.RewardR
 
 	return records[0], nil
 }
+
+func (r *rewardServiceImpl) GetRewardHistoryByTaskIDs(taskIDs []int) (map[int]*model.RewardRecord, error) {
+	return r.rewardRecordRepository.GetRewardRecordsByTaskIDs(taskIDs)
+}
@@ -6,21 +6,17 @@ import (
 	"testing"
 	"time"
 	"trading-ace/mock/repository"
-	"trading-ace/mock/service"
 	"trading-ace/src/model"
 	repoReal "trading-ace/src/repository"
 )
 
 var rewardService RewardService
-var mockedUserService *service.MockUserService
 var mockedRewardRecordRepository *repository.MockRewardRecordRepository
 
 func setUpRewardService(t *testing.T) {
-	mockedUserService = service.NewMockUserService(t)
 	mockedRewardRecordRepository = repository.NewMockRewardRecordRepository(t)
 	rewardService = &rewardServiceImpl{
 		rewardRecordRepository: mockedRewardRecordRepository,
-		userService:            mockedUserService,
 	}
 }
 
@@ -28,19 +24,13 @@ func TestRewardServiceImpl_RewardUser(t *testing.T) {
 	t.Run("RewardUser", func(t *testing.T) {
 		setUpRewardService(t)
 
-		mockedUserService.EXPECT().GetUserByID("test_user_id").Return(&model.User{
-			ID:     "test_user_id",
-			Points: 0.0,
-		}, nil).Times(1)
-
-		mockedUserService.EXPECT().UpdateUserPoints("test_user_id", 10.0).Return(nil).Times(1)
 		mockedRewardRecordRepository.EXPECT().CreateRewardRecord(mock.MatchedBy(
 			func(rewardRecord *model.RewardRecord) bool {
 				return rewardRecord.UserID == "test_user_id" &&
+					rewardRecord.CampaignID == 1 &&
 					rewardRecord.Points == 10.0 &&
 					rewardRecord.TaskID == 1 &&
-					rewardRecord.OriginPoints == 0.0 &&
-					rewardRecord.UpdatedPoints == 10.0
+					rewardRecord.Multipliers[0].Name == "weekend"
 			},
 		)).Return(
 			&model.RewardRecord{
@@ -51,12 +41,7 @@ func TestRewardServiceImpl_RewardUser(t *testing.T) {
 				UpdatedPoints: 10.0,
 			}, nil).Times(1)
 
-		mockedUserService.EXPECT().GetUserByID("test_user_id").Return(&model.User{
-			ID:     "test_user_id",
-			Points: 10.0,
-		}, nil).Times(1)
-
-		err := rewardService.RewardUser("test_user_id", 1, 10.0)
+		err := rewardService.RewardUser("test_user_id", 1, 1, 10.0, []*model.AppliedMultiplier{{ID: 1, Name: "weekend", Factor: 2}})
 		if err != nil {
 			t.Errorf("RewardUser() exception = %v", err)
 		}
@@ -66,52 +51,26 @@ func TestRewardServiceImpl_RewardUser(t *testing.T) {
 		setUpRewardService(t)
 
 		t.Run("NegativePoints", func(t *testing.T) {
-			err := rewardService.RewardUser("test_user_id", 1, -10.0)
+			err := rewardService.RewardUser("test_user_id", 1, 1, -10.0, nil)
 			if err == nil {
 				t.Errorf("RewardUser() expected error but got nil")
 			}
 		})
 
 		t.Run("ZeroPoints", func(t *testing.T) {
-			err := rewardService.RewardUser("test_user_id", 1, 0.0)
+			err := rewardService.RewardUser("test_user_id", 1, 1, 0.0, nil)
 			if err == nil {
 				t.Errorf("RewardUser() expected error but got nil")
 			}
 		})
 	})
 
-	t.Run("RewardUserFail", func(t *testing.T) {
-		setUpRewardService(t)
-
-		mockedUserService.EXPECT().GetUserByID("test_user_id").Return(&model.User{
-			ID:     "test_user_id",
-			Points: 0.0,
-		}, nil).Times(1)
-		mockedUserService.EXPECT().UpdateUserPoints("test_user_id", 10.0).Return(assert.AnError).Times(1)
-
-		err := rewardService.RewardUser("test_user_id", 1, 10.0)
-		if err == nil {
-			t.Errorf("RewardUser() expected error but got nil")
-		}
-	})
-
 	t.Run("CreateRewardRecordFail", func(t *testing.T) {
 		setUpRewardService(t)
 
-		mockedUserService.EXPECT().GetUserByID("test_user_id").Return(&model.User{
-			ID:     "test_user_id",
-			Points: 0.0,
-		}, nil).Times(1)
-
-		mockedUserService.EXPECT().UpdateUserPoints("test_user_id", 10.0).Return(nil).Times(1)
-		mockedUserService.EXPECT().GetUserByID("test_user_id").Return(&model.User{
-			ID:     "test_user_id",
-			Points: 10.0,
-		}, nil).Times(1)
-
 		mockedRewardRecordRepository.EXPECT().CreateRewardRecord(mock.Anything).Return(nil, assert.AnError).Times(1)
 
-		err := rewardService.RewardUser("test_user_id", 1, 10.0)
+		err := rewardService.RewardUser("test_user_id", 1, 1, 10.0, nil)
 		if err == nil {
 			t.Errorf("RewardUser() expected error but got nil")
 		}
@@ -128,6 +87,7 @@ func TestRewardServiceImpl_GetRewardHistory(t *testing.T) {
 				UserID:    "test_user_id",
 				StartTime: currentTime,
 				Duration:  time.Hour * 24,
+				Page:      &repoReal.Page{Limit: 10},
 			},
 		).Return([]*model.RewardRecord{
 			{
@@ -140,7 +100,7 @@ func TestRewardServiceImpl_GetRewardHistory(t *testing.T) {
 			},
 		}, nil).Times(1)
 
-		rewardRecords, err := rewardService.GetRewardHistory("test_user_id", currentTime, time.Hour*24)
+		rewardRecords, err := rewardService.GetRewardHistory("test_user_id", currentTime, time.Hour*24, &repoReal.Page{Limit: 10})
 		if err != nil {
 			t.Errorf("GetRewardHistory() exception = %v", err)
 		}
@@ -155,17 +115,17 @@ func TestRewardServiceImpl_GetRewardHistory(t *testing.T) {
 		setUpRewardService(t)
 
 		t.Run("DurationLessOrEqualThanZero", func(t *testing.T) {
-			records, err := rewardService.GetRewardHistory("test_user_id", time.Now(), -1)
+			records, err := rewardService.GetRewardHistory("test_user_id", time.Now(), -1, nil)
 			assert.NotNil(t, err)
 			assert.Nil(t, records)
 
-			records, err = rewardService.GetRewardHistory("test_user_id", time.Now(), 0)
+			records, err = rewardService.GetRewardHistory("test_user_id", time.Now(), 0, nil)
 			assert.NotNil(t, err)
 			assert.Nil(t, records)
 		})
 
 		t.Run("DurationGreaterThanMaxQueryRewardHistoryDuration (30 days)", func(t *testing.T) {
-			records, err := rewardService.GetRewardHistory("test_user_id", time.Now(), time.Hour*24*31)
+			records, err := rewardService.GetRewardHistory("test_user_id", time.Now(), time.Hour*24*31, nil)
 			assert.NotNil(t, err)
 			assert.Nil(t, records)
 		})
@@ -184,10 +144,21 @@ func TestRewardServiceImpl_GetRewardHistory(t *testing.T) {
 			},
 		).Return(nil, assert.AnError).Times(1)
 
-		rewardRecords, err := rewardService.GetRewardHistory("test_user_id", currentTime, time.Hour*24)
+		rewardRecords, err := rewardService.GetRewardHistory("test_user_id", currentTime, time.Hour*24, nil)
 		if err == nil {
 			t.Errorf("GetRewardHistory() expected error but got nil")
 		}
 		assert.Nil(t, rewardRecords)
 	})
 }
+
+func TestRewardServiceImpl_GetRewardHistoryByTaskIDs(t *testing.T) {
+	setUpRewardService(t)
+
+	records := map[int]*model.RewardRecord{1: {TaskID: 1, Points: 10}, 3: {TaskID: 3, Points: 30}}
+	mockedRewardRecordRepository.EXPECT().GetRewardRecordsByTaskIDs([]int{1, 2, 3}).Return(records, nil).Times(1)
+
+	rewardRecords, err := rewardService.GetRewardHistoryByTaskIDs([]int{1, 2, 3})
+	assert.Nil(t, err)
+	assert.Equal(t, records, rewardRecords)
+}
@@ -0,0 +1,330 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type SettlementService interface {
+	SettleDuePeriods(ctx context.Context, now time.Time) error
+	PreviewSettlement(campaignID int, periodIndex int) (*model.SettlementPreview, error)
+	ExecuteSettlement(ctx context.Context, campaignID int, periodIndex int, operator string) (*model.Settlement, error)
+}
+
+type settlementServiceImpl struct {
+	settlementRepository repository.SettlementRepository
+	campaignService      CampaignService
+	uniSwapService       UniSwapService
+	sharedPoolService    SharedPoolService
+	auditService         AuditService
+	periodStatsService   PeriodStatsService
+	claimService         ClaimService
+}
+
+func NewSettlementService() SettlementService {
+	return &settlementServiceImpl{
+		settlementRepository: repository.NewSettlementRepository(),
+		campaignService:      NewCampaignService(),
+		uniSwapService:       NewUniSwapService(),
+		sharedPoolService:    NewSharedPoolService(),
+		auditService:         NewAuditService(),
+		periodStatsService:   NewPeriodStatsService(),
+		claimService:         NewClaimService(),
+	}
+}
+
+// SettleDuePeriods settles every finished period of the active campaigns that
+// has no completed settlement yet, then retries the distributions that failed
+// after their settlement completed. Paused campaigns catch up once they are
+// resumed. A campaign failing to settle doesn't keep the others from settling,
+// the errors of every campaign are returned.
+func (s *settlementServiceImpl) SettleDuePeriods(ctx context.Context, now time.Time) error {
+	campaigns, err := s.campaignService.SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, campaign := range campaigns {
+		if err := s.settleCampaign(ctx, campaign, now); err != nil {
+			log.Printf("Failed to settle campaign %d, the next sweep retries: %v", campaign.ID, err)
+			errs = append(errs, fmt.Errorf("failed to settle campaign %d: %w", campaign.ID, err))
+		}
+	}
+
+	errs = append(errs, s.distributePending(ctx))
+
+	return errors.Join(errs...)
+}
+
+// distributePending retries the distributions of the completed settlements of
+// every campaign, whatever its status, so the points paid by the last period
+// of a campaign become claimable too.
+func (s *settlementServiceImpl) distributePending(ctx context.Context) error {
+	settlements, err := s.settlementRepository.SearchSettlements(&repository.SearchSettlementsCondition{PendingDistribution: true})
+	if err != nil {
+		return err
+	}
+
+	for _, settlement := range settlements {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		campaign, err := s.campaignService.GetCampaign(settlement.CampaignID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.distribute(campaign, settlement); err != nil {
+			log.Printf("Failed to build the claims of campaign %d period %d: %v", campaign.ID, settlement.PeriodIndex, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *settlementServiceImpl) settleCampaign(ctx context.Context, campaign *model.Campaign, now time.Time) error {
+	settlements, err := s.settlementRepository.SearchSettlements(&repository.SearchSettlementsCondition{
+		CampaignID: campaign.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	byPeriod := make(map[int]*model.Settlement, len(settlements))
+	for _, settlement := range settlements {
+		byPeriod[settlement.PeriodIndex] = settlement
+	}
+
+	for i := 0; i < campaign.Periods; i++ {
+		_, end := campaign.PeriodWindow(i)
+		if end.After(now) {
+			break
+		}
+
+		settlement := byPeriod[i]
+		if settlement != nil && settlement.IsCompleted() {
+			continue
+		}
+
+		// a pending settlement was interrupted, it is resumed
+		_, _, err := s.settlePeriod(ctx, campaign, i, settlement, "")
+		if err != nil && !errors.Is(err, exception.SettlementAlreadyExistsError) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PreviewSettlement computes the payouts of a period as if it was settled now,
+// or returns the payouts of its settlement when it is settled already.
+func (s *settlementServiceImpl) PreviewSettlement(campaignID int, periodIndex int) (*model.SettlementPreview, error) {
+	campaign, err := s.getCampaignPeriod(campaignID, periodIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	settlement, err := s.getSettlement(campaign.ID, periodIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	start, end := campaign.PeriodWindow(periodIndex)
+	var payouts []*model.SharedPoolPayout
+	if settlement != nil {
+		payouts, err = s.settlementRepository.GetSettlementPayouts(settlement.ID)
+	} else {
+		payouts, err = s.sharedPoolService.PreviewSharedPool(campaign, start, end)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &model.SettlementPreview{
+		CampaignID:  campaign.ID,
+		PeriodIndex: periodIndex,
+		StartTime:   start,
+		EndTime:     end,
+		Budget:      campaign.BudgetOfPeriod(periodIndex),
+		Settled:     settlement != nil,
+		Payouts:     payouts,
+	}
+
+	for _, payout := range payouts {
+		preview.TotalSwapAmount += payout.SwapAmount
+	}
+
+	return preview, nil
+}
+
+// ExecuteSettlement settles a finished period, or resumes its pending
+// settlement, on behalf of an operator. Nothing is paid unless it is recorded
+// in the audit log. The caller is expected to hold the settlement
+// lock so it does not race the scheduled sweep.
+func (s *settlementServiceImpl) ExecuteSettlement(ctx context.Context, campaignID int, periodIndex int, operator string) (*model.Settlement, error) {
+	campaign, err := s.getCampaignPeriod(campaignID, periodIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if campaign.Status == model.CampaignStatusArchived {
+		return nil, fmt.Errorf("%w: campaign %d is archived", exception.InvalidSettlementError, campaign.ID)
+	}
+
+	if _, end := campaign.PeriodWindow(periodIndex); end.After(time.Now().UTC()) {
+		return nil, fmt.Errorf("%w: period %d is not finished yet", exception.InvalidSettlementError, periodIndex)
+	}
+
+	settlement, err := s.getSettlement(campaign.ID, periodIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	if settlement != nil && settlement.IsCompleted() {
+		return nil, exception.SettlementAlreadyExistsError
+	}
+
+	settlement, _, err = s.settlePeriod(ctx, campaign, periodIndex, settlement, operator)
+	if err != nil {
+		return nil, err
+	}
+
+	return settlement, nil
+}
+
+// settlePeriod records the settlement of the period with the payouts it
+// computes and pays them. A pending settlement is only resumed: its stored
+// payouts are paid, never recomputed, since the tasks the interrupted run paid
+// are not pending anymore. The settlement of an operator is audited before
+// anything is paid, in the transaction that records it.
+func (s *settlementServiceImpl) settlePeriod(ctx context.Context, campaign *model.Campaign, periodIndex int, settlement *model.Settlement, operator string) (*model.Settlement, []*model.SharedPoolPayout, error) {
+	start, end := campaign.PeriodWindow(periodIndex)
+
+	var payouts []*model.SharedPoolPayout
+	var err error
+	if settlement == nil {
+		log.Printf("Settling campaign %d period %d [%s, %s)", campaign.ID, periodIndex, start, end)
+
+		if payouts, err = s.sharedPoolService.PreviewSharedPool(campaign, start, end); err != nil {
+			return nil, nil, err
+		}
+
+		var audit func(settlement *model.Settlement) *model.AuditLog
+		if operator != "" {
+			audit = func(settlement *model.Settlement) *model.AuditLog {
+				return newSettlementAuditLog(operator, settlement, payouts)
+			}
+		}
+
+		settlement, err = s.settlementRepository.CreateSettlement(&model.Settlement{
+			CampaignID:  campaign.ID,
+			PeriodIndex: periodIndex,
+			StartTime:   start,
+			EndTime:     end,
+			SettledAt:   time.Now().UTC(),
+		}, payouts, audit)
+	} else {
+		log.Printf("Resuming settlement %d of campaign %d period %d", settlement.ID, campaign.ID, periodIndex)
+		if payouts, err = s.settlementRepository.GetSettlementPayouts(settlement.ID); err == nil && operator != "" {
+			auditLog := newSettlementAuditLog(operator, settlement, payouts)
+			err = s.auditService.Record(auditLog.Operator, auditLog.Action, auditLog.Target, auditLog.Detail)
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.uniSwapService.ProcessSharedPool(ctx, campaign, payouts); err != nil {
+		return nil, nil, err
+	}
+
+	completedAt := time.Now().UTC()
+	if err := s.settlementRepository.CompleteSettlement(settlement.ID, completedAt); err != nil {
+		return nil, nil, err
+	}
+	settlement.CompletedAt = &completedAt
+
+	if err := s.periodStatsService.RefreshPeriod(campaign, periodIndex); err != nil {
+		log.Printf("Failed to refresh stats of campaign %d period %d: %v", campaign.ID, periodIndex, err)
+	}
+
+	if err := s.distribute(campaign, settlement); err != nil {
+		log.Printf("Failed to build the claims of campaign %d period %d, the next sweep retries: %v", campaign.ID, periodIndex, err)
+	}
+
+	return settlement, payouts, nil
+}
+
+// distribute builds the claims of a completed settlement and marks it
+// distributed. Until then the settlement stays pending distribution.
+func (s *settlementServiceImpl) distribute(campaign *model.Campaign, settlement *model.Settlement) error {
+	_, err := s.claimService.BuildDistribution(campaign, settlement.PeriodIndex)
+	if err != nil && !errors.Is(err, exception.DistributionAlreadyExistsError) {
+		return err
+	}
+
+	distributedAt := time.Now().UTC()
+	if err := s.settlementRepository.DistributeSettlement(settlement.ID, distributedAt); err != nil {
+		return err
+	}
+	settlement.DistributedAt = &distributedAt
+
+	return nil
+}
+
+func (s *settlementServiceImpl) getCampaignPeriod(campaignID int, periodIndex int) (*model.Campaign, error) {
+	campaign, err := s.campaignService.GetCampaign(campaignID)
+	if err != nil {
+		return nil, err
+	}
+
+	if periodIndex < 0 || periodIndex >= campaign.Periods {
+		return nil, fmt.Errorf("%w: campaign %d has no period %d", exception.InvalidSettlementError, campaign.ID, periodIndex)
+	}
+
+	return campaign, nil
+}
+
+// getSettlement returns the settlement of the period, nil when it has none.
+func (s *settlementServiceImpl) getSettlement(campaignID int, periodIndex int) (*model.Settlement, error) {
+	settlements, err := s.settlementRepository.SearchSettlements(&repository.SearchSettlementsCondition{
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, settlement := range settlements {
+		if settlement.PeriodIndex == periodIndex {
+			return settlement, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func newSettlementAuditLog(operator string, settlement *model.Settlement, payouts []*model.SharedPoolPayout) *model.AuditLog {
+	totalPoints := 0.0
+	for _, payout := range payouts {
+		totalPoints += payout.Points
+	}
+
+	return model.NewAuditLog(operator, model.AuditActionExecuteSettlement,
+		fmt.Sprintf("campaign:%d:period:%d", settlement.CampaignID, settlement.PeriodIndex), map[string]any{
+			"settlement_id": settlement.ID,
+			"start_time":    settlement.StartTime,
+			"end_time":      settlement.EndTime,
+			"payouts":       len(payouts),
+			"total_points":  totalPoints,
+		})
+}
@@ -0,0 +1,382 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	realRepo "trading-ace/src/repository"
+)
+
+type settlementServiceTestSuite struct {
+	settlementService          SettlementService
+	mockedSettlementRepository *repository.MockSettlementRepository
+	mockedCampaignService      *service.MockCampaignService
+	mockedUniSwapService       *service.MockUniSwapService
+	mockedSharedPoolService    *service.MockSharedPoolService
+	mockedAuditService         *service.MockAuditService
+	mockedPeriodStatsService   *service.MockPeriodStatsService
+	mockedClaimService         *service.MockClaimService
+}
+
+func (s *settlementServiceTestSuite) setUp(t *testing.T) {
+	s.mockedSettlementRepository = repository.NewMockSettlementRepository(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.mockedUniSwapService = service.NewMockUniSwapService(t)
+	s.mockedSharedPoolService = service.NewMockSharedPoolService(t)
+	s.mockedAuditService = service.NewMockAuditService(t)
+	s.mockedPeriodStatsService = service.NewMockPeriodStatsService(t)
+	s.mockedClaimService = service.NewMockClaimService(t)
+	s.settlementService = &settlementServiceImpl{
+		settlementRepository: s.mockedSettlementRepository,
+		campaignService:      s.mockedCampaignService,
+		uniSwapService:       s.mockedUniSwapService,
+		sharedPoolService:    s.mockedSharedPoolService,
+		auditService:         s.mockedAuditService,
+		periodStatsService:   s.mockedPeriodStatsService,
+		claimService:         s.mockedClaimService,
+	}
+}
+
+// noSettlementAudit matches the audit of a scheduled settlement, there is none.
+var noSettlementAudit = mock.MatchedBy(func(audit func(*model.Settlement) *model.AuditLog) bool {
+	return audit == nil
+})
+
+func TestSettlementServiceImpl_SettleDuePeriods(t *testing.T) {
+	testSuite := &settlementServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	weekDuration := time.Hour * 24 * 7
+
+	t.Run("Settle Finished And Unsettled Periods", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		now := startTime.Add(weekDuration*3 + time.Hour)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns([]model.CampaignStatus{model.CampaignStatusActive}).
+			Return([]*model.Campaign{campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).
+			Return([]*model.Settlement{{CampaignID: 1, PeriodIndex: 0, CompletedAt: &startTime}}, nil).Times(1)
+
+		for _, periodIndex := range []int{1, 2} {
+			start, end := campaign.PeriodWindow(periodIndex)
+			payouts := []*model.SharedPoolPayout{{TaskID: periodIndex, UserID: "test_user_1", Points: 10000}}
+			testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return(payouts, nil).Times(1)
+			testSuite.mockedSettlementRepository.EXPECT().CreateSettlement(mock.MatchedBy(func(settlement *model.Settlement) bool {
+				return settlement.CampaignID == 1 && settlement.PeriodIndex == periodIndex &&
+					settlement.StartTime.Equal(start) && settlement.EndTime.Equal(end)
+			}), payouts, noSettlementAudit).Return(&model.Settlement{ID: 10 + periodIndex, PeriodIndex: periodIndex}, nil).Times(1)
+			testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, payouts).Return(nil).Times(1)
+			testSuite.mockedSettlementRepository.EXPECT().CompleteSettlement(10+periodIndex, mock.Anything).Return(nil).Times(1)
+			testSuite.mockedPeriodStatsService.EXPECT().RefreshPeriod(campaign, periodIndex).Return(nil).Times(1)
+			testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, periodIndex).Return(&model.MerkleDistribution{}, nil).Times(1)
+			testSuite.mockedSettlementRepository.EXPECT().DistributeSettlement(10+periodIndex, mock.Anything).Return(nil).Times(1)
+		}
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), now)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Resume Pending Settlement With Its Stored Payouts", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		now := startTime.Add(weekDuration + time.Hour)
+		payouts := []*model.SharedPoolPayout{{TaskID: 1, UserID: "test_user_1", Points: 10000}}
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return([]*model.Campaign{campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).
+			Return([]*model.Settlement{{ID: 5, CampaignID: 1, PeriodIndex: 0}}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().GetSettlementPayouts(5).Return(payouts, nil).Times(1)
+		testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, payouts).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CompleteSettlement(5, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedPeriodStatsService.EXPECT().RefreshPeriod(campaign, 0).Return(nil).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 0).Return(&model.MerkleDistribution{}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().DistributeSettlement(5, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), now)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Ignore Settlement Recorded By Another Instance", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		now := startTime.Add(weekDuration + time.Hour)
+		start, end := campaign.PeriodWindow(0)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return([]*model.Campaign{campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).Return(nil, nil).Times(1)
+		testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return(nil, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CreateSettlement(mock.Anything, mock.Anything, noSettlementAudit).
+			Return(nil, exception.SettlementAlreadyExistsError).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), now)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Leave Settlement Pending On Process Error", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		now := startTime.Add(weekDuration*2 + time.Hour)
+		start, end := campaign.PeriodWindow(0)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return([]*model.Campaign{campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).Return(nil, nil).Times(1)
+		testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return(nil, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CreateSettlement(mock.Anything, mock.Anything, noSettlementAudit).
+			Return(&model.Settlement{ID: 3}, nil).Times(1)
+		testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, mock.Anything).Return(assert.AnError).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), now)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Settle Other Campaigns When One Fails", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		failing := newTestCampaign(startTime)
+		campaign := newTestCampaign(startTime)
+		campaign.ID = 2
+		now := startTime.Add(weekDuration + time.Hour)
+		start, end := campaign.PeriodWindow(0)
+		payouts := []*model.SharedPoolPayout{{TaskID: 1, UserID: "test_user_1", Points: 10000}}
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return([]*model.Campaign{failing, campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).Return(nil, assert.AnError).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 2}).Return(nil, nil).Times(1)
+		testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return(payouts, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CreateSettlement(mock.Anything, payouts, noSettlementAudit).
+			Return(&model.Settlement{ID: 6, CampaignID: 2, PeriodIndex: 0}, nil).Times(1)
+		testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, payouts).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CompleteSettlement(6, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedPeriodStatsService.EXPECT().RefreshPeriod(campaign, 0).Return(nil).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 0).Return(&model.MerkleDistribution{}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().DistributeSettlement(6, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), now)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Nothing Due", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return([]*model.Campaign{campaign}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).Return(nil, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).Return(nil, nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), startTime.Add(time.Hour))
+		assert.Nil(t, err)
+	})
+
+	t.Run("Retry Pending Distribution", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		campaign.Status = model.CampaignStatusArchived
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return(nil, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).
+			Return([]*model.Settlement{{ID: 4, CampaignID: 1, PeriodIndex: 3, CompletedAt: &startTime}}, nil).Times(1)
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 3).Return(nil, exception.DistributionAlreadyExistsError).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().DistributeSettlement(4, mock.Anything).Return(nil).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), startTime)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Keep Distribution Pending On Error", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+
+		testSuite.mockedCampaignService.EXPECT().SearchCampaigns(mock.Anything).Return(nil, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{PendingDistribution: true}).
+			Return([]*model.Settlement{{ID: 4, CampaignID: 1, PeriodIndex: 3, CompletedAt: &startTime}}, nil).Times(1)
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 3).Return(nil, assert.AnError).Times(1)
+
+		err := testSuite.settlementService.SettleDuePeriods(context.Background(), startTime)
+		assert.Nil(t, err)
+	})
+}
+
+func TestSettlementServiceImpl_PreviewSettlement(t *testing.T) {
+	testSuite := &settlementServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Preview Period", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		campaign.BudgetDecay = 0.5
+		start, end := campaign.PeriodWindow(1)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(&realRepo.SearchSettlementsCondition{CampaignID: 1}).
+			Return([]*model.Settlement{{CampaignID: 1, PeriodIndex: 0}}, nil).Times(1)
+		testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return([]*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", SwapAmount: 30, Share: 0.75, Points: 3750},
+			{TaskID: 2, UserID: "test_user_2", SwapAmount: 10, Share: 0.25, Points: 1250},
+		}, nil).Times(1)
+
+		preview, err := testSuite.settlementService.PreviewSettlement(1, 1)
+		assert.Nil(t, err)
+		assert.False(t, preview.Settled)
+		assert.Equal(t, 5000.0, preview.Budget)
+		assert.Equal(t, 40.0, preview.TotalSwapAmount)
+		assert.Equal(t, 2, len(preview.Payouts))
+		assert.Equal(t, start, preview.StartTime)
+	})
+
+	t.Run("Preview Settled Period", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).
+			Return([]*model.Settlement{{ID: 5, CampaignID: 1, PeriodIndex: 0}}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().GetSettlementPayouts(5).Return([]*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", SwapAmount: 30, Share: 1, Points: 10000},
+		}, nil).Times(1)
+
+		preview, err := testSuite.settlementService.PreviewSettlement(1, 0)
+		assert.Nil(t, err)
+		assert.True(t, preview.Settled)
+		assert.Equal(t, 30.0, preview.TotalSwapAmount)
+	})
+
+	t.Run("Period Out Of Range", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(newTestCampaign(startTime), nil).Times(1)
+
+		preview, err := testSuite.settlementService.PreviewSettlement(1, 4)
+		assert.ErrorIs(t, err, exception.InvalidSettlementError)
+		assert.Nil(t, preview)
+	})
+}
+
+func TestSettlementServiceImpl_ExecuteSettlement(t *testing.T) {
+	testSuite := &settlementServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Execute And Audit", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		start, end := campaign.PeriodWindow(0)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		payouts := []*model.SharedPoolPayout{{TaskID: 1, UserID: "test_user_1", Points: 10000}}
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).Return(nil, nil).Times(1)
+		testSuite.mockedSharedPoolService.EXPECT().PreviewSharedPool(campaign, start, end).Return(payouts, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CreateSettlement(mock.Anything, payouts, mock.Anything).
+			RunAndReturn(func(settlement *model.Settlement, _ []*model.SharedPoolPayout, audit func(*model.Settlement) *model.AuditLog) (*model.Settlement, error) {
+				settlement.ID = 7
+
+				auditLog := audit(settlement)
+				assert.Equal(t, "ops@example.com", auditLog.Operator)
+				assert.Equal(t, model.AuditActionExecuteSettlement, auditLog.Action)
+				assert.Equal(t, "campaign:1:period:0", auditLog.Target)
+				assert.Equal(t, 7, auditLog.Detail["settlement_id"])
+				assert.Equal(t, 1, auditLog.Detail["payouts"])
+				assert.Equal(t, 10000.0, auditLog.Detail["total_points"])
+				return settlement, nil
+			}).Times(1)
+		testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, payouts).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CompleteSettlement(7, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedPeriodStatsService.EXPECT().RefreshPeriod(campaign, 0).Return(assert.AnError).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 0).Return(nil, assert.AnError).Times(1)
+
+		settlement, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 7, settlement.ID)
+		assert.True(t, settlement.IsCompleted())
+	})
+
+	t.Run("Audit Resumed Settlement Before Paying", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		payouts := []*model.SharedPoolPayout{{TaskID: 1, UserID: "test_user_1", Points: 10000}}
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).
+			Return([]*model.Settlement{{ID: 5, CampaignID: 1, PeriodIndex: 0}}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().GetSettlementPayouts(5).Return(payouts, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record("ops@example.com", model.AuditActionExecuteSettlement, "campaign:1:period:0",
+			mock.MatchedBy(func(detail map[string]any) bool {
+				return detail["settlement_id"] == 5 && detail["payouts"] == 1
+			})).Return(nil).Times(1)
+		testSuite.mockedUniSwapService.EXPECT().ProcessSharedPool(mock.Anything, campaign, payouts).Return(nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().CompleteSettlement(5, mock.Anything).Return(nil).Times(1)
+		testSuite.mockedPeriodStatsService.EXPECT().RefreshPeriod(campaign, 0).Return(nil).Times(1)
+		testSuite.mockedClaimService.EXPECT().BuildDistribution(campaign, 0).Return(&model.MerkleDistribution{}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().DistributeSettlement(5, mock.Anything).Return(nil).Times(1)
+
+		settlement, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.Nil(t, err)
+		assert.Equal(t, 5, settlement.ID)
+	})
+
+	t.Run("Pay Nothing When the Audit Fails", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(newTestCampaign(startTime), nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).
+			Return([]*model.Settlement{{ID: 5, CampaignID: 1, PeriodIndex: 0}}, nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().GetSettlementPayouts(5).Return(nil, nil).Times(1)
+		testSuite.mockedAuditService.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(assert.AnError).Times(1)
+
+		_, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Period Already Settled", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(newTestCampaign(startTime), nil).Times(1)
+		testSuite.mockedSettlementRepository.EXPECT().SearchSettlements(mock.Anything).
+			Return([]*model.Settlement{{CampaignID: 1, PeriodIndex: 0, CompletedAt: &startTime}}, nil).Times(1)
+
+		_, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.ErrorIs(t, err, exception.SettlementAlreadyExistsError)
+	})
+
+	t.Run("Period Not Finished", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(newTestCampaign(time.Now().UTC()), nil).Times(1)
+
+		_, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.ErrorIs(t, err, exception.InvalidSettlementError)
+	})
+
+	t.Run("Archived Campaign", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		campaign := newTestCampaign(startTime)
+		campaign.Status = model.CampaignStatusArchived
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(campaign, nil).Times(1)
+
+		_, err := testSuite.settlementService.ExecuteSettlement(context.Background(), 1, 0, "ops@example.com")
+		assert.ErrorIs(t, err, exception.InvalidSettlementError)
+	})
+}
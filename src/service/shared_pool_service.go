@@ -0,0 +1,139 @@
+package service
+
+import (
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+// SharedPoolService splits the budget of a campaign period between its shared
+// pool tasks, for the settlement of the period and the projections of the
+// running one alike.
+type SharedPoolService interface {
+	PreviewSharedPool(campaign *model.Campaign, from time.Time, to time.Time) ([]*model.SharedPoolPayout, error)
+}
+
+type sharedPoolServiceImpl struct {
+	taskService       TaskService
+	multiplierService MultiplierService
+	tierService       TierService
+}
+
+func NewSharedPoolService() SharedPoolService {
+	return &sharedPoolServiceImpl{
+		taskService:       NewTaskService(),
+		multiplierService: NewMultiplierService(),
+		tierService:       NewTierService(),
+	}
+}
+
+// PreviewSharedPool computes how the period budget would be split between the
+// pending shared pool tasks of the window without rewarding anyone. A task
+// weighs its swap amount times the multipliers active when it was created and
+// the multiplier of the tier of its user, whose bonus is capped per payout.
+func (s *sharedPoolServiceImpl) PreviewSharedPool(campaign *model.Campaign, from time.Time, to time.Time) ([]*model.SharedPoolPayout, error) {
+	tasks, err := s.taskService.SearchTasks(&repository.SearchTasksCondition{
+		CampaignID: campaign.ID,
+		StartTime:  from,
+		EndTime:    to,
+		Type:       model.TaskTypeSharedPool,
+		Status:     model.TaskStatusPending,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	multipliers, err := s.multiplierService.GetActiveMultipliers(campaign.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	seen := make(map[string]bool)
+	for _, task := range *tasks {
+		if !seen[task.UserID] {
+			seen[task.UserID] = true
+			userIDs = append(userIDs, task.UserID)
+		}
+	}
+
+	onboarded, err := s.getOnboardedUsers(campaign.ID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var filteredTasks []*model.Task
+	var onboardedUserIDs []string
+	for _, task := range *tasks {
+		if onboarded[task.UserID] {
+			filteredTasks = append(filteredTasks, task)
+		}
+	}
+
+	for _, userID := range userIDs {
+		if onboarded[userID] {
+			onboardedUserIDs = append(onboardedUserIDs, userID)
+		}
+	}
+
+	tiers, err := s.tierService.GetUserTiers(onboardedUserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	totalWeight := 0.0
+	payouts := make([]*model.SharedPoolPayout, 0, len(filteredTasks))
+	for _, task := range filteredTasks {
+		factor, applied := model.ApplyMultipliers(multipliers, task.UserID, campaign.ID, task.CreatedAt)
+		if tierMultiplier := tiers[task.UserID].AppliedMultiplier(); tierMultiplier != nil {
+			factor *= tierMultiplier.Factor
+			applied = append(applied, tierMultiplier)
+		}
+
+		weight := task.SwapAmount * factor
+		totalWeight += weight
+		payouts = append(payouts, &model.SharedPoolPayout{
+			TaskID:      task.ID,
+			UserID:      task.UserID,
+			SwapAmount:  task.SwapAmount,
+			Weight:      weight,
+			Multipliers: applied,
+		})
+	}
+
+	periodIndex, _ := campaign.PeriodIndexAt(from)
+	budget := campaign.BudgetOfPeriod(periodIndex)
+
+	for _, payout := range payouts {
+		payout.Share = payout.Weight / totalWeight
+		// the points a tier bonus loses to its cap stay in the budget
+		payout.Points = tiers[payout.UserID].CapBonus(budget * payout.Share)
+	}
+
+	return payouts, nil
+}
+
+// getOnboardedUsers tells which of the users completed the onboarding task of
+// the campaign, in a single query.
+func (s *sharedPoolServiceImpl) getOnboardedUsers(campaignID int, userIDs []string) (map[string]bool, error) {
+	onboarded := make(map[string]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return onboarded, nil
+	}
+
+	tasks, err := s.taskService.SearchTasks(&repository.SearchTasksCondition{
+		UserIDs:    userIDs,
+		CampaignID: campaignID,
+		Type:       model.TaskTypeOnboarding,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, task := range *tasks {
+		onboarded[task.UserID] = true
+	}
+
+	return onboarded, nil
+}
@@ -0,0 +1,205 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/service"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type sharedPoolServiceTestSuite struct {
+	sharedPoolService       SharedPoolService
+	mockedTaskService       *service.MockTaskService
+	mockedMultiplierService *service.MockMultiplierService
+	mockedTierService       *service.MockTierService
+}
+
+func (s *sharedPoolServiceTestSuite) setUp(t *testing.T) {
+	s.mockedTaskService = service.NewMockTaskService(t)
+	s.mockedMultiplierService = service.NewMockMultiplierService(t)
+	s.mockedTierService = service.NewMockTierService(t)
+	s.sharedPoolService = &sharedPoolServiceImpl{
+		taskService:       s.mockedTaskService,
+		multiplierService: s.mockedMultiplierService,
+		tierService:       s.mockedTierService,
+	}
+}
+
+func TestSharedPoolServiceImpl_PreviewSharedPool(t *testing.T) {
+	testSuite := &sharedPoolServiceTestSuite{}
+
+	parseTime := func(timeStr string) time.Time {
+		t, _ := time.Parse("2006-01-02", timeStr)
+		return t
+	}
+
+	createdTime := parseTime("2021-01-01").Add(time.Hour * 5)
+	tasksPool := []*model.Task{
+		{
+			ID:         1,
+			UserID:     "test_user_1",
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 10.0,
+			CreatedAt:  createdTime,
+		},
+		{
+			ID:         3,
+			UserID:     "test_user_1",
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 20.0,
+			CreatedAt:  createdTime,
+		},
+		{
+			ID:         4,
+			UserID:     "test_user_2",
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 10.0,
+			CreatedAt:  createdTime,
+		},
+		{
+			ID:         7,
+			UserID:     "test_user_3",
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 10.0,
+			CreatedAt:  createdTime,
+		},
+		{
+			ID:         6,
+			UserID:     "test_user_2",
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 10.0,
+			CreatedAt:  createdTime,
+		},
+	}
+
+	t.Run("Preview Splits the Budget", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		fromTime := parseTime("2021-01-01")
+		toTime := parseTime("2021-01-02")
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+			CampaignID: 1,
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			StartTime:  fromTime,
+			EndTime:    toTime,
+		}).Return(&tasksPool, nil).Times(1)
+		testSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, fromTime, toTime).Return(nil, nil).Times(1)
+		testSuite.mockedTierService.EXPECT().GetUserTiers(mock.Anything).Return(nil, nil).Times(1)
+
+		// the onboarding of every user is looked up at once, test_user_3 has none
+		testSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+			UserIDs:    []string{"test_user_1", "test_user_2", "test_user_3"},
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
+		}).Return(&[]*model.Task{
+			{UserID: "test_user_1", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone},
+			{UserID: "test_user_2", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone},
+		}, nil).Times(1)
+
+		expectedPoints := map[int]float64{1: 2000, 3: 4000, 4: 2000, 6: 2000}
+
+		payouts, err := testSuite.sharedPoolService.PreviewSharedPool(testCampaign, fromTime, toTime)
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(payouts))
+		for _, payout := range payouts {
+			assert.Equal(t, expectedPoints[payout.TaskID], payout.Points)
+		}
+	})
+
+	t.Run("Query Error", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		fromTime := parseTime("2021-01-01")
+		toTime := parseTime("2021-01-02")
+		testSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+			CampaignID: 1,
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			StartTime:  fromTime,
+			EndTime:    toTime,
+		}).Return(nil, assert.AnError).Times(1)
+
+		_, err := testSuite.sharedPoolService.PreviewSharedPool(testCampaign, fromTime, toTime)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Preview Does Not Reward", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		fromTime := parseTime("2021-01-01")
+		toTime := parseTime("2021-01-02")
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+			CampaignID: 1,
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			StartTime:  fromTime,
+			EndTime:    toTime,
+		}).Return(&[]*model.Task{tasksPool[0], tasksPool[1]}, nil).Times(1)
+		testSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, fromTime, toTime).Return(nil, nil).Times(1)
+		testSuite.mockedTierService.EXPECT().GetUserTiers(mock.Anything).Return(nil, nil).Times(1)
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
+			return condition.Type == model.TaskTypeOnboarding
+		})).Return(&[]*model.Task{
+			{UserID: "test_user_1", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone},
+		}, nil).Times(1)
+
+		payouts, err := testSuite.sharedPoolService.PreviewSharedPool(testCampaign, fromTime, toTime)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(payouts))
+		assert.InDelta(t, 1.0/3, payouts[0].Share, 1e-9)
+		assert.InDelta(t, 20000.0/3, payouts[1].Points, 1e-9)
+	})
+
+	t.Run("Multipliers and Tiers Weigh the Tasks", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		fromTime := parseTime("2021-01-01")
+		toTime := parseTime("2021-01-02")
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+			CampaignID: 1,
+			Type:       model.TaskTypeSharedPool,
+			Status:     model.TaskStatusPending,
+			StartTime:  fromTime,
+			EndTime:    toTime,
+		}).Return(&[]*model.Task{tasksPool[0], tasksPool[2]}, nil).Times(1)
+
+		testSuite.mockedTaskService.EXPECT().SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
+			return condition.Type == model.TaskTypeOnboarding
+		})).Return(&[]*model.Task{
+			{UserID: "test_user_1", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone},
+			{UserID: "test_user_2", Type: model.TaskTypeOnboarding, Status: model.TaskStatusDone},
+		}, nil).Times(1)
+
+		multiplierEnd := createdTime.Add(time.Hour)
+		testSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, fromTime, toTime).Return([]*model.Multiplier{
+			{ID: 1, Name: "happy hour", Factor: 3, UserIDs: []string{"test_user_2"}, StartTime: fromTime, EndTime: &multiplierEnd},
+		}, nil).Times(1)
+		testSuite.mockedTierService.EXPECT().GetUserTiers([]string{"test_user_1", "test_user_2"}).Return(map[string]*model.Tier{
+			"test_user_1": {Name: "gold", MinVolume: 100000, Multiplier: 1.25, BonusCap: 100},
+		}, nil).Times(1)
+
+		payouts, err := testSuite.sharedPoolService.PreviewSharedPool(testCampaign, fromTime, toTime)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(payouts))
+		assert.Equal(t, 12.5, payouts[0].Weight)
+		assert.Equal(t, []*model.AppliedMultiplier{{Name: "gold tier", Factor: 1.25}}, payouts[0].Multipliers)
+		// the gold bonus of 588.24 points is capped at 100
+		assert.InDelta(t, 10000*10/42.5+100, payouts[0].Points, 1e-9)
+		assert.Equal(t, 30.0, payouts[1].Weight)
+		assert.Equal(t, []*model.AppliedMultiplier{{ID: 1, Name: "happy hour", Factor: 3}}, payouts[1].Multipliers)
+		assert.InDelta(t, 10000*30/42.5, payouts[1].Points, 1e-9)
+	})
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"log"
+	"slices"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type StreakService interface {
+	GetMilestones() []*model.StreakMilestone
+	// RecordSwap advances the streaks of the user and returns the milestones
+	// their running streaks reached the user wasn't rewarded for yet.
+	RecordSwap(userID string, now time.Time) ([]*model.MilestoneRule, error)
+	// GetStreaks returns the daily and weekly streaks of the user, their
+	// current length 0 once broken.
+	GetStreaks(userID string, now time.Time) ([]*model.Streak, error)
+}
+
+type streakServiceImpl struct {
+	streakRepository    repository.StreakRepository
+	milestoneRepository repository.MilestoneRepository
+	milestones          []*model.StreakMilestone
+}
+
+func NewStreakService() StreakService {
+	var milestones []*model.StreakMilestone
+	if streakConfig := config.GetAppConfig().Streak; streakConfig != nil {
+		for _, milestone := range streakConfig.Milestones {
+			period := model.PeriodType(milestone.Period)
+			if (period != model.PeriodTypeDaily && period != model.PeriodTypeWeekly) || milestone.Length <= 0 || milestone.Reward <= 0 {
+				log.Printf("Skipping streak milestone of %d %s periods with an unknown period or no reward", milestone.Length, milestone.Period)
+				continue
+			}
+			milestones = append(milestones, &model.StreakMilestone{
+				Period: period,
+				Length: milestone.Length,
+				Reward: milestone.Reward,
+			})
+		}
+	}
+
+	return &streakServiceImpl{
+		streakRepository:    repository.NewStreakRepository(),
+		milestoneRepository: repository.NewMilestoneRepository(),
+		milestones:          milestones,
+	}
+}
+
+func (s *streakServiceImpl) GetMilestones() []*model.StreakMilestone {
+	return s.milestones
+}
+
+// RecordSwap advances each streak once per period. The milestones of a streak
+// stay pending until claimed, so a reward that failed is retried by the next
+// swap of the streak.
+func (s *streakServiceImpl) RecordSwap(userID string, now time.Time) ([]*model.MilestoneRule, error) {
+	var streaks []*model.Streak
+	advanced := true
+	for _, period := range model.StreakPeriods {
+		start := model.StreakPeriodStart(period, now)
+		streak, err := s.streakRepository.AdvanceStreak(userID, period, start, model.PreviousStreakPeriod(period, start))
+		if err != nil {
+			return nil, err
+		}
+
+		if streak == nil {
+			advanced = false
+			continue
+		}
+		streaks = append(streaks, streak)
+	}
+
+	if len(s.milestones) == 0 {
+		return nil, nil
+	}
+
+	if !advanced {
+		// the streaks the swap didn't advance already count this period
+		var err error
+		if streaks, err = s.GetStreaks(userID, now); err != nil {
+			return nil, err
+		}
+	}
+
+	var reached []*model.MilestoneRule
+	for _, streak := range streaks {
+		for _, milestone := range s.milestones {
+			if milestone.Period == streak.Period && milestone.Length <= streak.Current {
+				reached = append(reached, milestone.Rule(streak))
+			}
+		}
+	}
+
+	if len(reached) == 0 {
+		return nil, nil
+	}
+
+	achieved, err := s.milestoneRepository.GetAchievedMilestones(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(reached, func(milestone *model.MilestoneRule) bool {
+		return slices.Contains(achieved, milestone.Key)
+	}), nil
+}
+
+func (s *streakServiceImpl) GetStreaks(userID string, now time.Time) ([]*model.Streak, error) {
+	stored, err := s.streakRepository.GetStreaks(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byPeriod := make(map[model.PeriodType]*model.Streak, len(stored))
+	for _, streak := range stored {
+		byPeriod[streak.Period] = streak
+	}
+
+	streaks := make([]*model.Streak, 0, len(model.StreakPeriods))
+	for _, period := range model.StreakPeriods {
+		streak, ok := byPeriod[period]
+		if !ok {
+			streak = &model.Streak{UserID: userID, Period: period}
+		}
+
+		streak.Current = streak.CurrentAt(now)
+		streaks = append(streaks, streak)
+	}
+
+	return streaks, nil
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/model"
+)
+
+type streakServiceTestSuite struct {
+	streakService             StreakService
+	mockedStreakRepository    *repository.MockStreakRepository
+	mockedMilestoneRepository *repository.MockMilestoneRepository
+}
+
+var testStreakMilestones = []*model.StreakMilestone{
+	{Period: model.PeriodTypeDaily, Length: 7, Reward: 100},
+	{Period: model.PeriodTypeDaily, Length: 30, Reward: 500},
+	{Period: model.PeriodTypeWeekly, Length: 4, Reward: 200},
+}
+
+func (s *streakServiceTestSuite) setUp(t *testing.T) {
+	s.mockedStreakRepository = repository.NewMockStreakRepository(t)
+	s.mockedMilestoneRepository = repository.NewMockMilestoneRepository(t)
+	s.streakService = &streakServiceImpl{
+		streakRepository:    s.mockedStreakRepository,
+		milestoneRepository: s.mockedMilestoneRepository,
+		milestones:          testStreakMilestones,
+	}
+}
+
+func TestStreakServiceImpl_RecordSwap(t *testing.T) {
+	testSuite := &streakServiceTestSuite{}
+	// 2024-09-11 is a Wednesday
+	now := time.Date(2024, 9, 11, 15, 0, 0, 0, time.UTC)
+	today := time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Milestone Reached", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		dailyStreak := &model.Streak{Period: model.PeriodTypeDaily, Current: 7, Best: 7, LastPeriod: today}
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeDaily, today, today.AddDate(0, 0, -1)).Return(dailyStreak, nil).Times(1)
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeWeekly, monday, monday.AddDate(0, 0, -7)).
+			Return(&model.Streak{Period: model.PeriodTypeWeekly, Current: 2, Best: 3, LastPeriod: monday}, nil).Times(1)
+		testSuite.mockedMilestoneRepository.EXPECT().GetAchievedMilestones("test_user").Return(nil, nil).Times(1)
+
+		milestones, err := testSuite.streakService.RecordSwap("test_user", now)
+		assert.NoError(t, err)
+		assert.Equal(t, []*model.MilestoneRule{testStreakMilestones[0].Rule(dailyStreak)}, milestones)
+		assert.Equal(t, "streak:daily:7:2024-09-05", milestones[0].Key)
+	})
+
+	t.Run("Unpaid Milestone Pending On The Next Swap", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeDaily, today, today.AddDate(0, 0, -1)).Return(nil, nil).Times(1)
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeWeekly, monday, monday.AddDate(0, 0, -7)).Return(nil, nil).Times(1)
+		testSuite.mockedStreakRepository.EXPECT().GetStreaks("test_user").Return([]*model.Streak{
+			{UserID: "test_user", Period: model.PeriodTypeDaily, Current: 8, Best: 8, LastPeriod: today},
+			{UserID: "test_user", Period: model.PeriodTypeWeekly, Current: 4, Best: 4, LastPeriod: monday},
+		}, nil).Times(1)
+		// the weekly milestone of the streak was paid, the daily one failed
+		testSuite.mockedMilestoneRepository.EXPECT().GetAchievedMilestones("test_user").Return([]string{"streak:weekly:4:2024-08-19"}, nil).Times(1)
+
+		milestones, err := testSuite.streakService.RecordSwap("test_user", now)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, len(milestones))
+		assert.Equal(t, "streak:daily:7:2024-09-04", milestones[0].Key)
+		assert.Equal(t, 100.0, milestones[0].Reward)
+	})
+
+	t.Run("Already Swapped In The Periods", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeDaily, today, today.AddDate(0, 0, -1)).Return(nil, nil).Times(1)
+		testSuite.mockedStreakRepository.EXPECT().AdvanceStreak("test_user", model.PeriodTypeWeekly, monday, monday.AddDate(0, 0, -7)).Return(nil, nil).Times(1)
+		testSuite.mockedStreakRepository.EXPECT().GetStreaks("test_user").Return([]*model.Streak{
+			{UserID: "test_user", Period: model.PeriodTypeDaily, Current: 3, Best: 5, LastPeriod: today},
+		}, nil).Times(1)
+
+		milestones, err := testSuite.streakService.RecordSwap("test_user", now)
+		assert.NoError(t, err)
+		assert.Empty(t, milestones)
+	})
+}
+
+func TestStreakServiceImpl_GetStreaks(t *testing.T) {
+	testSuite := &streakServiceTestSuite{}
+	testSuite.setUp(t)
+	now := time.Date(2024, 9, 11, 15, 0, 0, 0, time.UTC)
+
+	testSuite.mockedStreakRepository.EXPECT().GetStreaks("test_user").Return([]*model.Streak{
+		{UserID: "test_user", Period: model.PeriodTypeDaily, Current: 4, Best: 6, LastPeriod: time.Date(2024, 9, 8, 0, 0, 0, 0, time.UTC)},
+	}, nil).Times(1)
+
+	streaks, err := testSuite.streakService.GetStreaks("test_user", now)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(streaks))
+	// the daily streak broke on 2024-09-10
+	assert.Equal(t, 0, streaks[0].Current)
+	assert.Equal(t, 6, streaks[0].Best)
+	assert.Equal(t, model.PeriodTypeWeekly, streaks[1].Period)
+	assert.Equal(t, 0, streaks[1].Best)
+}
@@ -8,8 +8,14 @@ import (
 )
 
 type TaskService interface {
-	CreateTask(userId string, taskType model.TaskType, swapAmount float64) (*model.Task, error)
+	CreateTask(userId string, campaignID int, taskType model.TaskType, swapAmount float64) (*model.Task, error)
+	// CreateSharedPoolTask creates the shared pool task of a swap for the
+	// campaign, nil when the swap already created it.
+	CreateSharedPoolTask(swapID string, userId string, campaignID int, swapAmount float64) (*model.Task, error)
 	CompleteTask(taskID int) error
+	// DiscardTask deletes a pending task whose reward failed, so it isn't left
+	// behind when the reward is retried with a new task.
+	DiscardTask(taskID int) error
 	SearchTasks(condition *repository.SearchTasksCondition) (*[]*model.Task, error)
 }
 
@@ -33,11 +39,20 @@ func (s *taskServiceImpl) SearchTasks(condition *repository.SearchTasksCondition
 	return &tasks, nil
 }
 
-func (s *taskServiceImpl) CreateTask(userId string, taskType model.TaskType, swapAmount float64) (*model.Task, error) {
-	task := model.NewTask(userId, taskType, swapAmount)
+func (s *taskServiceImpl) CreateTask(userId string, campaignID int, taskType model.TaskType, swapAmount float64) (*model.Task, error) {
+	task := model.NewTask(userId, campaignID, taskType, swapAmount)
 	return s.taskRepository.CreateTask(task)
 }
 
+func (s *taskServiceImpl) CreateSharedPoolTask(swapID string, userId string, campaignID int, swapAmount float64) (*model.Task, error) {
+	task := model.NewTask(userId, campaignID, model.TaskTypeSharedPool, swapAmount)
+	return s.taskRepository.CreateSwapTask(swapID, task)
+}
+
+func (s *taskServiceImpl) DiscardTask(taskID int) error {
+	return s.taskRepository.DeletePendingTask(taskID)
+}
+
 func (s *taskServiceImpl) CompleteTask(taskID int) error {
 	task, err := s.taskRepository.GetTaskByID(taskID)
 
@@ -88,6 +88,7 @@ func TestTaskServiceImpl_CreateTask(t *testing.T) {
 
 		taskCreatedByRepo := &model.Task{
 			ID:         1,
+			CampaignID: 1,
 			UserID:     "test_user_id",
 			Type:       model.TaskTypeOnboarding,
 			Status:     model.TaskStatusPending,
@@ -102,6 +103,7 @@ func TestTaskServiceImpl_CreateTask(t *testing.T) {
 		testSuite.mockedTaskRepository.EXPECT().CreateTask(mock.MatchedBy(
 			func(task *model.Task) bool {
 				return task.UserID == "test_user_id" &&
+					task.CampaignID == 1 &&
 					task.Type == model.TaskTypeOnboarding &&
 					task.SwapAmount == 10.0 &&
 					task.Status == model.TaskStatusPending &&
@@ -109,7 +111,7 @@ func TestTaskServiceImpl_CreateTask(t *testing.T) {
 			},
 		)).Return(taskCreatedByRepo, nil).Times(1)
 
-		newTask, err := testSuite.taskService.CreateTask("test_user_id", model.TaskTypeOnboarding, 10.0)
+		newTask, err := testSuite.taskService.CreateTask("test_user_id", 1, model.TaskTypeOnboarding, 10.0)
 		assert.Nil(t, err)
 		assert.Equal(t, model.TaskTypeOnboarding, newTask.Type)
 		assert.Equal(t, 10.0, newTask.SwapAmount)
@@ -126,12 +128,32 @@ func TestTaskServiceImpl_CreateTask(t *testing.T) {
 		testSuite.mockedTaskRepository.EXPECT().CreateTask(mock.Anything).Return(
 			nil, assert.AnError).Times(1)
 
-		newTask, err := testSuite.taskService.CreateTask("test_user_id", model.TaskTypeOnboarding, 10.0)
+		newTask, err := testSuite.taskService.CreateTask("test_user_id", 1, model.TaskTypeOnboarding, 10.0)
 		assert.Nil(t, newTask)
 		assert.NotNil(t, err)
 	})
 }
 
+func TestTaskServiceImpl_CreateSharedPoolTask(t *testing.T) {
+	testSuite := &taskServiceTestSuite{}
+	testSuite.setUp(t)
+
+	testSuite.mockedTaskRepository.EXPECT().CreateSwapTask("test_swap", mock.MatchedBy(
+		func(task *model.Task) bool {
+			return task.UserID == "test_user_id" &&
+				task.CampaignID == 1 &&
+				task.Type == model.TaskTypeSharedPool &&
+				task.SwapAmount == 10.0 &&
+				task.Status == model.TaskStatusPending
+		},
+	)).Return(nil, nil).Times(1)
+
+	// the swap already created its task
+	newTask, err := testSuite.taskService.CreateSharedPoolTask("test_swap", "test_user_id", 1, 10.0)
+	assert.Nil(t, err)
+	assert.Nil(t, newTask)
+}
+
 // test searchTasks
 func TestTaskServiceImpl_SearchTasks(t *testing.T) {
 	testSuite := &taskServiceTestSuite{}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type TierService interface {
+	GetTiers() model.TierSchedule
+	// RecordSwap adds the swap to the rolling volume of the user, once per
+	// swapID, and returns the tier the user is in after it.
+	RecordSwap(swapID string, userID string, swapAmount float64, now time.Time) (*model.Tier, error)
+	RefreshTier(userID string, now time.Time) (*model.Tier, error)
+	RefreshTiers(ctx context.Context, now time.Time) error
+	GetUserTiers(userIDs []string) (map[string]*model.Tier, error)
+	GetTierHistory(userID string) ([]*model.TierChange, error)
+}
+
+type tierServiceImpl struct {
+	volumeRepository repository.VolumeRepository
+	tierRepository   repository.TierRepository
+	userService      UserService
+	tiers            model.TierSchedule
+	window           time.Duration
+}
+
+func NewTierService() TierService {
+	tierConfig := config.GetAppConfig().Tier
+
+	var tiers []*model.Tier
+	if tierConfig != nil {
+		for _, level := range tierConfig.Levels {
+			if level.Name == "" || level.MinVolume < 0 || level.Multiplier < 0 {
+				log.Printf("Skipping tier %q without name or with a negative volume or multiplier", level.Name)
+				continue
+			}
+			tiers = append(tiers, &model.Tier{
+				Name:       level.Name,
+				MinVolume:  level.MinVolume,
+				Multiplier: level.Multiplier,
+				BonusCap:   level.BonusCap,
+			})
+		}
+	}
+
+	return &tierServiceImpl{
+		volumeRepository: repository.NewVolumeRepository(),
+		tierRepository:   repository.NewTierRepository(),
+		userService:      NewUserService(),
+		tiers:            model.NewTierSchedule(tiers),
+		window:           tierConfig.GetWindow(),
+	}
+}
+
+func (s *tierServiceImpl) GetTiers() model.TierSchedule {
+	return s.tiers
+}
+
+func (s *tierServiceImpl) RecordSwap(swapID string, userID string, swapAmount float64, now time.Time) (*model.Tier, error) {
+	if err := s.volumeRepository.AddSwap(swapID, userID, now, swapAmount); err != nil {
+		return nil, err
+	}
+
+	return s.RefreshTier(userID, now)
+}
+
+// RefreshTier moves the user to the tier of the volume of the window ending
+// now, and records the change in the tier history.
+func (s *tierServiceImpl) RefreshTier(userID string, now time.Time) (*model.Tier, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	volume, err := s.volumeRepository.GetVolume(userID, now.Add(-s.window))
+	if err != nil {
+		return nil, err
+	}
+
+	tier := s.tiers.TierOf(volume)
+	var tierName string
+	if tier != nil {
+		tierName = tier.Name
+	}
+
+	if tierName == user.Tier {
+		return tier, nil
+	}
+
+	changed, err := s.tierRepository.ChangeTier(&model.TierChange{
+		UserID:        userID,
+		FromTier:      user.Tier,
+		ToTier:        tierName,
+		RollingVolume: volume,
+		CreatedAt:     now,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !changed {
+		// a concurrent refresh moved the user first, its tier is as recent
+		return s.tiers.Get(user.Tier), nil
+	}
+
+	log.Printf("User %s moved from tier %q to %q with a rolling volume of %f", userID, user.Tier, tierName, volume)
+	return tier, nil
+}
+
+// RefreshTiers drops the users whose rolling volume fell below their tier
+// without swapping since. Users in a tier without minimum can't drop.
+func (s *tierServiceImpl) RefreshTiers(ctx context.Context, now time.Time) error {
+	var tierNames []string
+	for _, tier := range s.tiers {
+		if tier.MinVolume > 0 {
+			tierNames = append(tierNames, tier.Name)
+		}
+	}
+
+	userIDs, err := s.tierRepository.SearchUserIDsByTiers(tierNames)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// one user failing shouldn't stop the others, the next run retries
+		if _, err := s.RefreshTier(userID, now); err != nil {
+			log.Printf("Failed to refresh tier of user %s: %v", userID, err)
+		}
+	}
+
+	return nil
+}
+
+// GetUserTiers returns the tier of each user, nil for a user without tier or
+// in a tier removed from the config.
+func (s *tierServiceImpl) GetUserTiers(userIDs []string) (map[string]*model.Tier, error) {
+	tierNames, err := s.tierRepository.GetUserTiers(userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make(map[string]*model.Tier, len(tierNames))
+	for userID, tierName := range tierNames {
+		tiers[userID] = s.tiers.Get(tierName)
+	}
+
+	return tiers, nil
+}
+
+func (s *tierServiceImpl) GetTierHistory(userID string) ([]*model.TierChange, error) {
+	return s.tierRepository.GetTierHistory(userID)
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/model"
+)
+
+type tierServiceTestSuite struct {
+	tierService            TierService
+	mockedVolumeRepository *repository.MockVolumeRepository
+	mockedTierRepository   *repository.MockTierRepository
+	mockedUserService      *service.MockUserService
+}
+
+var testTiers = model.NewTierSchedule([]*model.Tier{
+	{Name: "bronze", MinVolume: 0, Multiplier: 1},
+	{Name: "silver", MinVolume: 10000, Multiplier: 1.1, BonusCap: 500},
+	{Name: "gold", MinVolume: 100000, Multiplier: 1.25, BonusCap: 2000},
+})
+
+func (s *tierServiceTestSuite) setUp(t *testing.T) {
+	s.mockedVolumeRepository = repository.NewMockVolumeRepository(t)
+	s.mockedTierRepository = repository.NewMockTierRepository(t)
+	s.mockedUserService = service.NewMockUserService(t)
+	s.tierService = &tierServiceImpl{
+		volumeRepository: s.mockedVolumeRepository,
+		tierRepository:   s.mockedTierRepository,
+		userService:      s.mockedUserService,
+		tiers:            testTiers,
+		window:           30 * 24 * time.Hour,
+	}
+}
+
+func TestTierServiceImpl_RecordSwap(t *testing.T) {
+	testSuite := &tierServiceTestSuite{}
+	now := time.Date(2024, 9, 7, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Promoted", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedVolumeRepository.EXPECT().AddSwap("test_swap", "test_user", now, 5000.0).Return(nil).Times(1)
+		testSuite.mockedUserService.EXPECT().GetUserByID("test_user").Return(&model.User{ID: "test_user", Tier: "bronze"}, nil).Times(1)
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user", now.AddDate(0, 0, -30)).Return(12000.0, nil).Times(1)
+		testSuite.mockedTierRepository.EXPECT().ChangeTier(&model.TierChange{
+			UserID:        "test_user",
+			FromTier:      "bronze",
+			ToTier:        "silver",
+			RollingVolume: 12000,
+			CreatedAt:     now,
+		}).Return(true, nil).Times(1)
+
+		tier, err := testSuite.tierService.RecordSwap("test_swap", "test_user", 5000, now)
+		assert.NoError(t, err)
+		assert.Equal(t, "silver", tier.Name)
+	})
+
+	t.Run("Same Tier", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedVolumeRepository.EXPECT().AddSwap("test_swap", "test_user", now, 5000.0).Return(nil).Times(1)
+		testSuite.mockedUserService.EXPECT().GetUserByID("test_user").Return(&model.User{ID: "test_user", Tier: "gold"}, nil).Times(1)
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user", mock.Anything).Return(150000.0, nil).Times(1)
+
+		tier, err := testSuite.tierService.RecordSwap("test_swap", "test_user", 5000, now)
+		assert.NoError(t, err)
+		assert.Equal(t, "gold", tier.Name)
+	})
+
+	t.Run("Changed Concurrently", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedVolumeRepository.EXPECT().AddSwap("test_swap", "test_user", now, 5000.0).Return(nil).Times(1)
+		testSuite.mockedUserService.EXPECT().GetUserByID("test_user").Return(&model.User{ID: "test_user", Tier: "bronze"}, nil).Times(1)
+		testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user", mock.Anything).Return(12000.0, nil).Times(1)
+		testSuite.mockedTierRepository.EXPECT().ChangeTier(mock.Anything).Return(false, nil).Times(1)
+
+		tier, err := testSuite.tierService.RecordSwap("test_swap", "test_user", 5000, now)
+		assert.NoError(t, err)
+		assert.Equal(t, "bronze", tier.Name)
+	})
+}
+
+func TestTierServiceImpl_RefreshTiers(t *testing.T) {
+	testSuite := &tierServiceTestSuite{}
+	testSuite.setUp(t)
+	now := time.Date(2024, 9, 7, 12, 0, 0, 0, time.UTC)
+
+	testSuite.mockedTierRepository.EXPECT().SearchUserIDsByTiers([]string{"silver", "gold"}).Return([]string{"test_user_1", "test_user_2"}, nil).Times(1)
+
+	testSuite.mockedUserService.EXPECT().GetUserByID("test_user_1").Return(&model.User{ID: "test_user_1", Tier: "gold"}, nil).Times(1)
+	testSuite.mockedVolumeRepository.EXPECT().GetVolume("test_user_1", mock.Anything).Return(50000.0, nil).Times(1)
+	testSuite.mockedTierRepository.EXPECT().ChangeTier(mock.MatchedBy(func(change *model.TierChange) bool {
+		return change.UserID == "test_user_1" && change.FromTier == "gold" && change.ToTier == "silver"
+	})).Return(true, nil).Times(1)
+
+	// a failing user doesn't stop the others
+	testSuite.mockedUserService.EXPECT().GetUserByID("test_user_2").Return(nil, assert.AnError).Times(1)
+
+	assert.NoError(t, testSuite.tierService.RefreshTiers(context.Background(), now))
+}
+
+func TestTierServiceImpl_GetUserTiers(t *testing.T) {
+	testSuite := &tierServiceTestSuite{}
+	testSuite.setUp(t)
+
+	testSuite.mockedTierRepository.EXPECT().GetUserTiers([]string{"test_user_1", "test_user_2"}).
+		Return(map[string]string{"test_user_1": "gold", "test_user_2": ""}, nil).Times(1)
+
+	tiers, err := testSuite.tierService.GetUserTiers([]string{"test_user_1", "test_user_2"})
+	assert.NoError(t, err)
+	assert.Equal(t, "gold", tiers["test_user_1"].Name)
+	assert.Nil(t, tiers["test_user_2"])
+}
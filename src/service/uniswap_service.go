@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -10,32 +11,43 @@ import (
 	"trading-ace/src/repository"
 )
 
-const (
-	onboardingAmount      = 1000.0
-	onboardingReward      = 100.0
-	sharedPoolTotalReward = 10000.0
-)
-
 type UniSwapService interface {
-	ProcessUniSwapTransaction(senderID string, swapAmount float64) error
-	ProcessSharedPool(from time.Time, to time.Time) error
+	// ProcessUniSwapTransaction processes the swap identified by swapID, which
+	// makes a retried job count the swap volume, its shared pool tasks and its
+	// period stats once.
+	ProcessUniSwapTransaction(swapID string, senderID string, poolAddress string, swapAmount float64) error
+	ProcessSharedPool(ctx context.Context, campaign *model.Campaign, payouts []*model.SharedPoolPayout) error
 }
 
 type uniSwapServiceImpl struct {
-	taskService   TaskService
-	userService   UserService
-	rewardService RewardService
+	taskService        TaskService
+	userService        UserService
+	rewardService      RewardService
+	campaignService    CampaignService
+	periodStatsService PeriodStatsService
+	multiplierService  MultiplierService
+	tierService        TierService
+	referralService    ReferralService
+	streakService      StreakService
+	milestoneService   MilestoneService
 }
 
 func NewUniSwapService() UniSwapService {
 	return &uniSwapServiceImpl{
-		taskService:   NewTaskService(),
-		userService:   NewUserService(),
-		rewardService: NewRewardService(),
+		taskService:        NewTaskService(),
+		userService:        NewUserService(),
+		rewardService:      NewRewardService(),
+		campaignService:    NewCampaignService(),
+		periodStatsService: NewPeriodStatsService(),
+		multiplierService:  NewMultiplierService(),
+		tierService:        NewTierService(),
+		referralService:    NewReferralService(),
+		streakService:      NewStreakService(),
+		milestoneService:   NewMilestoneService(),
 	}
 }
 
-func (s *uniSwapServiceImpl) ProcessUniSwapTransaction(senderID string, swapAmount float64) error {
+func (s *uniSwapServiceImpl) ProcessUniSwapTransaction(swapID string, senderID string, poolAddress string, swapAmount float64) error {
 	sender, err := s.userService.GetUserByID(senderID)
 
 	if err != nil && !errors.Is(err, exception.UserNotFoundError) {
@@ -50,84 +62,333 @@ func (s *uniSwapServiceImpl) ProcessUniSwapTransaction(senderID string, swapAmou
 		return err
 	}
 
-	if !s.isUserAlreadyOnboard(senderID) {
-		err := s.processOnBoarding(senderID, swapAmount)
+	now := time.Now().UTC()
+	tier, err := s.tierService.RecordSwap(swapID, senderID, swapAmount, now)
+	if err != nil {
+		return err
+	}
 
+	streakMilestones, err := s.streakService.RecordSwap(senderID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, milestone := range streakMilestones {
+		// a failed milestone is released, the next swap of the streak pays it
+		achieved, err := s.processStreakMilestone(milestone, senderID, now)
 		if err != nil {
-			return err
+			log.Printf("Failed to reward the %s streak milestone of %s: %v", milestone.Key, senderID, err)
+			continue
 		}
+
+		if achieved {
+			log.Println(fmt.Sprintf("User %s reached the %s streak milestone", senderID, milestone.Key))
+		}
+	}
+
+	volumeMilestones, err := s.milestoneService.GetPendingVolumeMilestones(senderID)
+	if err != nil {
+		return err
 	}
 
-	_, err = s.taskService.CreateTask(senderID, model.TaskTypeSharedPool, swapAmount)
+	for _, milestone := range volumeMilestones {
+		// a failed milestone is achieved again by the next swap
+		achieved, err := s.processMilestone(milestone, senderID, 0, tier, now)
+		if err != nil {
+			log.Printf("Failed to reward the %s milestone of %s: %v", milestone.Key, senderID, err)
+			continue
+		}
+
+		if achieved {
+			log.Println(fmt.Sprintf("User %s reached a lifetime volume of %f USD", senderID, milestone.Threshold))
+		}
+	}
 
+	campaigns, err := s.campaignService.GetRunningCampaigns(poolAddress, now)
 	if err != nil {
 		return err
 	}
 
-	log.Println(fmt.Sprintf("User %s add %f USD to shared pool", senderID, swapAmount))
+	if len(campaigns) == 0 {
+		log.Println(fmt.Sprintf("No running campaign for pool %s", poolAddress))
+		return nil
+	}
+
+	var onboardedCampaign *model.Campaign
+	for _, campaign := range campaigns {
+		onboarded := s.isUserAlreadyOnboard(senderID, campaign.ID)
+		if !onboarded {
+			onboarded, err = s.processOnBoarding(campaign, senderID, swapAmount, tier, now)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if onboarded && onboardedCampaign == nil {
+			onboardedCampaign = campaign
+		}
+
+		_, err = s.taskService.CreateSharedPoolTask(swapID, senderID, campaign.ID, swapAmount)
+
+		if err != nil {
+			return err
+		}
+
+		// the stats only back projections, they are rebuilt from the tasks
+		// when the period is refreshed
+		if err := s.periodStatsService.RecordSwap(swapID, campaign, senderID, now, swapAmount, onboarded, tier); err != nil {
+			log.Printf("Failed to record swap stats of %s for campaign %d: %v", senderID, campaign.ID, err)
+		}
+
+		log.Println(fmt.Sprintf("User %s add %f USD to shared pool of campaign %d", senderID, swapAmount, campaign.ID))
+	}
+
+	// the referrer is rewarded when the user first onboards, or by the next
+	// swap when that reward failed
+	if onboardedCampaign != nil {
+		if err := s.processReferral(onboardedCampaign, senderID); err != nil {
+			log.Printf("Failed to reward the referrer of %s: %v", senderID, err)
+		}
+	}
 
 	return nil
 }
 
-func (s *uniSwapServiceImpl) ProcessSharedPool(from time.Time, to time.Time) error {
-	tasks, err := s.taskService.SearchTasks(&repository.SearchTasksCondition{
-		StartTime: from,
-		EndTime:   to,
-		Type:      model.TaskTypeSharedPool,
-		Status:    model.TaskStatusPending,
-	})
+// ProcessSharedPool pays the payouts of a settlement and completes their
+// tasks. A task is rewarded once, so paying the payouts of an interrupted run
+// again only pays the ones it missed. The referrers of the users earn their
+// share of the payouts on top of the period budget. A failed payout doesn't
+// stop the others, the first failure is returned once they are done.
+func (s *uniSwapServiceImpl) ProcessSharedPool(ctx context.Context, campaign *model.Campaign, payouts []*model.SharedPoolPayout) error {
+	referralShare := s.referralService.GetRewards().SharedPoolShare
+	referrers := map[string]string{}
+	if referralShare > 0 && len(payouts) > 0 {
+		userIDs := make([]string, 0, len(payouts))
+		for _, payout := range payouts {
+			userIDs = append(userIDs, payout.UserID)
+		}
 
-	if err != nil {
-		return err
+		var err error
+		if referrers, err = s.referralService.GetReferrers(userIDs); err != nil {
+			return err
+		}
 	}
 
-	totalSwapAmount := 0.0
-	var filteredTasks []*model.Task
-	for _, task := range *tasks {
-		if !s.isUserAlreadyOnboard(task.UserID) {
+	var failure error
+	for _, payout := range payouts {
+		// stop as soon as the caller gives up, e.g. when the settlement lock is lost
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := s.payShared(campaign, payout); err != nil {
+			log.Printf("Failed to pay task %d of campaign %d: %v", payout.TaskID, campaign.ID, err)
+			if failure == nil {
+				failure = err
+			}
 			continue
 		}
 
-		totalSwapAmount += task.SwapAmount
-		filteredTasks = append(filteredTasks, task)
+		// the referee is paid, the referrer is paid once per task however many
+		// times the payout is retried
+		if referrerID, ok := referrers[payout.UserID]; ok && payout.Points > 0 {
+			if err := s.payReferrer(campaign, referrerID, payout.TaskID, payout.Points*referralShare); err != nil {
+				log.Printf("Failed to reward referrer %s for task %d: %v", referrerID, payout.TaskID, err)
+				if failure == nil {
+					failure = err
+				}
+			}
+		}
 	}
 
-	for _, task := range filteredTasks {
-		rewardAmount := sharedPoolTotalReward * task.SwapAmount / totalSwapAmount
-		_ = s.rewardService.RewardUser(task.UserID, task.ID, rewardAmount)
-		_ = s.taskService.CompleteTask(task.ID)
+	return failure
+}
+
+// payShared rewards and completes the task of the payout.
+func (s *uniSwapServiceImpl) payShared(campaign *model.Campaign, payout *model.SharedPoolPayout) error {
+	if payout.Points > 0 {
+		err := s.rewardService.RewardUser(payout.UserID, campaign.ID, payout.TaskID, payout.Points, payout.Multipliers)
+		if err != nil && !errors.Is(err, exception.TaskAlreadyRewardedError) {
+			return err
+		}
+	}
+
+	return s.taskService.CompleteTask(payout.TaskID)
+}
+
+// payReferrer rewards the referrer its share of the task of a referee unless
+// it already was, a failed reward is released so a retry pays it.
+func (s *uniSwapServiceImpl) payReferrer(campaign *model.Campaign, referrerID string, taskID int, points float64) error {
+	claimed, err := s.referralService.ClaimSharedPoolReward(taskID, referrerID, time.Now().UTC())
+	if err != nil || !claimed {
+		return err
+	}
+
+	if err := s.rewardTask(referrerID, campaign.ID, model.TaskTypeReferral, points); err != nil {
+		if err := s.referralService.ReleaseSharedPoolReward(taskID); err != nil {
+			log.Printf("Failed to release the referral reward of task %d: %v", taskID, err)
+		}
+		return err
 	}
 
 	return nil
 }
 
-func (s *uniSwapServiceImpl) processOnBoarding(userID string, swapAmount float64) error {
-	if swapAmount < onboardingAmount {
+// processOnBoarding onboards the user when the swap meets the campaign
+// requirement and tells whether it did.
+func (s *uniSwapServiceImpl) processOnBoarding(campaign *model.Campaign, userID string, swapAmount float64, tier *model.Tier, now time.Time) (bool, error) {
+	milestone := model.OnboardingMilestone(campaign)
+	if !milestone.ReachedBy(swapAmount) {
 		log.Println(fmt.Sprintf("User %s does not meet the onboarding requirement", userID))
-		return nil
+		return false, nil
 	}
 
 	log.Println(fmt.Sprintf("User %s satisfy onboarding condition with amount %f", userID, swapAmount))
 
-	task, err := s.taskService.CreateTask(userID, model.TaskTypeOnboarding, swapAmount)
+	// the milestone isn't achieved when a concurrent swap onboarded the user,
+	// who is onboarded either way
+	if _, err := s.processMilestone(milestone, userID, swapAmount, tier, now); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// processMilestone rewards the first achievement of the milestone by the user
+// with a completed task of the milestone, the reward boosted by the
+// multipliers active at now and the tier of the user. It tells false when the
+// user already achieved it.
+func (s *uniSwapServiceImpl) processMilestone(milestone *model.MilestoneRule, userID string, swapAmount float64, tier *model.Tier, now time.Time) (bool, error) {
+	claimed, err := s.milestoneService.ClaimMilestone(userID, milestone, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if err := s.rewardMilestone(milestone, userID, swapAmount, tier, now); err != nil {
+		if err := s.milestoneService.ReleaseMilestone(userID, milestone); err != nil {
+			log.Printf("Failed to release the %s milestone of %s: %v", milestone.Key, userID, err)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// processStreakMilestone pays the reward of a streak milestone as is, streak
+// rewards belong to no campaign and aren't boosted.
+func (s *uniSwapServiceImpl) processStreakMilestone(milestone *model.MilestoneRule, userID string, now time.Time) (bool, error) {
+	claimed, err := s.milestoneService.ClaimMilestone(userID, milestone, now)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if err := s.rewardTask(userID, 0, milestone.TaskType, milestone.Reward); err != nil {
+		if err := s.milestoneService.ReleaseMilestone(userID, milestone); err != nil {
+			log.Printf("Failed to release the %s milestone of %s: %v", milestone.Key, userID, err)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *uniSwapServiceImpl) rewardMilestone(milestone *model.MilestoneRule, userID string, swapAmount float64, tier *model.Tier, now time.Time) error {
+	var points float64
+	var applied []*model.AppliedMultiplier
+	if milestone.Reward > 0 {
+		multipliers, err := s.multiplierService.GetActiveMultipliers(milestone.CampaignID, now, now.Add(time.Nanosecond))
+		if err != nil {
+			return err
+		}
+
+		var factor float64
+		factor, applied = model.ApplyMultipliers(multipliers, userID, milestone.CampaignID, now)
+		if tierMultiplier := tier.AppliedMultiplier(); tierMultiplier != nil {
+			factor *= tierMultiplier.Factor
+			applied = append(applied, tierMultiplier)
+		}
+
+		points = tier.CapBonus(milestone.Reward * factor)
+	}
+
+	task, err := s.taskService.CreateTask(userID, milestone.CampaignID, milestone.TaskType, swapAmount)
 
 	if err != nil {
 		return err
 	}
 
-	err = s.rewardService.RewardUser(userID, task.ID, onboardingReward)
+	return s.payTask(task, userID, milestone.CampaignID, points, applied)
+}
+
+// processReferral rewards the referrer of the user the first time the user
+// onboards, in any campaign. A failed reward is released so the next swap pays
+// it.
+func (s *uniSwapServiceImpl) processReferral(campaign *model.Campaign, userID string) error {
+	referral, err := s.referralService.OnboardReferee(userID, time.Now().UTC())
+	if err != nil || referral == nil {
+		return err
+	}
+
+	points := s.referralService.GetRewards().OnboardingReward
+	if points <= 0 {
+		return nil
+	}
+
+	log.Println(fmt.Sprintf("User %s onboarded, rewarding referrer %s", userID, referral.ReferrerID))
+	if err := s.rewardTask(referral.ReferrerID, campaign.ID, model.TaskTypeReferral, points); err != nil {
+		if err := s.referralService.ReleaseReferee(userID); err != nil {
+			log.Printf("Failed to release the referral of %s: %v", userID, err)
+		}
+		return err
+	}
 
+	return nil
+}
+
+// rewardTask rewards the user through a completed task with no swap amount,
+// campaign 0 for a task of no campaign.
+func (s *uniSwapServiceImpl) rewardTask(userID string, campaignID int, taskType model.TaskType, points float64) error {
+	task, err := s.taskService.CreateTask(userID, campaignID, taskType, 0)
 	if err != nil {
 		return err
 	}
 
-	return s.taskService.CompleteTask(task.ID)
+	return s.payTask(task, userID, campaignID, points, nil)
+}
+
+// payTask rewards the points of a task just created and completes it. A task
+// whose reward failed is discarded, so releasing the claim that guarded it
+// leaves no pending task behind. Once the reward is paid the claim must hold,
+// a task that fails to complete is only logged.
+func (s *uniSwapServiceImpl) payTask(task *model.Task, userID string, campaignID int, points float64, multipliers []*model.AppliedMultiplier) error {
+	if points > 0 {
+		if err := s.rewardService.RewardUser(userID, campaignID, task.ID, points, multipliers); err != nil {
+			if err := s.taskService.DiscardTask(task.ID); err != nil {
+				log.Printf("Failed to discard task %d of %s: %v", task.ID, userID, err)
+			}
+			return err
+		}
+	}
+
+	if err := s.taskService.CompleteTask(task.ID); err != nil {
+		log.Printf("Failed to complete the rewarded task %d of %s: %v", task.ID, userID, err)
+	}
+
+	return nil
+}
+
+func (s *uniSwapServiceImpl) isUserAlreadyOnboard(userID string, campaignID int) bool {
+	return isUserOnboarded(s.taskService, userID, campaignID)
 }
 
-func (s *uniSwapServiceImpl) isUserAlreadyOnboard(userID string) bool {
-	tasks, err := s.taskService.SearchTasks(&repository.SearchTasksCondition{
-		UserID: userID,
-		Type:   model.TaskTypeOnboarding,
+// isUserOnboarded tells whether the user completed the onboarding task of the
+// campaign, false when it can't be told.
+func isUserOnboarded(taskService TaskService, userID string, campaignID int) bool {
+	tasks, err := taskService.SearchTasks(&repository.SearchTasksCondition{
+		UserID:     userID,
+		CampaignID: campaignID,
+		Type:       model.TaskTypeOnboarding,
 	})
 	return err == nil && len(*tasks) > 0
 }
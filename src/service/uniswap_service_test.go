@@ -1,8 +1,10 @@
 package service
 
 import (
+	"context"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"math"
 	"testing"
 	"time"
 	"trading-ace/mock/service"
@@ -12,20 +14,41 @@ import (
 )
 
 type uniSwapServiceTestSuite struct {
-	uniSwapService      *uniSwapServiceImpl
-	mockedUserService   *service.MockUserService
-	mockedTaskService   *service.MockTaskService
-	mockedRewardService *service.MockRewardService
+	uniSwapService          *uniSwapServiceImpl
+	mockedUserService       *service.MockUserService
+	mockedTaskService       *service.MockTaskService
+	mockedRewardService     *service.MockRewardService
+	mockedCampaignService   *service.MockCampaignService
+	mockedStatsService      *service.MockPeriodStatsService
+	mockedMultiplierService *service.MockMultiplierService
+	mockedTierService       *service.MockTierService
+	mockedReferralService   *service.MockReferralService
+	mockedStreakService     *service.MockStreakService
+	mockedMilestoneService  *service.MockMilestoneService
 }
 
 func (s *uniSwapServiceTestSuite) setUp(t *testing.T) {
 	s.mockedUserService = service.NewMockUserService(t)
 	s.mockedTaskService = service.NewMockTaskService(t)
 	s.mockedRewardService = service.NewMockRewardService(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.mockedStatsService = service.NewMockPeriodStatsService(t)
+	s.mockedMultiplierService = service.NewMockMultiplierService(t)
+	s.mockedTierService = service.NewMockTierService(t)
+	s.mockedReferralService = service.NewMockReferralService(t)
+	s.mockedStreakService = service.NewMockStreakService(t)
+	s.mockedMilestoneService = service.NewMockMilestoneService(t)
 	s.uniSwapService = &uniSwapServiceImpl{
-		userService:   s.mockedUserService,
-		taskService:   s.mockedTaskService,
-		rewardService: s.mockedRewardService,
+		userService:        s.mockedUserService,
+		taskService:        s.mockedTaskService,
+		rewardService:      s.mockedRewardService,
+		campaignService:    s.mockedCampaignService,
+		periodStatsService: s.mockedStatsService,
+		multiplierService:  s.mockedMultiplierService,
+		tierService:        s.mockedTierService,
+		referralService:    s.mockedReferralService,
+		streakService:      s.mockedStreakService,
+		milestoneService:   s.mockedMilestoneService,
 	}
 }
 
@@ -46,13 +69,33 @@ func (s *uniSwapServiceTestSuite) createSharedPoolTasks(taskSetting struct {
 
 var uniSwapTestSuite = &uniSwapServiceTestSuite{}
 
+const testPoolAddress = "0xB4e16d0168e52d35CaCD2c6185b44281Ec28C9Dc"
+
+const testSwapID = "0x5c504ed432cb51138bcf09aa5e8a410dd4a1e204ef84bfed1be16dfba1b22060:3"
+
+var testCampaign = &model.Campaign{
+	ID:               1,
+	Name:             "test_campaign",
+	Pools:            []string{testPoolAddress},
+	StartTime:        time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	PeriodType:       model.PeriodTypeDaily,
+	Timezone:         model.DefaultTimezone,
+	Periods:          4,
+	BudgetPerPeriod:  10000,
+	BudgetDecay:      1,
+	OnboardingAmount: 1000,
+	OnboardingReward: 100,
+	Status:           model.CampaignStatusActive,
+}
+
 func TestIsUserAlreadyOnboard(t *testing.T) {
 	t.Run("User Already Onboarded", func(t *testing.T) {
 		uniSwapTestSuite.setUp(t)
 
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			UserID: "test_user_address",
-			Type:   model.TaskTypeOnboarding,
+			UserID:     "test_user_address",
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
 		}).Return(&[]*model.Task{
 			{
 				ID:         1,
@@ -63,7 +106,7 @@ func TestIsUserAlreadyOnboard(t *testing.T) {
 			},
 		}, nil).Times(1)
 
-		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address")
+		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address", 1)
 		assert.True(t, result)
 	})
 
@@ -71,11 +114,12 @@ func TestIsUserAlreadyOnboard(t *testing.T) {
 		uniSwapTestSuite.setUp(t)
 
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			UserID: "test_user_address",
-			Type:   model.TaskTypeOnboarding,
+			UserID:     "test_user_address",
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
 		}).Return(&[]*model.Task{}, nil).Times(1)
 
-		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address")
+		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address", 1)
 		assert.False(t, result)
 	})
 
@@ -83,11 +127,12 @@ func TestIsUserAlreadyOnboard(t *testing.T) {
 		uniSwapTestSuite.setUp(t)
 
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			UserID: "test_user_address",
-			Type:   model.TaskTypeOnboarding,
+			UserID:     "test_user_address",
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
 		}).Return(nil, assert.AnError).Times(1)
 
-		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address")
+		result := uniSwapTestSuite.uniSwapService.isUserAlreadyOnboard("test_user_address", 1)
 		assert.False(t, result)
 	})
 }
@@ -102,15 +147,70 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			Points: 0,
 		}, nil).Times(1)
 
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+
+		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(
+			&repository.SearchTasksCondition{
+				UserID:     "test_user_address",
+				CampaignID: 1,
+				Type:       model.TaskTypeOnboarding,
+			},
+		).Return(&[]*model.Task{}, nil).Times(1)
+
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", model.OnboardingMilestone(testCampaign), mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask(
+			"test_user_address",
+			1,
+			model.TaskTypeOnboarding,
+			10000.0,
+		).Return(&model.Task{
+			ID:         10,
+			Type:       model.TaskTypeOnboarding,
+			Status:     model.TaskStatusPending,
+			SwapAmount: 10000.0,
+		}, nil).Times(1)
+
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, mock.Anything, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 1, 10, 100.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(10).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", 1, 10000.0).Return(&model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Onboarding Reward Multiplied", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(nil, exception.UserNotFoundError).Times(1)
+		uniSwapTestSuite.mockedUserService.EXPECT().CreateUser("test_user_address").Return(&model.User{
+			ID:     "test_user_address",
+			Points: 0,
+		}, nil).Times(1)
+
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).
+			Return(&model.Tier{Name: "silver", MinVolume: 10000, Multiplier: 1.1, BonusCap: 5}, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(
 			&repository.SearchTasksCondition{
-				UserID: "test_user_address",
-				Type:   model.TaskTypeOnboarding,
+				UserID:     "test_user_address",
+				CampaignID: 1,
+				Type:       model.TaskTypeOnboarding,
 			},
 		).Return(&[]*model.Task{}, nil).Times(1)
 
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", model.OnboardingMilestone(testCampaign), mock.Anything).Return(true, nil).Times(1)
 		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask(
 			"test_user_address",
+			1,
 			model.TaskTypeOnboarding,
 			10000.0,
 		).Return(&model.Task{
@@ -120,11 +220,222 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			SwapAmount: 10000.0,
 		}, nil).Times(1)
 
-		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 10, 100.0).Return(nil).Times(1)
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, mock.Anything, mock.Anything).Return([]*model.Multiplier{
+			{ID: 1, Name: "launch week", Factor: 2, StartTime: testCampaign.StartTime},
+			{ID: 2, Name: "vip", Factor: 1.5, UserIDs: []string{"other_user_address"}, StartTime: testCampaign.StartTime},
+		}, nil).Times(1)
+		// the silver bonus of 20 points is capped at 5
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 1, 10, mock.MatchedBy(func(points float64) bool {
+			return math.Abs(points-205) < 1e-9
+		}), []*model.AppliedMultiplier{{ID: 1, Name: "launch week", Factor: 2}, {Name: "silver tier", Factor: 1.1}}).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(10).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", 1, 10000.0).Return(&model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Referrer Rewarded On First Onboarding", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(
+			&repository.SearchTasksCondition{
+				UserID:     "test_user_address",
+				CampaignID: 1,
+				Type:       model.TaskTypeOnboarding,
+			},
+		).Return(&[]*model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", model.OnboardingMilestone(testCampaign), mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 1, model.TaskTypeOnboarding, 10000.0).Return(&model.Task{ID: 10}, nil).Times(1)
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(1, mock.Anything, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 1, 10, 100.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
 		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(10).Return(nil).Times(1)
-		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", model.TaskTypeSharedPool, 10000.0).Return(&model.Task{}, nil).Times(1)
 
-		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction("test_user_address", 10000.0)
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(&model.Referral{
+			RefereeID:  "test_user_address",
+			ReferrerID: "referrer_address",
+			Code:       "ALICE",
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{OnboardingReward: 50}).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("referrer_address", 1, model.TaskTypeReferral, 0.0).Return(&model.Task{ID: 11}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("referrer_address", 1, 11, 50.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(11).Return(nil).Times(1)
+
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", 1, 10000.0).Return(&model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Referral Released When the Referrer Reward Fails", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(
+			&repository.SearchTasksCondition{
+				UserID:     "test_user_address",
+				CampaignID: 1,
+				Type:       model.TaskTypeOnboarding,
+			},
+		).Return(&[]*model.Task{{ID: 10, Type: model.TaskTypeOnboarding}}, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", 1, 10000.0).Return(&model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).Return(nil).Times(1)
+
+		// the referral left by an earlier failure is paid by this swap, and released again
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(&model.Referral{
+			RefereeID:  "test_user_address",
+			ReferrerID: "referrer_address",
+			Code:       "ALICE",
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{OnboardingReward: 50}).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("referrer_address", 1, model.TaskTypeReferral, 0.0).Return(nil, assert.AnError).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().ReleaseReferee("test_user_address").Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+
+	streakMilestone := (&model.StreakMilestone{Period: model.PeriodTypeDaily, Length: 7, Reward: 100}).Rule(&model.Streak{
+		Period: model.PeriodTypeDaily, Current: 7, LastPeriod: time.Date(2024, 9, 11, 0, 0, 0, 0, time.UTC),
+	})
+
+	t.Run("Streak Milestone Rewarded", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return([]*model.MilestoneRule{streakMilestone}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", streakMilestone, mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		// streak milestones belong to no campaign
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 0, model.TaskTypeStreak, 0.0).Return(&model.Task{ID: 30}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 0, 30, 100.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(30).Return(nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Streak Milestone Released When the Reward Fails", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return([]*model.MilestoneRule{streakMilestone}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", streakMilestone, mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 0, model.TaskTypeStreak, 0.0).Return(nil, assert.AnError).Times(1)
+		// the next swap of the streak pays it
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ReleaseMilestone("test_user_address", streakMilestone).Return(nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Volume Milestones Rewarded Once", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		milestone := model.VolumeMilestone(10000, 100)
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return([]*model.MilestoneRule{
+			milestone, model.VolumeMilestone(100000, 1000),
+		}, nil).Times(1)
+
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", milestone, mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 0, model.TaskTypeVolumeMilestone, 0.0).Return(&model.Task{ID: 40}, nil).Times(1)
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(0, mock.Anything, mock.Anything).Return([]*model.Multiplier{
+			{ID: 1, Name: "launch week", Factor: 2, StartTime: testCampaign.StartTime},
+			{ID: 2, Name: "campaign only", Factor: 3, CampaignID: 1, StartTime: testCampaign.StartTime},
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 0, 40, 200.0, []*model.AppliedMultiplier{{ID: 1, Name: "launch week", Factor: 2}}).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(40).Return(nil).Times(1)
+
+		// a concurrent swap already achieved the second one
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", model.VolumeMilestone(100000, 1000), mock.Anything).Return(false, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Failed Milestone Released", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		milestone := model.VolumeMilestone(10000, 100)
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return([]*model.MilestoneRule{milestone}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", milestone, mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(0, mock.Anything, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 0, model.TaskTypeVolumeMilestone, 0.0).Return(&model.Task{ID: 40}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 0, 40, 100.0, mock.Anything).Return(assert.AnError).Times(1)
+		// no pending task is left behind for the next swap to duplicate
+		uniSwapTestSuite.mockedTaskService.EXPECT().DiscardTask(40).Return(nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ReleaseMilestone("test_user_address", milestone).Return(nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Paid Milestone Kept When the Task Fails To Complete", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		milestone := model.VolumeMilestone(10000, 100)
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return([]*model.MilestoneRule{milestone}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", milestone, mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedMultiplierService.EXPECT().GetActiveMultipliers(0, mock.Anything, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("test_user_address", 0, model.TaskTypeVolumeMilestone, 0.0).Return(&model.Task{ID: 40}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_address", 0, 40, 100.0, mock.Anything).Return(nil).Times(1)
+		// the reward is paid, releasing the milestone would pay it twice
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(40).Return(assert.AnError).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Onboarded By A Concurrent Swap", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{ID: "test_user_address"}, nil).Times(1)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(
+			&repository.SearchTasksCondition{
+				UserID:     "test_user_address",
+				CampaignID: 1,
+				Type:       model.TaskTypeOnboarding,
+			},
+		).Return(&[]*model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().ClaimMilestone("test_user_address", model.OnboardingMilestone(testCampaign), mock.Anything).Return(false, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", 1, 10000.0).Return(&model.Task{}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
 		assert.Nil(t, err)
 	})
 
@@ -136,14 +447,21 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			Points: 0,
 		}, nil).Times(1)
 
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			UserID: "test_user_address",
-			Type:   model.TaskTypeOnboarding,
+			UserID:     "test_user_address",
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
 		}).Return(&[]*model.Task{}, nil).Times(1)
 
-		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask(
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(
+			testSwapID,
 			"test_user_address",
-			model.TaskTypeSharedPool,
+			1,
 			50.0,
 		).Return(&model.Task{
 			UserID:     "test_user_address",
@@ -151,8 +469,9 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			Status:     model.TaskStatusPending,
 			SwapAmount: 50.0,
 		}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 50.0, false, mock.Anything).Return(nil).Times(1)
 
-		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction("test_user_address", 50.0)
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
 		assert.Nil(t, err)
 	})
 
@@ -164,9 +483,15 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			Points: 0,
 		}, nil).Times(1)
 
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign}, nil).Times(1)
+
 		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			UserID: "test_user_address",
-			Type:   model.TaskTypeOnboarding,
+			UserID:     "test_user_address",
+			CampaignID: 1,
+			Type:       model.TaskTypeOnboarding,
 		}).Return(&[]*model.Task{
 			{
 				ID:         1,
@@ -177,9 +502,10 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			},
 		}, nil).Times(1)
 
-		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask(
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(
+			testSwapID,
 			"test_user_address",
-			model.TaskTypeSharedPool,
+			1,
 			10000.0,
 		).Return(&model.Task{
 			ID:         10,
@@ -187,123 +513,229 @@ func TestUniSwapServiceImpl_ProcessUniSwapTransaction(t *testing.T) {
 			Status:     model.TaskStatusPending,
 			SwapAmount: 10000.0,
 		}, nil).Times(1)
+		uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, testCampaign, "test_user_address", mock.Anything, 10000.0, true, mock.Anything).
+			Return(assert.AnError).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+}
+
+func TestUniSwapServiceImpl_ProcessUniSwapTransaction_Campaigns(t *testing.T) {
+	t.Run("No running campaign for pool", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{
+			ID:     "test_user_address",
+			Points: 0,
+		}, nil).Times(1)
+
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 10000.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 10000.0)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Accrue to every running campaign", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		otherCampaign := *testCampaign
+		otherCampaign.ID = 2
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{
+			ID:     "test_user_address",
+			Points: 0,
+		}, nil).Times(1)
 
-		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction("test_user_address", 10000.0)
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return([]*model.Campaign{testCampaign, &otherCampaign}, nil).Times(1)
+
+		for _, campaignID := range []int{1, 2} {
+			uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
+				UserID:     "test_user_address",
+				CampaignID: campaignID,
+				Type:       model.TaskTypeOnboarding,
+			}).Return(&[]*model.Task{{ID: campaignID, Type: model.TaskTypeOnboarding}}, nil).Times(1)
+
+			uniSwapTestSuite.mockedTaskService.EXPECT().CreateSharedPoolTask(testSwapID, "test_user_address", campaignID, 50.0).Return(&model.Task{}, nil).Times(1)
+			uniSwapTestSuite.mockedStatsService.EXPECT().RecordSwap(testSwapID, mock.MatchedBy(func(c *model.Campaign) bool {
+				return c.ID == campaignID
+			}), "test_user_address", mock.Anything, 50.0, true, mock.Anything).Return(nil).Times(1)
+		}
+		// the referrer is rewarded in the first campaign the user is onboarded in
+		uniSwapTestSuite.mockedReferralService.EXPECT().OnboardReferee("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
 		assert.Nil(t, err)
 	})
+
+	t.Run("Campaign Query Error", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedUserService.EXPECT().GetUserByID("test_user_address").Return(&model.User{
+			ID:     "test_user_address",
+			Points: 0,
+		}, nil).Times(1)
+
+		uniSwapTestSuite.mockedTierService.EXPECT().RecordSwap(testSwapID, "test_user_address", 50.0, mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedStreakService.EXPECT().RecordSwap("test_user_address", mock.Anything).Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedMilestoneService.EXPECT().GetPendingVolumeMilestones("test_user_address").Return(nil, nil).Times(1)
+		uniSwapTestSuite.mockedCampaignService.EXPECT().GetRunningCampaigns(testPoolAddress, mock.Anything).Return(nil, assert.AnError).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessUniSwapTransaction(testSwapID, "test_user_address", testPoolAddress, 50.0)
+		assert.NotNil(t, err)
+	})
 }
 
 func TestUniSwapServiceImpl_ProcessSharedPool(t *testing.T) {
-	parseTime := func(timeStr string) time.Time {
-		t, _ := time.Parse("2006-01-02", timeStr)
-		return t
-	}
+	t.Run("Pay the Payouts", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
 
-	createdTime := parseTime("2021-01-01").Add(time.Hour * 5)
-	tasksPool := []*model.Task{
-		{
-			ID:         1,
-			UserID:     "test_user_1",
-			Type:       model.TaskTypeSharedPool,
-			Status:     model.TaskStatusPending,
-			SwapAmount: 10.0,
-			CreatedAt:  createdTime,
-		},
-		{
-			ID:         3,
-			UserID:     "test_user_1",
-			Type:       model.TaskTypeSharedPool,
-			Status:     model.TaskStatusPending,
-			SwapAmount: 20.0,
-			CreatedAt:  createdTime,
-		},
-		{
-			ID:         4,
-			UserID:     "test_user_2",
-			Type:       model.TaskTypeSharedPool,
-			Status:     model.TaskStatusPending,
-			SwapAmount: 10.0,
-			CreatedAt:  createdTime,
-		},
-		{
-			ID:         7,
-			UserID:     "test_user_3",
-			Type:       model.TaskTypeSharedPool,
-			Status:     model.TaskStatusPending,
-			SwapAmount: 10.0,
-			CreatedAt:  createdTime,
-		},
-		{
-			ID:         6,
-			UserID:     "test_user_2",
-			Type:       model.TaskTypeSharedPool,
-			Status:     model.TaskStatusPending,
-			SwapAmount: 10.0,
-			CreatedAt:  createdTime,
-		},
-	}
+		payouts := []*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", Points: 2000},
+			{TaskID: 3, UserID: "test_user_1", Points: 4000, Multipliers: []*model.AppliedMultiplier{{ID: 1, Name: "weekend", Factor: 2}}},
+		}
+
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{}).Times(1)
+		for _, payout := range payouts {
+			uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser(payout.UserID, 1, payout.TaskID, payout.Points, payout.Multipliers).Return(nil).Times(1)
+			uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(payout.TaskID).Return(nil).Times(1)
+		}
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.Nil(t, err)
+	})
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Complete Tasks Rewarded By an Interrupted Run", func(t *testing.T) {
 		uniSwapTestSuite.setUp(t)
 
-		fromTime := parseTime("2021-01-01")
-		toTime := parseTime("2021-01-02")
+		payouts := []*model.SharedPoolPayout{{TaskID: 1, UserID: "test_user_1", Points: 2000}}
 
-		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			Type:      model.TaskTypeSharedPool,
-			Status:    model.TaskStatusPending,
-			StartTime: fromTime,
-			EndTime:   toTime,
-		}).Return(&tasksPool, nil).Times(1)
-
-		IsUsersOnBoarded := map[string]bool{
-			"test_user_1": true,
-			"test_user_2": true,
-			"test_user_3": false,
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{}).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_1", 1, 1, 2000.0, []*model.AppliedMultiplier(nil)).
+			Return(exception.TaskAlreadyRewardedError).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(1).Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Pay the Others When a Payout Fails", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		payouts := []*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", Points: 2000},
+			{TaskID: 4, UserID: "test_user_2", Points: 2000},
 		}
 
-		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
-			return condition.Type == model.TaskTypeOnboarding && IsUsersOnBoarded[condition.UserID]
-		})).Return(&[]*model.Task{
-			{
-				Type:   model.TaskTypeOnboarding,
-				Status: model.TaskStatusDone,
-			},
-		}, nil)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{}).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_1", 1, 1, 2000.0, []*model.AppliedMultiplier(nil)).
+			Return(assert.AnError).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_2", 1, 4, 2000.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(4).Return(nil).Times(1)
 
-		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(mock.MatchedBy(func(condition *repository.SearchTasksCondition) bool {
-			return condition.Type == model.TaskTypeOnboarding && !IsUsersOnBoarded[condition.UserID]
-		})).Return(&[]*model.Task{}, nil)
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
 
-		filteredTasksAndExpectedPoints := map[*model.Task]float64{
-			tasksPool[0]: 2000,
-			tasksPool[1]: 4000,
-			tasksPool[2]: 2000,
-			tasksPool[4]: 2000,
+	t.Run("Referrers Earn a Share", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		payouts := []*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", Points: 5000},
+			{TaskID: 4, UserID: "test_user_2", Points: 5000},
 		}
 
-		for task, expectedPoints := range filteredTasksAndExpectedPoints {
-			uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser(task.UserID, task.ID, expectedPoints).Return(nil).Times(1)
-			uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(task.ID).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{SharedPoolShare: 0.1}).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetReferrers([]string{"test_user_1", "test_user_2"}).Return(map[string]string{
+			"test_user_2": "referrer_address",
+		}, nil).Times(1)
+
+		for _, payout := range payouts {
+			uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser(payout.UserID, 1, payout.TaskID, 5000.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+			uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(payout.TaskID).Return(nil).Times(1)
 		}
+		// the share of the referrer is paid on top of the budget
+		uniSwapTestSuite.mockedReferralService.EXPECT().ClaimSharedPoolReward(4, "referrer_address", mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("referrer_address", 1, model.TaskTypeReferral, 0.0).Return(&model.Task{ID: 20}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("referrer_address", 1, 20, 500.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(20).Return(nil).Times(1)
 
-		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(fromTime, toTime)
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
 		assert.Nil(t, err)
 	})
 
-	t.Run("Query Error", func(t *testing.T) {
+	t.Run("Referrers Are Paid Once per Task", func(t *testing.T) {
 		uniSwapTestSuite.setUp(t)
 
-		fromTime := parseTime("2021-01-01")
-		toTime := parseTime("2021-01-02")
-		uniSwapTestSuite.mockedTaskService.EXPECT().SearchTasks(&repository.SearchTasksCondition{
-			Type:      model.TaskTypeSharedPool,
-			Status:    model.TaskStatusPending,
-			StartTime: fromTime,
-			EndTime:   toTime,
-		}).Return(nil, assert.AnError).Times(1)
+		payouts := []*model.SharedPoolPayout{{TaskID: 4, UserID: "test_user_2", Points: 5000}}
 
-		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(fromTime, toTime)
-		assert.NotNil(t, err)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{SharedPoolShare: 0.1}).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetReferrers([]string{"test_user_2"}).Return(map[string]string{
+			"test_user_2": "referrer_address",
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_2", 1, 4, 5000.0, []*model.AppliedMultiplier(nil)).
+			Return(exception.TaskAlreadyRewardedError).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(4).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().ClaimSharedPoolReward(4, "referrer_address", mock.Anything).Return(false, nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Referrers Are Not Paid When the Referee Payout Fails", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		payouts := []*model.SharedPoolPayout{{TaskID: 4, UserID: "test_user_2", Points: 5000}}
+
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{SharedPoolShare: 0.1}).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetReferrers([]string{"test_user_2"}).Return(map[string]string{
+			"test_user_2": "referrer_address",
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_2", 1, 4, 5000.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(4).Return(assert.AnError).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Release a Failed Referral Reward", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		payouts := []*model.SharedPoolPayout{{TaskID: 4, UserID: "test_user_2", Points: 5000}}
+
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{SharedPoolShare: 0.1}).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetReferrers([]string{"test_user_2"}).Return(map[string]string{
+			"test_user_2": "referrer_address",
+		}, nil).Times(1)
+		uniSwapTestSuite.mockedRewardService.EXPECT().RewardUser("test_user_2", 1, 4, 5000.0, []*model.AppliedMultiplier(nil)).Return(nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CompleteTask(4).Return(nil).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().ClaimSharedPoolReward(4, "referrer_address", mock.Anything).Return(true, nil).Times(1)
+		uniSwapTestSuite.mockedTaskService.EXPECT().CreateTask("referrer_address", 1, model.TaskTypeReferral, 0.0).Return(nil, assert.AnError).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().ReleaseSharedPoolReward(4).Return(nil).Times(1)
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(context.Background(), testCampaign, payouts)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("Stop when context is cancelled", func(t *testing.T) {
+		uniSwapTestSuite.setUp(t)
+
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetRewards().Return(&model.ReferralRewards{SharedPoolShare: 0.1}).Times(1)
+		uniSwapTestSuite.mockedReferralService.EXPECT().GetReferrers(mock.Anything).Return(nil, nil).Times(1)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := uniSwapTestSuite.uniSwapService.ProcessSharedPool(ctx, testCampaign, []*model.SharedPoolPayout{
+			{TaskID: 1, UserID: "test_user_1", Points: 2000},
+		})
+		assert.ErrorIs(t, err, context.Canceled)
 	})
 }
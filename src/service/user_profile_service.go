@@ -0,0 +1,120 @@
+package service
+
+import (
+	"time"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+)
+
+type UserProfileService interface {
+	GetUserProfile(userID string, now time.Time) (*model.UserProfile, error)
+}
+
+type userProfileServiceImpl struct {
+	userService            UserService
+	campaignService        CampaignService
+	taskRepository         repository.TaskRepository
+	rewardRecordRepository repository.RewardRecordRepository
+	periodStatsRepository  repository.PeriodStatsRepository
+	streakService          StreakService
+}
+
+func NewUserProfileService() UserProfileService {
+	return &userProfileServiceImpl{
+		userService:            NewUserService(),
+		campaignService:        NewCampaignService(),
+		taskRepository:         repository.NewTaskRepository(),
+		rewardRecordRepository: repository.NewRewardRecordRepository(),
+		periodStatsRepository:  repository.NewPeriodStatsRepository(),
+		streakService:          NewStreakService(),
+	}
+}
+
+// GetUserProfile returns the balance and the streaks of the user, and a summary
+// of every campaign the user swapped in.
+func (s *userProfileServiceImpl) GetUserProfile(userID string, now time.Time) (*model.UserProfile, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := s.taskRepository.SearchUserCampaignActivities(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	points, err := s.rewardRecordRepository.SumPointsByCampaign(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	streaks, err := s.streakService.GetStreaks(userID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &model.UserProfile{
+		User:      user,
+		Streaks:   streaks,
+		Campaigns: make([]*model.UserCampaignProfile, 0, len(activities)),
+	}
+
+	for _, activity := range activities {
+		campaign, err := s.campaignService.GetCampaign(activity.CampaignID)
+		if err != nil {
+			return nil, err
+		}
+
+		campaignProfile := &model.UserCampaignProfile{
+			CampaignID:     campaign.ID,
+			CampaignName:   campaign.Name,
+			CampaignStatus: campaign.Status,
+			Onboarded:      activity.OnboardedAt.Valid,
+			LifetimeVolume: activity.Volume,
+			SwapCount:      activity.SwapCount,
+			Points:         points[campaign.ID],
+		}
+
+		if activity.OnboardedAt.Valid {
+			onboardedAt := activity.OnboardedAt.Time
+			campaignProfile.OnboardedAt = &onboardedAt
+		}
+
+		if err := s.fillCurrentPeriod(campaignProfile, campaign, userID, now); err != nil {
+			return nil, err
+		}
+
+		entry, err := s.periodStatsRepository.GetLeaderboardEntry(&repository.LeaderboardCondition{
+			CampaignID: campaign.ID,
+			SortBy:     model.LeaderboardSortByVolume,
+		}, userID)
+		if err != nil {
+			return nil, err
+		}
+
+		if entry != nil {
+			campaignProfile.Rank = entry.Rank
+		}
+
+		profile.Campaigns = append(profile.Campaigns, campaignProfile)
+	}
+
+	return profile, nil
+}
+
+func (s *userProfileServiceImpl) fillCurrentPeriod(profile *model.UserCampaignProfile, campaign *model.Campaign, userID string, now time.Time) error {
+	if !campaign.IsRunningAt(now) {
+		return nil
+	}
+
+	periodIndex, _ := campaign.PeriodIndexAt(now)
+	profile.CurrentPeriodIndex = &periodIndex
+
+	stats, err := s.periodStatsRepository.GetUserPeriodStats(campaign.ID, periodIndex, userID)
+	if err != nil || stats == nil {
+		return err
+	}
+
+	profile.CurrentPeriodVolume = stats.Volume
+	return nil
+}
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"database/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/mock/service"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+)
+
+type userProfileServiceTestSuite struct {
+	userProfileService           UserProfileService
+	mockedUserService            *service.MockUserService
+	mockedCampaignService        *service.MockCampaignService
+	mockedTaskRepository         *repository.MockTaskRepository
+	mockedRewardRecordRepository *repository.MockRewardRecordRepository
+	mockedPeriodStatsRepository  *repository.MockPeriodStatsRepository
+	mockedStreakService          *service.MockStreakService
+}
+
+func (s *userProfileServiceTestSuite) setUp(t *testing.T) {
+	s.mockedUserService = service.NewMockUserService(t)
+	s.mockedCampaignService = service.NewMockCampaignService(t)
+	s.mockedTaskRepository = repository.NewMockTaskRepository(t)
+	s.mockedRewardRecordRepository = repository.NewMockRewardRecordRepository(t)
+	s.mockedPeriodStatsRepository = repository.NewMockPeriodStatsRepository(t)
+	s.mockedStreakService = service.NewMockStreakService(t)
+	s.userProfileService = &userProfileServiceImpl{
+		userService:            s.mockedUserService,
+		campaignService:        s.mockedCampaignService,
+		taskRepository:         s.mockedTaskRepository,
+		rewardRecordRepository: s.mockedRewardRecordRepository,
+		periodStatsRepository:  s.mockedPeriodStatsRepository,
+		streakService:          s.mockedStreakService,
+	}
+}
+
+func TestUserProfileServiceImpl_GetUserProfile(t *testing.T) {
+	testSuite := &userProfileServiceTestSuite{}
+	startTime := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	now := startTime.Add(time.Hour * 24 * 8)
+	onboardedAt := startTime.Add(time.Hour)
+
+	t.Run("Profile Of Running And Finished Campaigns", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		running := newTestCampaign(startTime)
+		finished := newTestCampaign(startTime.Add(-time.Hour * 24 * 7 * 5))
+		finished.ID = 2
+
+		testSuite.mockedUserService.EXPECT().GetUserByID("test_user").Return(&model.User{ID: "test_user", Points: 260}, nil).Times(1)
+		testSuite.mockedTaskRepository.EXPECT().SearchUserCampaignActivities("test_user").Return([]*model.UserCampaignActivity{
+			{CampaignID: 1, Volume: 3000, SwapCount: 3, OnboardedAt: sql.NullTime{Time: onboardedAt, Valid: true}},
+			{CampaignID: 2, Volume: 500, SwapCount: 1},
+		}, nil).Times(1)
+		testSuite.mockedRewardRecordRepository.EXPECT().SumPointsByCampaign("test_user").Return(map[int]float64{1: 260}, nil).Times(1)
+		testSuite.mockedStreakService.EXPECT().GetStreaks("test_user", now).Return([]*model.Streak{
+			{UserID: "test_user", Period: model.PeriodTypeDaily, Current: 3, Best: 5},
+			{UserID: "test_user", Period: model.PeriodTypeWeekly, Current: 0, Best: 2},
+		}, nil).Times(1)
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(1).Return(running, nil).Times(1)
+		testSuite.mockedCampaignService.EXPECT().GetCampaign(2).Return(finished, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetUserPeriodStats(1, 1, "test_user").
+			Return(&model.UserPeriodStats{Volume: 1200}, nil).Times(1)
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetLeaderboardEntry(mock.Anything, "test_user").
+			Return(&model.LeaderboardEntry{Rank: 4}, nil).Once()
+		testSuite.mockedPeriodStatsRepository.EXPECT().GetLeaderboardEntry(mock.Anything, "test_user").
+			Return(nil, nil).Once()
+
+		profile, err := testSuite.userProfileService.GetUserProfile("test_user", now)
+		assert.Nil(t, err)
+		assert.Equal(t, 260.0, profile.User.Points)
+		assert.Equal(t, 2, len(profile.Campaigns))
+		assert.Equal(t, 3, profile.Streaks[0].Current)
+		assert.Equal(t, 2, profile.Streaks[1].Best)
+
+		assert.True(t, profile.Campaigns[0].Onboarded)
+		assert.Equal(t, onboardedAt, *profile.Campaigns[0].OnboardedAt)
+		assert.Equal(t, 1, *profile.Campaigns[0].CurrentPeriodIndex)
+		assert.Equal(t, 1200.0, profile.Campaigns[0].CurrentPeriodVolume)
+		assert.Equal(t, 4, profile.Campaigns[0].Rank)
+		assert.Equal(t, 260.0, profile.Campaigns[0].Points)
+
+		assert.False(t, profile.Campaigns[1].Onboarded)
+		assert.Nil(t, profile.Campaigns[1].CurrentPeriodIndex)
+		assert.Equal(t, 0, profile.Campaigns[1].Rank)
+	})
+
+	t.Run("User Not Found", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedUserService.EXPECT().GetUserByID("test_user").Return(nil, exception.UserNotFoundError).Times(1)
+
+		_, err := testSuite.userProfileService.GetUserProfile("test_user", now)
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+}
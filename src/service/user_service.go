@@ -1,7 +1,6 @@
 package service
 
 import (
-	"errors"
 	"trading-ace/src/model"
 	"trading-ace/src/repository"
 )
@@ -9,7 +8,6 @@ import (
 type UserService interface {
 	GetUserByID(userID string) (*model.User, error)
 	CreateUser(userID string) (*model.User, error)
-	UpdateUserPoints(userID string, points float64) error
 }
 
 type userServiceImpl struct {
@@ -29,19 +27,3 @@ func (s *userServiceImpl) CreateUser(userID string) (*model.User, error) {
 func (s *userServiceImpl) GetUserByID(userID string) (*model.User, error) {
 	return s.userRepository.GetUser(userID)
 }
-
-func (s *userServiceImpl) UpdateUserPoints(userID string, points float64) error {
-	if points <= 0 {
-		return errors.New("points should be greater than 0")
-	}
-
-	user, err := s.userRepository.GetUser(userID)
-	if err != nil {
-		return err
-	}
-
-	user.Points += points
-
-	_, err = s.userRepository.UpdateUser(user)
-	return err
-}
@@ -72,69 +72,3 @@ func TestUserServiceImpl_GetUserByID(t *testing.T) {
 		assert.NotNil(t, err)
 	})
 }
-
-func TestUserServiceImpl_UpdateUserPoints(t *testing.T) {
-	t.Run("UpdateUserPoints", func(t *testing.T) {
-		setUpUserService(t)
-
-		mockedUserRepository.EXPECT().GetUser("test_user_id").Return(
-			&model.User{
-				ID:     "test_user_id",
-				Points: 0.0,
-			}, nil).Times(1)
-
-		mockedUserRepository.EXPECT().UpdateUser(&model.User{
-			ID:     "test_user_id",
-			Points: 100.0,
-		}).Return(
-			&model.User{
-				ID:     "test_user_id",
-				Points: 100.0,
-			}, nil).Times(1)
-
-		err := userService.UpdateUserPoints("test_user_id", 100.0)
-		assert.Nil(t, err)
-	})
-
-	t.Run("UpdateUserWithNonPositivePoints", func(t *testing.T) {
-		setUpUserService(t)
-
-		t.Run("UpdateUserPointsWithZeroPoints", func(t *testing.T) {
-			err := userService.UpdateUserPoints("test_user_id", 0.0)
-			assert.NotNil(t, err)
-		})
-
-		t.Run("UpdateUserPointsWithNegativePoints", func(t *testing.T) {
-			err := userService.UpdateUserPoints("test_user_id", -100.0)
-			assert.NotNil(t, err)
-		})
-	})
-
-	t.Run("CannotFindUser", func(t *testing.T) {
-		setUpUserService(t)
-
-		mockedUserRepository.EXPECT().GetUser("test_user_id").Return(
-			nil, assert.AnError).Times(1)
-
-		err := userService.UpdateUserPoints("test_user_id", 100.0)
-		assert.NotNil(t, err)
-	})
-	t.Run("UpdateUserPointsFail", func(t *testing.T) {
-		setUpUserService(t)
-
-		mockedUserRepository.EXPECT().GetUser("test_user_id").Return(
-			&model.User{
-				ID:     "test_user_id",
-				Points: 0.0,
-			}, nil).Times(1)
-
-		mockedUserRepository.EXPECT().UpdateUser(&model.User{
-			ID:     "test_user_id",
-			Points: 100.0,
-		}).Return(
-			nil, assert.AnError).Times(1)
-
-		err := userService.UpdateUserPoints("test_user_id", 100.0)
-		assert.NotNil(t, err)
-	})
-}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"log"
+	"time"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/repository"
+	"trading-ace/src/voucher"
+)
+
+type VoucherService interface {
+	IssueVoucher(address string, now time.Time) (*model.Voucher, error)
+	GetVouchers(address string) ([]*model.Voucher, error)
+}
+
+type voucherServiceImpl struct {
+	ledgerRepository  repository.LedgerRepository
+	voucherRepository repository.VoucherRepository
+	mode              string
+	// signer is nil when no key is configured, vouchers can't be issued then.
+	signer        *voucher.Signer
+	tokenDecimals int
+	ttl           time.Duration
+}
+
+func NewVoucherService() VoucherService {
+	claimConfig := config.GetAppConfig().Claim
+
+	var signer *voucher.Signer
+	if claimConfig.GetMode() == config.ClaimModeVoucher {
+		key, err := voucher.LoadKey(claimConfig.SignerKey, claimConfig.KeystoreFile, claimConfig.KeystorePassword)
+		if err != nil {
+			log.Printf("Failed to load voucher signing key: %v", err)
+		} else {
+			signer = voucher.NewSigner(key, claimConfig.GetChainID(), common.HexToAddress(claimConfig.VerifyingContract))
+		}
+	}
+
+	return &voucherServiceImpl{
+		ledgerRepository:  repository.NewLedgerRepository(),
+		voucherRepository: repository.NewVoucherRepository(),
+		mode:              claimConfig.GetMode(),
+		signer:            signer,
+		tokenDecimals:     claimConfig.GetTokenDecimals(),
+		ttl:               claimConfig.GetVoucherTTL(),
+	}
+}
+
+// IssueVoucher moves the balance of the user to the claims account of the
+// ledger, so the points can't be redeemed or expire anymore, and signs a
+// voucher of the cumulative points the user claimed, converted to token base
+// units. The contract pays the difference with what the account already
+// claimed, and marks the nonce as used.
+func (s *voucherServiceImpl) IssueVoucher(address string, now time.Time) (*model.Voucher, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	if s.mode != config.ClaimModeVoucher {
+		return nil, exception.VouchersDisabledError
+	}
+
+	if s.signer == nil {
+		return nil, exception.VoucherSignerNotConfiguredError
+	}
+
+	account := common.HexToAddress(address)
+	claimed, err := s.ledgerRepository.ClaimPoints(account.Hex(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := pointsToAmount(claimed, s.tokenDecimals)
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("%w: %s", exception.NothingToClaimError, address)
+	}
+
+	expiry := now.Add(s.ttl).Truncate(time.Second).UTC()
+
+	return s.voucherRepository.CreateVoucher(account.Hex(), func(nonce int64) (*model.Voucher, error) {
+		signature, err := s.signer.Sign(&voucher.Voucher{
+			Account: account,
+			Amount:  amount,
+			Nonce:   nonce,
+			Expiry:  expiry,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.Voucher{
+			Account:   account.Hex(),
+			Nonce:     nonce,
+			Amount:    amount.String(),
+			Expiry:    expiry,
+			Signature: hexutil.Encode(signature),
+			CreatedAt: now.UTC(),
+			Domain: &model.VoucherDomain{
+				Name:              voucher.DomainName,
+				Version:           voucher.DomainVersion,
+				ChainID:           s.signer.ChainID(),
+				VerifyingContract: s.signer.VerifyingContract().Hex(),
+				Signer:            s.signer.Address().Hex(),
+			},
+		}, nil
+	})
+}
+
+func (s *voucherServiceImpl) GetVouchers(address string) ([]*model.Voucher, error) {
+	if !common.IsHexAddress(address) {
+		return nil, fmt.Errorf("%w: %s", exception.InvalidAddressError, address)
+	}
+
+	return s.voucherRepository.SearchVouchers(common.HexToAddress(address).Hex())
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+	"trading-ace/mock/repository"
+	"trading-ace/src/config"
+	"trading-ace/src/exception"
+	"trading-ace/src/model"
+	"trading-ace/src/voucher"
+)
+
+type voucherServiceTestSuite struct {
+	voucherService          VoucherService
+	signer                  *voucher.Signer
+	mockedLedgerRepository  *repository.MockLedgerRepository
+	mockedVoucherRepository *repository.MockVoucherRepository
+}
+
+func (s *voucherServiceTestSuite) setUp(t *testing.T) {
+	key, _ := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	s.signer = voucher.NewSigner(key, 1, common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3"))
+	s.mockedLedgerRepository = repository.NewMockLedgerRepository(t)
+	s.mockedVoucherRepository = repository.NewMockVoucherRepository(t)
+	s.voucherService = &voucherServiceImpl{
+		ledgerRepository:  s.mockedLedgerRepository,
+		voucherRepository: s.mockedVoucherRepository,
+		mode:              config.ClaimModeVoucher,
+		signer:            s.signer,
+		tokenDecimals:     18,
+		ttl:               time.Hour,
+	}
+}
+
+func TestVoucherServiceImpl_IssueVoucher(t *testing.T) {
+	testSuite := &voucherServiceTestSuite{}
+	now := time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC)
+	account := common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+
+	t.Run("Sign Cumulative Claimed Points", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(account.Hex(), now).Return(1500.5, nil).Times(1)
+		testSuite.mockedVoucherRepository.EXPECT().CreateVoucher(account.Hex(), mock.Anything).
+			RunAndReturn(func(account string, sign func(int64) (*model.Voucher, error)) (*model.Voucher, error) {
+				return sign(7)
+			}).Times(1)
+
+		issued, err := testSuite.voucherService.IssueVoucher(account.Hex(), now)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), issued.Nonce)
+		assert.Equal(t, "1500500000000000000000", issued.Amount)
+		assert.Equal(t, now.Add(time.Hour), issued.Expiry)
+		assert.Equal(t, testSuite.signer.Address().Hex(), issued.Domain.Signer)
+
+		amount, _ := new(big.Int).SetString(issued.Amount, 10)
+		hash, _, err := apitypes.TypedDataAndHash(testSuite.signer.TypedData(&voucher.Voucher{
+			Account: account,
+			Amount:  amount,
+			Nonce:   issued.Nonce,
+			Expiry:  issued.Expiry,
+		}))
+		assert.NoError(t, err)
+
+		signature := hexutil.MustDecode(issued.Signature)
+		signature[64] -= 27
+		publicKey, err := crypto.SigToPub(hash, signature)
+		assert.NoError(t, err)
+		assert.Equal(t, testSuite.signer.Address(), crypto.PubkeyToAddress(*publicKey))
+	})
+
+	t.Run("Nothing To Claim", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(account.Hex(), now).Return(0, nil).Times(1)
+
+		_, err := testSuite.voucherService.IssueVoucher(account.Hex(), now)
+		assert.ErrorIs(t, err, exception.NothingToClaimError)
+	})
+
+	t.Run("Lowercase Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		testSuite.mockedLedgerRepository.EXPECT().ClaimPoints(account.Hex(), now).Return(0, exception.UserNotFoundError).Times(1)
+
+		_, err := testSuite.voucherService.IssueVoucher(strings.ToLower(account.Hex()), now)
+		assert.ErrorIs(t, err, exception.UserNotFoundError)
+	})
+
+	t.Run("Invalid Address", func(t *testing.T) {
+		testSuite.setUp(t)
+
+		_, err := testSuite.voucherService.IssueVoucher("not_an_address", now)
+		assert.ErrorIs(t, err, exception.InvalidAddressError)
+	})
+
+	t.Run("Merkle Mode", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.voucherService.(*voucherServiceImpl).mode = config.ClaimModeMerkle
+
+		_, err := testSuite.voucherService.IssueVoucher(account.Hex(), now)
+		assert.ErrorIs(t, err, exception.VouchersDisabledError)
+	})
+
+	t.Run("Signer Not Configured", func(t *testing.T) {
+		testSuite.setUp(t)
+		testSuite.voucherService.(*voucherServiceImpl).signer = nil
+
+		_, err := testSuite.voucherService.IssueVoucher(account.Hex(), now)
+		assert.ErrorIs(t, err, exception.VoucherSignerNotConfiguredError)
+	})
+}
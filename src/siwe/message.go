@@ -0,0 +1,164 @@
+// Package siwe parses and verifies Sign-In with Ethereum (EIP-4361) messages.
+package siwe
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const header = " wants you to sign in with your Ethereum account:"
+
+var (
+	ErrInvalidMessage   = errors.New("invalid SIWE message")
+	ErrInvalidSignature = errors.New("invalid SIWE signature")
+
+	noncePattern = regexp.MustCompile(`^[a-zA-Z0-9]{8,}$`)
+)
+
+type Message struct {
+	Domain         string
+	Address        common.Address
+	Statement      string
+	URI            string
+	Version        string
+	ChainID        int64
+	Nonce          string
+	IssuedAt       time.Time
+	ExpirationTime *time.Time
+	NotBefore      *time.Time
+	RequestID      string
+	Resources      []string
+}
+
+// ParseMessage parses the plain text message the wallet signed.
+func ParseMessage(text string) (*Message, error) {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if len(lines) < 2 || !strings.HasSuffix(lines[0], header) {
+		return nil, fmt.Errorf("%w: missing header", ErrInvalidMessage)
+	}
+
+	message := &Message{Domain: strings.TrimSuffix(lines[0], header)}
+	if message.Domain == "" {
+		return nil, fmt.Errorf("%w: missing domain", ErrInvalidMessage)
+	}
+
+	// The address must be EIP-55 checksummed.
+	if !common.IsHexAddress(lines[1]) || common.HexToAddress(lines[1]).Hex() != lines[1] {
+		return nil, fmt.Errorf("%w: invalid address %s", ErrInvalidMessage, lines[1])
+	}
+	message.Address = common.HexToAddress(lines[1])
+
+	i := 2
+	for ; i < len(lines) && !strings.HasPrefix(lines[i], "URI: "); i++ {
+		if lines[i] != "" {
+			if message.Statement != "" {
+				return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, lines[i])
+			}
+			message.Statement = lines[i]
+		}
+	}
+
+	var err error
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "URI: "):
+			message.URI = strings.TrimPrefix(line, "URI: ")
+		case strings.HasPrefix(line, "Version: "):
+			message.Version = strings.TrimPrefix(line, "Version: ")
+		case strings.HasPrefix(line, "Chain ID: "):
+			message.ChainID, err = strconv.ParseInt(strings.TrimPrefix(line, "Chain ID: "), 10, 64)
+		case strings.HasPrefix(line, "Nonce: "):
+			message.Nonce = strings.TrimPrefix(line, "Nonce: ")
+		case strings.HasPrefix(line, "Issued At: "):
+			message.IssuedAt, err = time.Parse(time.RFC3339, strings.TrimPrefix(line, "Issued At: "))
+		case strings.HasPrefix(line, "Expiration Time: "):
+			message.ExpirationTime, err = parseOptionalTime(strings.TrimPrefix(line, "Expiration Time: "))
+		case strings.HasPrefix(line, "Not Before: "):
+			message.NotBefore, err = parseOptionalTime(strings.TrimPrefix(line, "Not Before: "))
+		case strings.HasPrefix(line, "Request ID: "):
+			message.RequestID = strings.TrimPrefix(line, "Request ID: ")
+		case line == "Resources:":
+			for ; i+1 < len(lines) && strings.HasPrefix(lines[i+1], "- "); i++ {
+				message.Resources = append(message.Resources, strings.TrimPrefix(lines[i+1], "- "))
+			}
+		default:
+			return nil, fmt.Errorf("%w: unexpected line %q", ErrInvalidMessage, line)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidMessage, err)
+		}
+	}
+
+	switch {
+	case message.URI == "":
+		return nil, fmt.Errorf("%w: missing URI", ErrInvalidMessage)
+	case message.Version != "1":
+		return nil, fmt.Errorf("%w: unsupported version %q", ErrInvalidMessage, message.Version)
+	case message.ChainID <= 0:
+		return nil, fmt.Errorf("%w: missing chain ID", ErrInvalidMessage)
+	case !noncePattern.MatchString(message.Nonce):
+		return nil, fmt.Errorf("%w: nonce should be at least 8 alphanumeric characters", ErrInvalidMessage)
+	case message.IssuedAt.IsZero():
+		return nil, fmt.Errorf("%w: missing issued at", ErrInvalidMessage)
+	}
+
+	return message, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// ValidAt tells whether the message can be used at now, as bounded by its
+// expiration time and not before.
+func (m *Message) ValidAt(now time.Time) bool {
+	if m.ExpirationTime != nil && !now.Before(*m.ExpirationTime) {
+		return false
+	}
+	return m.NotBefore == nil || !now.Before(*m.NotBefore)
+}
+
+// VerifySignature checks that the personal_sign signature of the text was
+// made by the address of the message. v can be 0/1 or 27/28.
+func VerifySignature(text string, message *Message, signature string) error {
+	return VerifyAddressSignature(text, message.Address, signature)
+}
+
+// VerifyAddressSignature checks that the personal_sign signature of any text
+// was made by address.
+func VerifyAddressSignature(text string, address common.Address, signature string) error {
+	sig, err := hexutil.Decode(signature)
+	if err != nil || len(sig) != crypto.SignatureLength {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(accounts.TextHash([]byte(text)), sig)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignature, err)
+	}
+
+	if crypto.PubkeyToAddress(*publicKey) != address {
+		return fmt.Errorf("%w: not signed by %s", ErrInvalidSignature, address.Hex())
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package siwe
+
+import (
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+const testMessage = `example.com wants you to sign in with your Ethereum account:
+0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266
+
+Sign in to Trading Ace.
+
+URI: https://example.com/login
+Version: 1
+Chain ID: 1
+Nonce: 32891756abcdef01
+Issued At: 2024-09-10T12:00:00Z
+Expiration Time: 2024-09-10T12:10:00Z
+Resources:
+- https://example.com/terms`
+
+func TestParseMessage(t *testing.T) {
+	t.Run("Full Message", func(t *testing.T) {
+		message, err := ParseMessage(testMessage)
+		assert.NoError(t, err)
+		assert.Equal(t, "example.com", message.Domain)
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", message.Address.Hex())
+		assert.Equal(t, "Sign in to Trading Ace.", message.Statement)
+		assert.Equal(t, "https://example.com/login", message.URI)
+		assert.Equal(t, int64(1), message.ChainID)
+		assert.Equal(t, "32891756abcdef01", message.Nonce)
+		assert.Equal(t, time.Date(2024, 9, 10, 12, 0, 0, 0, time.UTC), message.IssuedAt)
+		assert.Equal(t, []string{"https://example.com/terms"}, message.Resources)
+
+		assert.True(t, message.ValidAt(time.Date(2024, 9, 10, 12, 5, 0, 0, time.UTC)))
+		assert.False(t, message.ValidAt(time.Date(2024, 9, 10, 12, 10, 0, 0, time.UTC)))
+	})
+
+	t.Run("Without Statement", func(t *testing.T) {
+		message, err := ParseMessage("example.com wants you to sign in with your Ethereum account:\n" +
+			"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\n\n" +
+			"URI: https://example.com\nVersion: 1\nChain ID: 5\nNonce: abcdefgh1\nIssued At: 2024-09-10T12:00:00Z")
+		assert.NoError(t, err)
+		assert.Empty(t, message.Statement)
+		assert.Nil(t, message.ExpirationTime)
+		assert.True(t, message.ValidAt(time.Now()))
+	})
+
+	for name, text := range map[string]string{
+		"Missing Header":      "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"Lowercase Address":   "example.com wants you to sign in with your Ethereum account:\n0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266\n\nURI: https://example.com\nVersion: 1\nChain ID: 1\nNonce: abcdefgh1\nIssued At: 2024-09-10T12:00:00Z",
+		"Short Nonce":         "example.com wants you to sign in with your Ethereum account:\n0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\nURI: https://example.com\nVersion: 1\nChain ID: 1\nNonce: abc\nIssued At: 2024-09-10T12:00:00Z",
+		"Unsupported Version": "example.com wants you to sign in with your Ethereum account:\n0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\nURI: https://example.com\nVersion: 2\nChain ID: 1\nNonce: abcdefgh1\nIssued At: 2024-09-10T12:00:00Z",
+		"Invalid Issued At":   "example.com wants you to sign in with your Ethereum account:\n0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266\n\nURI: https://example.com\nVersion: 1\nChain ID: 1\nNonce: abcdefgh1\nIssued At: yesterday",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseMessage(text)
+			assert.ErrorIs(t, err, ErrInvalidMessage)
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	key, _ := crypto.HexToECDSA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	otherKey, _ := crypto.GenerateKey()
+	message, _ := ParseMessage(testMessage)
+
+	signature, _ := crypto.Sign(accounts.TextHash([]byte(testMessage)), key)
+
+	t.Run("Signed By Address", func(t *testing.T) {
+		assert.NoError(t, VerifySignature(testMessage, message, hexutil.Encode(signature)))
+
+		// Wallets return v as 27 or 28.
+		withV := append([]byte{}, signature...)
+		withV[64] += 27
+		assert.NoError(t, VerifySignature(testMessage, message, hexutil.Encode(withV)))
+	})
+
+	t.Run("Signed By Another Key", func(t *testing.T) {
+		otherSignature, _ := crypto.Sign(accounts.TextHash([]byte(testMessage)), otherKey)
+		assert.ErrorIs(t, VerifySignature(testMessage, message, hexutil.Encode(otherSignature)), ErrInvalidSignature)
+	})
+
+	t.Run("Tampered Message", func(t *testing.T) {
+		assert.ErrorIs(t, VerifySignature(testMessage+"\n", message, hexutil.Encode(signature)), ErrInvalidSignature)
+	})
+
+	t.Run("Malformed Signature", func(t *testing.T) {
+		assert.ErrorIs(t, VerifySignature(testMessage, message, "0x1234"), ErrInvalidSignature)
+	})
+}
@@ -0,0 +1,132 @@
+// Package voucher signs reward vouchers as EIP-712 typed data:
+//
+//	Voucher(address account,uint256 amount,uint256 nonce,uint256 expiry)
+//
+// in the domain {name: "TradingAce", version: "1", chainId, verifyingContract}.
+package voucher
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DomainName    = "TradingAce"
+	DomainVersion = "1"
+)
+
+var ErrNoKey = errors.New("no voucher signing key configured")
+
+type Voucher struct {
+	Account common.Address
+	Amount  *big.Int
+	Nonce   int64
+	Expiry  time.Time
+}
+
+type Signer struct {
+	key               *ecdsa.PrivateKey
+	chainID           int64
+	verifyingContract common.Address
+}
+
+func NewSigner(key *ecdsa.PrivateKey, chainID int64, verifyingContract common.Address) *Signer {
+	return &Signer{key: key, chainID: chainID, verifyingContract: verifyingContract}
+}
+
+// LoadKey reads the signing key from its hex encoding or, when empty, from an
+// encrypted keystore file.
+func LoadKey(hexKey string, keystoreFile string, password string) (*ecdsa.PrivateKey, error) {
+	if hexKey != "" {
+		return crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	}
+
+	if keystoreFile == "" {
+		return nil, ErrNoKey
+	}
+
+	keyJSON, err := os.ReadFile(keystoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore %s: %w", keystoreFile, err)
+	}
+
+	return key.PrivateKey, nil
+}
+
+func (s *Signer) Address() common.Address {
+	return crypto.PubkeyToAddress(s.key.PublicKey)
+}
+
+func (s *Signer) ChainID() int64 {
+	return s.chainID
+}
+
+func (s *Signer) VerifyingContract() common.Address {
+	return s.verifyingContract
+}
+
+// TypedData is the EIP-712 typed data of the voucher, as eth_signTypedData_v4 expects it.
+func (s *Signer) TypedData(voucher *Voucher) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Voucher": {
+				{Name: "account", Type: "address"},
+				{Name: "amount", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "expiry", Type: "uint256"},
+			},
+		},
+		PrimaryType: "Voucher",
+		Domain: apitypes.TypedDataDomain{
+			Name:              DomainName,
+			Version:           DomainVersion,
+			ChainId:           math.NewHexOrDecimal256(s.chainID),
+			VerifyingContract: s.verifyingContract.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"account": voucher.Account.Hex(),
+			"amount":  voucher.Amount.String(),
+			"nonce":   strconv.FormatInt(voucher.Nonce, 10),
+			"expiry":  strconv.FormatInt(voucher.Expiry.Unix(), 10),
+		},
+	}
+}
+
+// Sign returns the 65 bytes signature of the voucher, with v as 27 or 28 as
+// ecrecover expects.
+func (s *Signer) Sign(voucher *Voucher) ([]byte, error) {
+	hash, _, err := apitypes.TypedDataAndHash(s.TypedData(voucher))
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(hash, s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
@@ -0,0 +1,87 @@
+package voucher
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Hardhat's first default account, never use it outside of tests.
+const testKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+func TestSigner_Sign(t *testing.T) {
+	key, err := LoadKey("0x"+testKey, "", "")
+	assert.NoError(t, err)
+
+	contract := common.HexToAddress("0x5FbDB2315678afecb367f032d93F642f64180aa3")
+	signer := NewSigner(key, 1, contract)
+	assert.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), signer.Address())
+
+	voucher := &Voucher{
+		Account: common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8"),
+		Amount:  new(big.Int).Exp(big.NewInt(10), big.NewInt(20), nil),
+		Nonce:   3,
+		Expiry:  time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	signature, err := signer.Sign(voucher)
+	assert.NoError(t, err)
+	assert.Equal(t, 65, len(signature))
+	assert.Contains(t, []byte{27, 28}, signature[64])
+
+	// Digest as the contract computes it with keccak256(abi.encode(...)).
+	word := func(value *big.Int) []byte { return common.LeftPadBytes(value.Bytes(), 32) }
+	domainSeparator := crypto.Keccak256(
+		crypto.Keccak256([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256([]byte(DomainName)),
+		crypto.Keccak256([]byte(DomainVersion)),
+		word(big.NewInt(1)),
+		common.LeftPadBytes(contract.Bytes(), 32),
+	)
+	structHash := crypto.Keccak256(
+		crypto.Keccak256([]byte("Voucher(address account,uint256 amount,uint256 nonce,uint256 expiry)")),
+		common.LeftPadBytes(voucher.Account.Bytes(), 32),
+		word(voucher.Amount),
+		word(big.NewInt(voucher.Nonce)),
+		word(big.NewInt(voucher.Expiry.Unix())),
+	)
+	digest := crypto.Keccak256([]byte{0x19, 0x01}, domainSeparator, structHash)
+
+	recoverable := append([]byte{}, signature...)
+	recoverable[64] -= 27
+	publicKey, err := crypto.SigToPub(digest, recoverable)
+	assert.NoError(t, err)
+	assert.Equal(t, signer.Address(), crypto.PubkeyToAddress(*publicKey))
+}
+
+func TestLoadKey(t *testing.T) {
+	t.Run("From Keystore", func(t *testing.T) {
+		key, _ := crypto.HexToECDSA(testKey)
+		keyJSON, err := keystore.EncryptKey(&keystore.Key{
+			Address:    crypto.PubkeyToAddress(key.PublicKey),
+			PrivateKey: key,
+		}, "secret", keystore.LightScryptN, keystore.LightScryptP)
+		assert.NoError(t, err)
+
+		keystoreFile := filepath.Join(t.TempDir(), "signer.json")
+		assert.NoError(t, os.WriteFile(keystoreFile, keyJSON, 0600))
+
+		loaded, err := LoadKey("", keystoreFile, "secret")
+		assert.NoError(t, err)
+		assert.Equal(t, key.D, loaded.D)
+
+		_, err = LoadKey("", keystoreFile, "wrong")
+		assert.Error(t, err)
+	})
+
+	t.Run("No Key", func(t *testing.T) {
+		_, err := LoadKey("", "", "")
+		assert.ErrorIs(t, err, ErrNoKey)
+	})
+}